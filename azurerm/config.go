@@ -2,10 +2,15 @@ package azurerm
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net"
 	"net/http"
 	"net/http/httputil"
+	"net/url"
 	"os"
 	"strings"
 	"sync"
@@ -67,6 +72,7 @@ import (
 	uuid "github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform/httpclient"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/authentication"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 	"github.com/terraform-providers/terraform-provider-azurerm/version"
 )
@@ -80,6 +86,13 @@ type ArmClient struct {
 	usingServicePrincipal    bool
 	environment              azure.Environment
 	skipProviderRegistration bool
+	partnerId                string
+	retryAttempts            int
+	retryDuration            time.Duration
+	httpClient               *http.Client
+	defaultTags              map[string]interface{}
+	ignoredTags              ignoredTags
+	features                 features
 
 	StopContext context.Context
 
@@ -146,6 +159,7 @@ type ArmClient struct {
 	vmExtensionImageClient     compute.VirtualMachineExtensionImagesClient
 	vmExtensionClient          compute.VirtualMachineExtensionsClient
 	vmScaleSetClient           compute.VirtualMachineScaleSetsClient
+	vmScaleSetExtensionsClient compute.VirtualMachineScaleSetExtensionsClient
 	vmImageClient              compute.VirtualMachineImagesClient
 	vmClient                   compute.VirtualMachinesClient
 
@@ -169,10 +183,13 @@ type ArmClient struct {
 	postgresqlFirewallRulesClient            postgresql.FirewallRulesClient
 	postgresqlServersClient                  postgresql.ServersClient
 	postgresqlVirtualNetworkRulesClient      postgresql.VirtualNetworkRulesClient
+	sqlDatabaseBlobAuditingPoliciesClient    sql.DatabaseBlobAuditingPoliciesClient
 	sqlDatabasesClient                       sql.DatabasesClient
 	sqlDatabaseThreatDetectionPoliciesClient sql.DatabaseThreatDetectionPoliciesClient
 	sqlElasticPoolsClient                    sql.ElasticPoolsClient
+	sqlFailoverGroupsClient                  sql.FailoverGroupsClient
 	sqlFirewallRulesClient                   sql.FirewallRulesClient
+	sqlManagedInstancesClient                sql.ManagedInstancesClient
 	sqlServersClient                         sql.ServersClient
 	sqlServerAzureADAdministratorsClient     sql.ServerAzureADAdministratorsClient
 	sqlVirtualNetworkRulesClient             sql.VirtualNetworkRulesClient
@@ -213,6 +230,7 @@ type ArmClient struct {
 	applicationGatewayClient        network.ApplicationGatewaysClient
 	applicationSecurityGroupsClient network.ApplicationSecurityGroupsClient
 	azureFirewallsClient            network.AzureFirewallsClient
+	ddosProtectionPlanClient        network.DdosProtectionPlansClient
 	expressRouteAuthsClient         network.ExpressRouteCircuitAuthorizationsClient
 	expressRouteCircuitClient       network.ExpressRouteCircuitsClient
 	expressRoutePeeringsClient      network.ExpressRouteCircuitPeeringsClient
@@ -284,8 +302,10 @@ type ArmClient struct {
 	trafficManagerEndpointsClient              trafficmanager.EndpointsClient
 
 	// Web
-	appServicePlansClient web.AppServicePlansClient
-	appServicesClient     web.AppsClient
+	appServiceCertificatesClient web.CertificatesClient
+	appServiceEnvironmentsClient web.AppServiceEnvironmentsClient
+	appServicePlansClient        web.AppServicePlansClient
+	appServicesClient            web.AppsClient
 
 	// Policy
 	policyAssignmentsClient policy.AssignmentsClient
@@ -313,12 +333,65 @@ func clientRequestID() string {
 }
 
 func (c *ArmClient) configureClient(client *autorest.Client, auth autorest.Authorizer) {
-	setUserAgent(client)
+	setUserAgent(client, c.partnerId)
 	client.Authorizer = auth
 	//client.RequestInspector = azure.WithClientID(clientRequestID())
-	client.Sender = autorest.CreateSender(withRequestLogging())
+	client.Sender = autorest.DecorateSender(c.httpClient, withRequestLogging(), response.WithRequestIDsInErrors())
 	client.SkipResourceProviderRegistration = c.skipProviderRegistration
-	client.PollingDuration = 60 * time.Minute
+	// the deadline on the context (from the resource's Timeouts) takes precedence over this
+	client.PollingDuration = 180 * time.Minute
+	client.RetryAttempts = c.retryAttempts
+	client.RetryDuration = c.retryDuration
+}
+
+// buildHttpClient returns the HTTP Client used to send requests to Azure, which uses either the
+// configured Proxy or the Proxy defined in the environment (e.g. `HTTPS_PROXY` and `NO_PROXY`) - and
+// which trusts any additional CA Certificates in addition to the system's CA Certificates.
+func buildHttpClient(c *authentication.Config) (*http.Client, error) {
+	transport := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		MaxIdleConns:          100,
+		TLSHandshakeTimeout:   10 * time.Second,
+		IdleConnTimeout:       90 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+
+	if c.ProxyURL != "" {
+		proxyUrl, err := url.Parse(c.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing the Proxy URL %q: %+v", c.ProxyURL, err)
+		}
+
+		transport.Proxy = http.ProxyURL(proxyUrl)
+	}
+
+	if c.CACertificatePath != "" {
+		contents, err := ioutil.ReadFile(c.CACertificatePath)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading the CA Certificate %q: %+v", c.CACertificatePath, err)
+		}
+
+		certPool, err := x509.SystemCertPool()
+		if err != nil || certPool == nil {
+			certPool = x509.NewCertPool()
+		}
+
+		if !certPool.AppendCertsFromPEM(contents) {
+			return nil, fmt.Errorf("No PEM-encoded CA Certificates were found in %q", c.CACertificatePath)
+		}
+
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs: certPool,
+		}
+	}
+
+	return &http.Client{
+		Transport: transport,
+	}, nil
 }
 
 func withRequestLogging() autorest.SendDecorator {
@@ -349,7 +422,7 @@ func withRequestLogging() autorest.SendDecorator {
 	}
 }
 
-func setUserAgent(client *autorest.Client) {
+func setUserAgent(client *autorest.Client, partnerId string) {
 	// TODO: This is the SDK version not the CLI version, once we are on 0.12, should revisit
 	tfUserAgent := httpclient.UserAgentString()
 
@@ -362,10 +435,15 @@ func setUserAgent(client *autorest.Client) {
 		client.UserAgent = fmt.Sprintf("%s %s", client.UserAgent, azureAgent)
 	}
 
+	// append the Partner ID (used for usage attribution) to the user agent if it's specified
+	if partnerId != "" {
+		client.UserAgent = fmt.Sprintf("%s pid-%s", client.UserAgent, partnerId)
+	}
+
 	log.Printf("[DEBUG] AzureRM Client User Agent: %s\n", client.UserAgent)
 }
 
-func getAuthorizationToken(c *authentication.Config, oauthConfig *adal.OAuthConfig, endpoint string) (*autorest.BearerAuthorizer, error) {
+func getAuthorizationToken(c *authentication.Config, oauthConfig *adal.OAuthConfig, endpoint string, sender autorest.Sender) (*autorest.BearerAuthorizer, error) {
 	useServicePrincipal := c.ClientSecret != ""
 
 	if useServicePrincipal {
@@ -373,12 +451,40 @@ func getAuthorizationToken(c *authentication.Config, oauthConfig *adal.OAuthConf
 		if err != nil {
 			return nil, err
 		}
+		spt.SetSender(sender)
+
+		auth := autorest.NewBearerAuthorizer(spt)
+		return auth, nil
+	}
+
+	if c.ClientCertPath != "" {
+		certificate, privateKey, err := c.DecodeClientCertificate()
+		if err != nil {
+			return nil, err
+		}
+
+		spt, err := adal.NewServicePrincipalTokenFromCertificate(*oauthConfig, c.ClientID, certificate, privateKey, endpoint)
+		if err != nil {
+			return nil, err
+		}
+		spt.SetSender(sender)
 
 		auth := autorest.NewBearerAuthorizer(spt)
 		return auth, nil
 	}
 
 	if c.UseMsi {
+		// when a Client ID is specified we authenticate using that User Assigned Identity
+		// rather than the System Assigned Identity of the host
+		if c.ClientID != "" {
+			spt, err := adal.NewServicePrincipalTokenFromMSIWithUserAssignedID(c.MsiEndpoint, endpoint, c.ClientID)
+			if err != nil {
+				return nil, err
+			}
+			auth := autorest.NewBearerAuthorizer(spt)
+			return auth, nil
+		}
+
 		spt, err := adal.NewServicePrincipalTokenFromMSI(c.MsiEndpoint, endpoint)
 		if err != nil {
 			return nil, err
@@ -399,6 +505,7 @@ func getAuthorizationToken(c *authentication.Config, oauthConfig *adal.OAuthConf
 	if err != nil {
 		return nil, err
 	}
+	spt.SetSender(sender)
 
 	err = spt.Refresh()
 
@@ -410,19 +517,49 @@ func getAuthorizationToken(c *authentication.Config, oauthConfig *adal.OAuthConf
 	return auth, nil
 }
 
+func getAuxiliaryTenantTokens(c *authentication.Config, env azure.Environment, endpoint string, sender autorest.Sender) ([]adal.OAuthTokenProvider, error) {
+	tokens := make([]adal.OAuthTokenProvider, 0)
+
+	for _, tenantId := range c.AuxiliaryTenantIDs {
+		oauthConfig, err := adal.NewOAuthConfig(env.ActiveDirectoryEndpoint, tenantId)
+		if err != nil {
+			return nil, fmt.Errorf("Error configuring OAuthConfig for Auxiliary Tenant %q: %+v", tenantId, err)
+		}
+
+		var spt *adal.ServicePrincipalToken
+		if c.ClientSecret != "" {
+			spt, err = adal.NewServicePrincipalToken(*oauthConfig, c.ClientID, c.ClientSecret, endpoint)
+			if err != nil {
+				return nil, fmt.Errorf("Error obtaining a token for Auxiliary Tenant %q: %+v", tenantId, err)
+			}
+		} else {
+			certificate, privateKey, err := c.DecodeClientCertificate()
+			if err != nil {
+				return nil, err
+			}
+
+			spt, err = adal.NewServicePrincipalTokenFromCertificate(*oauthConfig, c.ClientID, certificate, privateKey, endpoint)
+			if err != nil {
+				return nil, fmt.Errorf("Error obtaining a token for Auxiliary Tenant %q: %+v", tenantId, err)
+			}
+		}
+
+		spt.SetSender(sender)
+		tokens = append(tokens, spt)
+	}
+
+	return tokens, nil
+}
+
 // getArmClient is a helper method which returns a fully instantiated
 // *ArmClient based on the Config's current settings.
 func getArmClient(c *authentication.Config) (*ArmClient, error) {
 	// detect cloud from environment
-	env, envErr := azure.EnvironmentFromName(c.Environment)
-	if envErr != nil {
-		// try again with wrapped value to support readable values like german instead of AZUREGERMANCLOUD
-		wrapped := fmt.Sprintf("AZURE%sCLOUD", c.Environment)
-		var innerErr error
-		if env, innerErr = azure.EnvironmentFromName(wrapped); innerErr != nil {
-			return nil, envErr
-		}
+	environment, err := c.AzureEnvironment()
+	if err != nil {
+		return nil, err
 	}
+	env := *environment
 
 	// client declarations:
 	client := ArmClient{
@@ -430,8 +567,19 @@ func getArmClient(c *authentication.Config) (*ArmClient, error) {
 		tenantId:                 c.TenantID,
 		subscriptionId:           c.SubscriptionID,
 		environment:              env,
-		usingServicePrincipal:    c.ClientSecret != "",
+		usingServicePrincipal:    c.ClientSecret != "" || c.ClientCertPath != "",
 		skipProviderRegistration: c.SkipProviderRegistration,
+		partnerId:                c.PartnerID,
+		retryAttempts:            autorest.DefaultRetryAttempts,
+		retryDuration:            autorest.DefaultRetryDuration,
+	}
+
+	if c.MaxRetries != nil {
+		client.retryAttempts = *c.MaxRetries
+	}
+
+	if c.RetryBackoffSeconds != nil {
+		client.retryDuration = time.Duration(*c.RetryBackoffSeconds) * time.Second
 	}
 
 	oauthConfig, err := adal.NewOAuthConfig(env.ActiveDirectoryEndpoint, c.TenantID)
@@ -444,25 +592,42 @@ func getArmClient(c *authentication.Config) (*ArmClient, error) {
 		return nil, fmt.Errorf("Unable to configure OAuthConfig for tenant %s", c.TenantID)
 	}
 
-	sender := autorest.CreateSender(withRequestLogging())
+	httpClient, err := buildHttpClient(c)
+	if err != nil {
+		return nil, err
+	}
+	client.httpClient = httpClient
+
+	sender := autorest.DecorateSender(httpClient, withRequestLogging())
 
 	// Resource Manager endpoints
 	endpoint := env.ResourceManagerEndpoint
-	auth, err := getAuthorizationToken(c, oauthConfig, endpoint)
+	var auth autorest.Authorizer
+	auth, err = getAuthorizationToken(c, oauthConfig, endpoint, sender)
 	if err != nil {
 		return nil, err
 	}
 
+	// cross-tenant operations additionally require a token for each of the Auxiliary Tenants
+	if len(c.AuxiliaryTenantIDs) > 0 {
+		auxiliaryTokens, err := getAuxiliaryTenantTokens(c, env, endpoint, sender)
+		if err != nil {
+			return nil, err
+		}
+
+		auth = authentication.NewAuxiliaryTenantAuthorizer(auth, auxiliaryTokens)
+	}
+
 	// Graph Endpoints
 	graphEndpoint := env.GraphEndpoint
-	graphAuth, err := getAuthorizationToken(c, oauthConfig, graphEndpoint)
+	graphAuth, err := getAuthorizationToken(c, oauthConfig, graphEndpoint, sender)
 	if err != nil {
 		return nil, err
 	}
 
 	// Key Vault Endpoints
 	keyVaultAuth := autorest.NewBearerAuthorizerCallback(sender, func(tenantID, resource string) (*autorest.BearerAuthorizer, error) {
-		keyVaultSpt, err := getAuthorizationToken(c, oauthConfig, resource)
+		keyVaultSpt, err := getAuthorizationToken(c, oauthConfig, resource, sender)
 		if err != nil {
 			return nil, err
 		}
@@ -628,6 +793,10 @@ func (c *ArmClient) registerComputeClients(endpoint, subscriptionId string, auth
 	c.configureClient(&scaleSetsClient.Client, auth)
 	c.vmScaleSetClient = scaleSetsClient
 
+	scaleSetExtensionsClient := compute.NewVirtualMachineScaleSetExtensionsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&scaleSetExtensionsClient.Client, auth)
+	c.vmScaleSetExtensionsClient = scaleSetExtensionsClient
+
 	virtualMachinesClient := compute.NewVirtualMachinesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&virtualMachinesClient.Client, auth)
 	c.vmClient = virtualMachinesClient
@@ -723,21 +892,33 @@ func (c *ArmClient) registerDatabases(endpoint, subscriptionId string, auth auto
 	c.configureClient(&sqlDBClient.Client, auth)
 	c.sqlDatabasesClient = sqlDBClient
 
+	sqlDBAPClient := sql.NewDatabaseBlobAuditingPoliciesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlDBAPClient.Client, auth)
+	c.sqlDatabaseBlobAuditingPoliciesClient = sqlDBAPClient
+
 	sqlDTDPClient := sql.NewDatabaseThreatDetectionPoliciesClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&sqlDTDPClient.Client)
-	sqlDTDPClient.Authorizer = auth
-	sqlDTDPClient.Sender = sender
-	sqlDTDPClient.SkipResourceProviderRegistration = c.skipProviderRegistration
+	c.configureClient(&sqlDTDPClient.Client, auth)
 	c.sqlDatabaseThreatDetectionPoliciesClient = sqlDTDPClient
 
 	sqlFWClient := sql.NewFirewallRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&sqlFWClient.Client, auth)
 	c.sqlFirewallRulesClient = sqlFWClient
 
+	sqlMIClient := sql.NewManagedInstancesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlMIClient.Client, auth)
+	// provisioning and deleting Managed Instances can take many hours, so the resource's Timeouts
+	// should be the only deadline on the poller
+	sqlMIClient.PollingDuration = 24 * time.Hour
+	c.sqlManagedInstancesClient = sqlMIClient
+
 	sqlEPClient := sql.NewElasticPoolsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&sqlEPClient.Client, auth)
 	c.sqlElasticPoolsClient = sqlEPClient
 
+	sqlFGClient := sql.NewFailoverGroupsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlFGClient.Client, auth)
+	c.sqlFailoverGroupsClient = sqlFGClient
+
 	sqlSrvClient := sql.NewServersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&sqlSrvClient.Client, auth)
 	c.sqlServersClient = sqlSrvClient
@@ -878,6 +1059,10 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.configureClient(&azureFirewallsClient.Client, auth)
 	c.azureFirewallsClient = azureFirewallsClient
 
+	ddosProtectionPlanClient := network.NewDdosProtectionPlansClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&ddosProtectionPlanClient.Client, auth)
+	c.ddosProtectionPlanClient = ddosProtectionPlanClient
+
 	expressRouteAuthsClient := network.NewExpressRouteCircuitAuthorizationsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&expressRouteAuthsClient.Client, auth)
 	c.expressRouteAuthsClient = expressRouteAuthsClient
@@ -955,7 +1140,7 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.watcherClient = watchersClient
 }
 
-func (c *ArmClient) registerNotificationHubsClient(endpoint, subscriptionId string, auth *autorest.BearerAuthorizer, sender autorest.Sender) {
+func (c *ArmClient) registerNotificationHubsClient(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
 	namespacesClient := notificationhubs.NewNamespacesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&namespacesClient.Client, auth)
 	c.notificationNamespacesClient = namespacesClient
@@ -1114,6 +1299,16 @@ func (c *ArmClient) registerTrafficManagerClients(endpoint, subscriptionId strin
 }
 
 func (c *ArmClient) registerWebClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+	appServiceCertificatesClient := web.NewCertificatesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&appServiceCertificatesClient.Client, auth)
+	c.appServiceCertificatesClient = appServiceCertificatesClient
+
+	appServiceEnvironmentsClient := web.NewAppServiceEnvironmentsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&appServiceEnvironmentsClient.Client, auth)
+	// creating, scaling and deleting an App Service Environment can take multiple hours
+	appServiceEnvironmentsClient.PollingDuration = 6 * time.Hour
+	c.appServiceEnvironmentsClient = appServiceEnvironmentsClient
+
 	appServicePlansClient := web.NewAppServicePlansClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&appServicePlansClient.Client, auth)
 	c.appServicePlansClient = appServicePlansClient
@@ -1,6 +1,15 @@
 package azurerm
 
-import "testing"
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/authentication"
+)
 
 func TestClientRequestID(t *testing.T) {
 	first := clientRequestID()
@@ -14,3 +23,64 @@ func TestClientRequestID(t *testing.T) {
 		t.Fatal("subsequent request ID not the same as the first")
 	}
 }
+
+func TestSetUserAgent(t *testing.T) {
+	client := autorest.NewClientWithUserAgent("")
+	setUserAgent(&client, "")
+	if strings.Contains(client.UserAgent, "pid-") {
+		t.Fatalf("Expected the User Agent not to contain a Partner ID but got %q", client.UserAgent)
+	}
+
+	client = autorest.NewClientWithUserAgent("")
+	setUserAgent(&client, "00000000-0000-0000-0000-000000000000")
+	if !strings.HasSuffix(client.UserAgent, " pid-00000000-0000-0000-0000-000000000000") {
+		t.Fatalf("Expected the User Agent to end with the Partner ID but got %q", client.UserAgent)
+	}
+}
+
+func TestBuildHttpClient(t *testing.T) {
+	client, err := buildHttpClient(&authentication.Config{})
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	transport := client.Transport.(*http.Transport)
+	if transport.TLSClientConfig != nil {
+		t.Fatalf("Expected no TLS Config when no CA Certificate is specified")
+	}
+
+	client, err = buildHttpClient(&authentication.Config{
+		ProxyURL: "http://proxy.example.com:3128",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, "https://management.azure.com/", nil)
+	proxy, err := client.Transport.(*http.Transport).Proxy(req)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	if proxy == nil || proxy.String() != "http://proxy.example.com:3128" {
+		t.Fatalf("Expected the Proxy to be `http://proxy.example.com:3128` but got %+v", proxy)
+	}
+
+	file, err := ioutil.TempFile("", "ca-certificate")
+	if err != nil {
+		t.Fatalf("Error creating temporary file: %+v", err)
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.WriteString("not a certificate"); err != nil {
+		t.Fatalf("Error writing temporary file: %+v", err)
+	}
+	file.Close()
+
+	if _, err := buildHttpClient(&authentication.Config{CACertificatePath: file.Name()}); err == nil {
+		t.Fatalf("Expected an error when the CA Certificate file contains no certificates but didn't get one")
+	}
+
+	if _, err := buildHttpClient(&authentication.Config{CACertificatePath: "/does/not/exist.pem"}); err == nil {
+		t.Fatalf("Expected an error when the CA Certificate file doesn't exist but didn't get one")
+	}
+}
@@ -0,0 +1,123 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmAppServiceEnvironment() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmAppServiceEnvironmentRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"location": locationForDataSourceSchema(),
+
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"internal_load_balancing_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"pricing_tier": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"front_end_scale_factor": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"cluster_setting": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"dns_suffix": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsForDataSourceSchema(),
+		},
+	}
+}
+
+func dataSourceArmAppServiceEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServiceEnvironmentsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: App Service Environment %q (Resource Group %q) was not found", name, resourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving App Service Environment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ID for App Service Environment %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.AppServiceEnvironment; props != nil {
+		if vnet := props.VirtualNetwork; vnet != nil {
+			d.Set("subnet_id", vnet.ID)
+		}
+
+		d.Set("internal_load_balancing_mode", string(props.InternalLoadBalancingMode))
+		d.Set("dns_suffix", props.DNSSuffix)
+
+		if frontEndScaleFactor := props.FrontEndScaleFactor; frontEndScaleFactor != nil {
+			d.Set("front_end_scale_factor", int(*frontEndScaleFactor))
+		}
+
+		if multiSize := props.MultiSize; multiSize != nil {
+			d.Set("pricing_tier", flattenAppServiceEnvironmentPricingTier(*multiSize))
+		}
+
+		if err := d.Set("cluster_setting", flattenAppServiceEnvironmentClusterSettings(props.ClusterSettings)); err != nil {
+			return fmt.Errorf("Error setting `cluster_setting`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
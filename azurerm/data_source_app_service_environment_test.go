@@ -0,0 +1,43 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMAppServiceEnvironment_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_app_service_environment.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAppServiceEnvironment_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "location"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "subnet_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "pricing_tier", "I1"),
+					resource.TestCheckResourceAttr(dataSourceName, "front_end_scale_factor", "15"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAppServiceEnvironment_basic(rInt int, location string) string {
+	config := testAccAzureRMAppServiceEnvironment_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_app_service_environment" "test" {
+  name                = "${azurerm_app_service_environment.test.name}"
+  resource_group_name = "${azurerm_app_service_environment.test.resource_group_name}"
+}
+`, config)
+}
@@ -0,0 +1,129 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-12-01/policy"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceArmBuiltInPolicyDefinition() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmBuiltInPolicyDefinitionRead,
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Computed
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_rule": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"parameters": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"metadata": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmBuiltInPolicyDefinitionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).policyDefinitionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	displayName := d.Get("display_name").(string)
+
+	// the API doesn't support filtering by Display Name, so we have to iterate over all of them
+	definitions, err := client.ListBuiltInComplete(ctx)
+	if err != nil {
+		return fmt.Errorf("Error loading Built-In Policy Definition List: %+v", err)
+	}
+
+	var definition *policy.Definition
+	for definitions.NotDone() {
+		v := definitions.Value()
+		if props := v.DefinitionProperties; props != nil && props.DisplayName != nil {
+			if strings.EqualFold(*props.DisplayName, displayName) {
+				definition = &v
+				break
+			}
+		}
+
+		if err := definitions.Next(); err != nil {
+			return fmt.Errorf("Error loading Built-In Policy Definition List: %+v", err)
+		}
+	}
+
+	if definition == nil {
+		return fmt.Errorf("A Built-In Policy Definition with the Display Name %q was not found", displayName)
+	}
+
+	if definition.ID == nil {
+		return fmt.Errorf("Error loading Built-In Policy Definition %q: `id` was nil", displayName)
+	}
+
+	d.SetId(*definition.ID)
+	d.Set("name", definition.Name)
+
+	if props := definition.DefinitionProperties; props != nil {
+		d.Set("display_name", props.DisplayName)
+		d.Set("description", props.Description)
+		d.Set("policy_type", string(props.PolicyType))
+		d.Set("mode", string(props.Mode))
+
+		if policyRule := props.PolicyRule; policyRule != nil {
+			policyRuleStr, err := structure.FlattenJsonToString(policyRule.(map[string]interface{}))
+			if err != nil {
+				return fmt.Errorf("unable to flatten JSON for `policy_rule`: %s", err)
+			}
+
+			d.Set("policy_rule", policyRuleStr)
+		}
+
+		if parameters := props.Parameters; parameters != nil {
+			parametersStr, err := structure.FlattenJsonToString(parameters.(map[string]interface{}))
+			if err != nil {
+				return fmt.Errorf("unable to flatten JSON for `parameters`: %s", err)
+			}
+
+			d.Set("parameters", parametersStr)
+		}
+
+		if metadata := props.Metadata; metadata != nil {
+			metadataStr, err := structure.FlattenJsonToString(metadata.(map[string]interface{}))
+			if err != nil {
+				return fmt.Errorf("unable to flatten JSON for `metadata`: %s", err)
+			}
+
+			d.Set("metadata", metadataStr)
+		}
+	}
+
+	return nil
+}
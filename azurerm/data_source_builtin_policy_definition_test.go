@@ -0,0 +1,52 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMBuiltInPolicyDefinition_allowedLocations(t *testing.T) {
+	dataSourceName := "data.azurerm_builtin_policy_definition.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceBuiltInPolicyDefinition("Allowed locations"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "/providers/Microsoft.Authorization/policyDefinitions/e56962a6-4747-49cd-b67b-bf8b01975c4c"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "e56962a6-4747-49cd-b67b-bf8b01975c4c"),
+					resource.TestCheckResourceAttr(dataSourceName, "display_name", "Allowed locations"),
+					resource.TestCheckResourceAttr(dataSourceName, "policy_type", "BuiltIn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "description"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "policy_rule"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "parameters"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMBuiltInPolicyDefinition_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceBuiltInPolicyDefinition("acctest-does-not-exist"),
+				ExpectError: regexp.MustCompile("A Built-In Policy Definition with the Display Name"),
+			},
+		},
+	})
+}
+
+func testAccDataSourceBuiltInPolicyDefinition(displayName string) string {
+	return fmt.Sprintf(`
+data "azurerm_builtin_policy_definition" "test" {
+  display_name = "%s"
+}
+`, displayName)
+}
@@ -0,0 +1,185 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceArmKeyVaultSecrets() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmKeyVaultSecretsRead,
+
+		Schema: map[string]*schema.Schema{
+			"vault_uri": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"include_versions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"secrets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"versions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmKeyVaultSecretsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+	ctx := meta.(*ArmClient).StopContext
+
+	vaultUri := d.Get("vault_uri").(string)
+	includeVersions := d.Get("include_versions").(bool)
+
+	iterator, err := client.GetSecretsComplete(ctx, vaultUri, nil)
+	if err != nil {
+		return fmt.Errorf("Error listing Secrets in KeyVault %q: %+v", vaultUri, err)
+	}
+
+	names := make([]string, 0)
+	secrets := make(map[string]map[string]interface{})
+	for iterator.NotDone() {
+		item := iterator.Value()
+		if item.ID != nil {
+			name, err := parseKeyVaultSecretNameFromID(*item.ID)
+			if err != nil {
+				return err
+			}
+
+			enabled := true
+			if attributes := item.Attributes; attributes != nil && attributes.Enabled != nil {
+				enabled = *attributes.Enabled
+			}
+
+			names = append(names, name)
+			secrets[name] = map[string]interface{}{
+				"name":    name,
+				"id":      *item.ID,
+				"enabled": enabled,
+			}
+		}
+
+		if err := iterator.Next(); err != nil {
+			return fmt.Errorf("Error listing Secrets in KeyVault %q: %+v", vaultUri, err)
+		}
+	}
+
+	// sort the names so that the ordering is consistent between runs
+	sort.Strings(names)
+
+	output := make([]interface{}, 0)
+	for _, name := range names {
+		secret := secrets[name]
+
+		versions := make([]interface{}, 0)
+		if includeVersions {
+			versions, err = listKeyVaultSecretVersions(ctx, client, vaultUri, name)
+			if err != nil {
+				return err
+			}
+		}
+		secret["versions"] = versions
+
+		output = append(output, secret)
+	}
+
+	d.SetId(fmt.Sprintf("%s/secrets", strings.TrimSuffix(vaultUri, "/")))
+
+	d.Set("vault_uri", vaultUri)
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("Error setting `names`: %+v", err)
+	}
+	if err := d.Set("secrets", output); err != nil {
+		return fmt.Errorf("Error setting `secrets`: %+v", err)
+	}
+
+	return nil
+}
+
+func listKeyVaultSecretVersions(ctx context.Context, client keyvault.BaseClient, vaultUri string, name string) ([]interface{}, error) {
+	iterator, err := client.GetSecretVersionsComplete(ctx, vaultUri, name, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Versions of Secret %q in KeyVault %q: %+v", name, vaultUri, err)
+	}
+
+	versions := make([]string, 0)
+	for iterator.NotDone() {
+		item := iterator.Value()
+		if item.ID != nil {
+			id, err := parseKeyVaultChildID(*item.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			versions = append(versions, id.Version)
+		}
+
+		if err := iterator.Next(); err != nil {
+			return nil, fmt.Errorf("Error listing Versions of Secret %q in KeyVault %q: %+v", name, vaultUri, err)
+		}
+	}
+
+	sort.Strings(versions)
+
+	output := make([]interface{}, 0)
+	for _, v := range versions {
+		output = append(output, v)
+	}
+	return output, nil
+}
+
+func parseKeyVaultSecretNameFromID(id string) (string, error) {
+	// example: https://tharvey-keyvault.vault.azure.net/secrets/bird
+	idURL, err := url.ParseRequestURI(id)
+	if err != nil {
+		return "", fmt.Errorf("Cannot parse Azure KeyVault Secret Id: %s", err)
+	}
+
+	components := strings.Split(strings.Trim(idURL.Path, "/"), "/")
+	if len(components) != 2 || components[1] == "" {
+		return "", fmt.Errorf("Azure KeyVault Secret Id should have 2 segments, got %d: '%s'", len(components), idURL.Path)
+	}
+
+	return components[1], nil
+}
@@ -0,0 +1,166 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestParseKeyVaultSecretNameFromID(t *testing.T) {
+	cases := []struct {
+		Input       string
+		Expected    string
+		ExpectError bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/secrets",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/secrets/hello-world",
+			Expected:    "hello-world",
+			ExpectError: false,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/secrets/hello-world/",
+			Expected:    "hello-world",
+			ExpectError: false,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/secrets/hello-world/fdf067c93bbb4b22bff4d8b7a9a56217",
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		name, err := parseKeyVaultSecretNameFromID(tc.Input)
+		if err != nil {
+			if tc.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Got error for ID '%s': %+v", tc.Input, err)
+		}
+
+		if tc.ExpectError {
+			t.Fatalf("Expected an error for ID '%s' but didn't get one", tc.Input)
+		}
+
+		if name != tc.Expected {
+			t.Fatalf("Expected the name to be %q for ID '%s' but got %q", tc.Expected, tc.Input, name)
+		}
+	}
+}
+
+func TestAccDataSourceAzureRMKeyVaultSecrets_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_key_vault_secrets.test"
+
+	rString := acctest.RandString(8)
+	location := testLocation()
+	config := testAccDataSourceKeyVaultSecrets_basic(rString, location, false)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "names.0", fmt.Sprintf("first-%s", rString)),
+					resource.TestCheckResourceAttr(dataSourceName, "names.1", fmt.Sprintf("second-%s", rString)),
+					resource.TestCheckResourceAttr(dataSourceName, "secrets.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "secrets.0.enabled", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "secrets.0.versions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMKeyVaultSecrets_includeVersions(t *testing.T) {
+	dataSourceName := "data.azurerm_key_vault_secrets.test"
+
+	rString := acctest.RandString(8)
+	location := testLocation()
+	config := testAccDataSourceKeyVaultSecrets_basic(rString, location, true)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "secrets.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "secrets.0.versions.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "secrets.1.versions.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceKeyVaultSecrets_basic(rString string, location string, includeVersions bool) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]s"
+  location = "%[2]s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv-%[1]s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  sku {
+    name = "premium"
+  }
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+    key_permissions = [
+      "get",
+    ]
+
+    secret_permissions = [
+      "get",
+      "delete",
+      "list",
+      "set",
+    ]
+  }
+}
+
+resource "azurerm_key_vault_secret" "first" {
+  name      = "first-%[1]s"
+  value     = "rick-and-morty"
+  vault_uri = "${azurerm_key_vault.test.vault_uri}"
+}
+
+resource "azurerm_key_vault_secret" "second" {
+  name      = "second-%[1]s"
+  value     = "szechuan"
+  vault_uri = "${azurerm_key_vault.test.vault_uri}"
+}
+
+data "azurerm_key_vault_secrets" "test" {
+  vault_uri        = "${azurerm_key_vault.test.vault_uri}"
+  include_versions = %[3]t
+
+  depends_on = ["azurerm_key_vault_secret.first", "azurerm_key_vault_secret.second"]
+}
+`, rString, location, includeVersions)
+}
@@ -84,6 +84,47 @@ func dataSourceArmKubernetesCluster() *schema.Resource {
 				Sensitive: true,
 			},
 
+			"kube_admin_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"password": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"client_certificate": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_key": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"cluster_ca_certificate": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"kube_admin_config_raw": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
 			"linux_profile": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -248,6 +289,41 @@ func dataSourceArmKubernetesCluster() *schema.Resource {
 				},
 			},
 
+			"role_based_access_control": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"azure_active_directory": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"client_app_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"server_app_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"tenant_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"tags": tagsForDataSourceSchema(),
 		},
 	}
@@ -309,6 +385,11 @@ func dataSourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{}
 			return fmt.Errorf("Error setting `network_profile`: %+v", err)
 		}
 
+		roleBasedAccessControl := flattenKubernetesClusterDataSourceRoleBasedAccessControl(props)
+		if err := d.Set("role_based_access_control", roleBasedAccessControl); err != nil {
+			return fmt.Errorf("Error setting `role_based_access_control`: %+v", err)
+		}
+
 		servicePrincipal := flattenKubernetesClusterDataSourceServicePrincipalProfile(props.ServicePrincipalProfile)
 		if err := d.Set("service_principal", servicePrincipal); err != nil {
 			return fmt.Errorf("Error setting `service_principal`: %+v", err)
@@ -322,6 +403,23 @@ func dataSourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error setting `kube_config`: %+v", err)
 	}
 
+	// the Admin credentials are only distinct from the User credentials when Azure Active Directory integration is enabled
+	var kubeAdminConfigRaw *string
+	kubeAdminConfig := make([]interface{}, 0)
+	if props := resp.ManagedClusterProperties; props != nil && props.AadProfile != nil {
+		adminProfile, err := kubernetesClustersClient.GetAccessProfile(ctx, resourceGroup, name, "clusterAdmin")
+		if err != nil {
+			return fmt.Errorf("Error getting admin access profile while making Read request on AKS Managed Cluster %q (resource group %q): %+v", name, resourceGroup, err)
+		}
+
+		kubeAdminConfigRaw, kubeAdminConfig = flattenKubernetesClusterDataSourceAccessProfile(&adminProfile)
+	}
+	d.Set("kube_admin_config_raw", kubeAdminConfigRaw)
+
+	if err := d.Set("kube_admin_config", kubeAdminConfig); err != nil {
+		return fmt.Errorf("Error setting `kube_admin_config`: %+v", err)
+	}
+
 	flattenAndSetTags(d, resp.Tags)
 
 	return nil
@@ -398,6 +496,39 @@ func flattenKubernetesClusterDataSourceAgentPoolProfiles(input *[]containerservi
 	return agentPoolProfiles
 }
 
+func flattenKubernetesClusterDataSourceRoleBasedAccessControl(input *containerservice.ManagedClusterProperties) []interface{} {
+	rbacEnabled := false
+	if input.EnableRBAC != nil {
+		rbacEnabled = *input.EnableRBAC
+	}
+
+	results := make([]interface{}, 0)
+	if profile := input.AadProfile; profile != nil {
+		output := make(map[string]interface{})
+
+		if profile.ClientAppID != nil {
+			output["client_app_id"] = *profile.ClientAppID
+		}
+
+		if profile.ServerAppID != nil {
+			output["server_app_id"] = *profile.ServerAppID
+		}
+
+		if profile.TenantID != nil {
+			output["tenant_id"] = *profile.TenantID
+		}
+
+		results = append(results, output)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled":                rbacEnabled,
+			"azure_active_directory": results,
+		},
+	}
+}
+
 func flattenKubernetesClusterDataSourceServicePrincipalProfile(profile *containerservice.ManagedClusterServicePrincipalProfile) []interface{} {
 	if profile == nil {
 		return []interface{}{}
@@ -422,7 +553,13 @@ func flattenKubernetesClusterDataSourceAccessProfile(profile *containerservice.M
 
 		kubeConfig, err := kubernetes.ParseKubeConfig(rawConfig)
 		if err != nil {
-			return utils.String(rawConfig), []interface{}{}
+			// clusters with Azure Active Directory integration use the `azure` auth provider instead
+			kubeConfigAAD, err := kubernetes.ParseKubeConfigAAD(rawConfig)
+			if err != nil {
+				return utils.String(rawConfig), []interface{}{}
+			}
+
+			return utils.String(rawConfig), flattenKubernetesClusterDataSourceKubeConfigAAD(*kubeConfigAAD)
 		}
 
 		flattenedKubeConfig := flattenKubernetesClusterDataSourceKubeConfig(*kubeConfig)
@@ -449,6 +586,23 @@ func flattenKubernetesClusterDataSourceKubeConfig(config kubernetes.KubeConfig)
 	return []interface{}{values}
 }
 
+func flattenKubernetesClusterDataSourceKubeConfigAAD(config kubernetes.KubeConfigAAD) []interface{} {
+	values := make(map[string]interface{})
+
+	cluster := config.Clusters[0].Cluster
+	name := config.Users[0].Name
+
+	// credentials for Azure Active Directory are obtained interactively, so aren't available here
+	values["host"] = cluster.Server
+	values["username"] = name
+	values["password"] = ""
+	values["client_certificate"] = ""
+	values["client_key"] = ""
+	values["cluster_ca_certificate"] = cluster.ClusterAuthorityData
+
+	return []interface{}{values}
+}
+
 func flattenKubernetesClusterDataSourceNetworkProfile(profile *containerservice.NetworkProfile) []interface{} {
 	values := make(map[string]interface{})
 
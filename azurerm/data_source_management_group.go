@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2018-03-01-preview/management"
@@ -14,13 +15,17 @@ func dataSourceArmManagementGroup() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"group_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"display_name"},
 			},
 
 			"display_name": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"group_id"},
 			},
 
 			"parent_management_group_id": {
@@ -43,6 +48,19 @@ func dataSourceArmManagementGroupRead(d *schema.ResourceData, meta interface{})
 	ctx := meta.(*ArmClient).StopContext
 
 	groupId := d.Get("group_id").(string)
+	displayName := d.Get("display_name").(string)
+
+	if groupId == "" {
+		if displayName == "" {
+			return fmt.Errorf("Either `group_id` or `display_name` must be specified")
+		}
+
+		id, err := getManagementGroupIdByDisplayName(ctx, client, displayName)
+		if err != nil {
+			return err
+		}
+		groupId = *id
+	}
 
 	recurse := true
 	resp, err := client.Get(ctx, groupId, "children", &recurse, "", managementGroupCacheControl)
@@ -51,7 +69,7 @@ func dataSourceArmManagementGroupRead(d *schema.ResourceData, meta interface{})
 			return fmt.Errorf("Management Group %q was not found", groupId)
 		}
 
-		return fmt.Errorf("Error reading Management Group %q: %+v", d.Id(), err)
+		return fmt.Errorf("Error reading Management Group %q: %+v", groupId, err)
 	}
 
 	d.SetId(*resp.ID)
@@ -104,3 +122,34 @@ func flattenArmManagementGroupDataSourceSubscriptionIds(input *[]managementgroup
 
 	return subscriptionIds, nil
 }
+
+func getManagementGroupIdByDisplayName(ctx context.Context, client managementgroups.Client, displayName string) (*string, error) {
+	iterator, err := client.ListComplete(ctx, managementGroupCacheControl, "")
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Management Groups: %+v", err)
+	}
+
+	results := make([]string, 0)
+	for iterator.NotDone() {
+		group := iterator.Value()
+		if props := group.InfoProperties; props != nil && props.DisplayName != nil && group.Name != nil {
+			if *props.DisplayName == displayName {
+				results = append(results, *group.Name)
+			}
+		}
+
+		if err := iterator.Next(); err != nil {
+			return nil, fmt.Errorf("Error listing Management Groups: %+v", err)
+		}
+	}
+
+	if len(results) == 0 {
+		return nil, fmt.Errorf("No Management Groups were found with the Display Name %q", displayName)
+	}
+
+	if len(results) > 1 {
+		return nil, fmt.Errorf("Expected a single Management Group with the Display Name %q but got %d - please use `group_id` instead", displayName, len(results))
+	}
+
+	return &results[0], nil
+}
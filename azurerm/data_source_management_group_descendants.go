@@ -0,0 +1,206 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2018-03-01-preview/management"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmManagementGroupDescendants() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmManagementGroupDescendantsRead,
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"display_name"},
+			},
+
+			"display_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"group_id"},
+			},
+
+			"management_group_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"subscription_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"management_groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"parent_management_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"subscription_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmManagementGroupDescendantsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).managementGroupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	groupId := d.Get("group_id").(string)
+	displayName := d.Get("display_name").(string)
+
+	if groupId == "" {
+		if displayName == "" {
+			return fmt.Errorf("Either `group_id` or `display_name` must be specified")
+		}
+
+		id, err := getManagementGroupIdByDisplayName(ctx, client, displayName)
+		if err != nil {
+			return err
+		}
+		groupId = *id
+	}
+
+	recurse := true
+	resp, err := client.Get(ctx, groupId, "children", &recurse, "", managementGroupCacheControl)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Management Group %q was not found", groupId)
+		}
+
+		return fmt.Errorf("Error reading Management Group %q: %+v", groupId, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Error reading Management Group %q: `id` was nil", groupId)
+	}
+
+	d.SetId(*resp.ID)
+	d.Set("group_id", groupId)
+
+	descendants := managementGroupDescendants{
+		managementGroupIds: make([]interface{}, 0),
+		subscriptionIds:    make([]interface{}, 0),
+		managementGroups:   make([]interface{}, 0),
+	}
+
+	if props := resp.Properties; props != nil {
+		d.Set("display_name", props.DisplayName)
+
+		if err := descendants.flatten(*resp.ID, props.Children); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("management_group_ids", descendants.managementGroupIds); err != nil {
+		return fmt.Errorf("Error setting `management_group_ids`: %+v", err)
+	}
+
+	if err := d.Set("subscription_ids", descendants.subscriptionIds); err != nil {
+		return fmt.Errorf("Error setting `subscription_ids`: %+v", err)
+	}
+
+	if err := d.Set("management_groups", descendants.managementGroups); err != nil {
+		return fmt.Errorf("Error setting `management_groups`: %+v", err)
+	}
+
+	return nil
+}
+
+type managementGroupDescendants struct {
+	managementGroupIds []interface{}
+	subscriptionIds    []interface{}
+	managementGroups   []interface{}
+}
+
+// flatten walks the tree of children depth-first, recording each Management Group and Subscription
+func (o *managementGroupDescendants) flatten(parentId string, children *[]managementgroups.ChildInfo) error {
+	if children == nil {
+		return nil
+	}
+
+	for _, child := range *children {
+		if child.ID == nil {
+			continue
+		}
+
+		id := *child.ID
+
+		if strings.EqualFold(string(child.Type), string(managementgroups.Type1Subscriptions)) {
+			subscriptionId, err := parseManagementGroupSubscriptionID(id)
+			if err != nil {
+				return fmt.Errorf("Unable to parse child Subscription ID: %+v", err)
+			}
+
+			if subscriptionId != nil {
+				o.subscriptionIds = append(o.subscriptionIds, subscriptionId.subscriptionId)
+			}
+			continue
+		}
+
+		groupId, err := parseManagementGroupId(id)
+		if err != nil {
+			return fmt.Errorf("Unable to parse child Management Group ID %q: %+v", id, err)
+		}
+
+		displayName := ""
+		if child.DisplayName != nil {
+			displayName = *child.DisplayName
+		}
+
+		subscriptionIds, err := flattenArmManagementGroupDataSourceSubscriptionIds(child.Children)
+		if err != nil {
+			return fmt.Errorf("Error flattening `subscription_ids` for Management Group %q: %+v", id, err)
+		}
+
+		o.managementGroupIds = append(o.managementGroupIds, id)
+		o.managementGroups = append(o.managementGroups, map[string]interface{}{
+			"id":                         id,
+			"group_id":                   groupId.groupId,
+			"display_name":               displayName,
+			"parent_management_group_id": parentId,
+			"subscription_ids":           subscriptionIds.List(),
+		})
+
+		if err := o.flatten(id, child.Children); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2018-03-01-preview/management"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAzureRMManagementGroupDescendants_flatten(t *testing.T) {
+	children := []managementgroups.ChildInfo{
+		{
+			Type: managementgroups.Type1Subscriptions,
+			ID:   utils.String("/subscriptions/00000000-0000-0000-0000-000000000000"),
+		},
+		{
+			Type:        managementgroups.Type1ProvidersMicrosoftManagementmanagementGroups,
+			ID:          utils.String("/providers/Microsoft.Management/managementGroups/child"),
+			DisplayName: utils.String("Child"),
+			Children: &[]managementgroups.ChildInfo{
+				{
+					Type: managementgroups.Type1Subscriptions,
+					ID:   utils.String("/subscriptions/11111111-1111-1111-1111-111111111111"),
+				},
+				{
+					Type: managementgroups.Type1ProvidersMicrosoftManagementmanagementGroups,
+					ID:   utils.String("/providers/Microsoft.Management/managementGroups/grandchild"),
+				},
+			},
+		},
+	}
+
+	descendants := managementGroupDescendants{}
+	if err := descendants.flatten("/providers/Microsoft.Management/managementGroups/root", &children); err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	expectedGroupIds := []string{
+		"/providers/Microsoft.Management/managementGroups/child",
+		"/providers/Microsoft.Management/managementGroups/grandchild",
+	}
+	if len(descendants.managementGroupIds) != len(expectedGroupIds) {
+		t.Fatalf("Expected %d Management Groups but got %d", len(expectedGroupIds), len(descendants.managementGroupIds))
+	}
+	for i, v := range expectedGroupIds {
+		if descendants.managementGroupIds[i] != v {
+			t.Fatalf("Expected Management Group %d to be %q but got %q", i, v, descendants.managementGroupIds[i])
+		}
+	}
+
+	expectedSubscriptionIds := []string{
+		"00000000-0000-0000-0000-000000000000",
+		"11111111-1111-1111-1111-111111111111",
+	}
+	if len(descendants.subscriptionIds) != len(expectedSubscriptionIds) {
+		t.Fatalf("Expected %d Subscriptions but got %d", len(expectedSubscriptionIds), len(descendants.subscriptionIds))
+	}
+	for i, v := range expectedSubscriptionIds {
+		if descendants.subscriptionIds[i] != v {
+			t.Fatalf("Expected Subscription %d to be %q but got %q", i, v, descendants.subscriptionIds[i])
+		}
+	}
+
+	grandchild := descendants.managementGroups[1].(map[string]interface{})
+	if v := grandchild["parent_management_group_id"]; v != "/providers/Microsoft.Management/managementGroups/child" {
+		t.Fatalf("Expected the Parent of the grandchild to be the child but got %q", v)
+	}
+	if v := grandchild["group_id"]; v != "grandchild" {
+		t.Fatalf("Expected the `group_id` of the grandchild to be `grandchild` but got %q", v)
+	}
+}
+
+func TestAccDataSourceArmManagementGroupDescendants_nested(t *testing.T) {
+	dataSourceName := "data.azurerm_management_group_descendants.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceArmManagementGroupDescendants_nested(ri),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "azurerm_management_group.root", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "management_group_ids.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "management_groups.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "subscription_ids.#", "0"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "management_groups.0.id", "azurerm_management_group.child", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "management_groups.1.id", "azurerm_management_group.grandchild", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "management_groups.1.parent_management_group_id", "azurerm_management_group.child", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceArmManagementGroupDescendants_nested(rInt int) string {
+	return fmt.Sprintf(`
+resource "azurerm_management_group" "root" {
+  display_name = "acctestmg-%d"
+}
+
+resource "azurerm_management_group" "child" {
+  display_name               = "acctestmg-child-%d"
+  parent_management_group_id = "${azurerm_management_group.root.id}"
+}
+
+resource "azurerm_management_group" "grandchild" {
+  display_name               = "acctestmg-grandchild-%d"
+  parent_management_group_id = "${azurerm_management_group.child.id}"
+}
+
+data "azurerm_management_group_descendants" "test" {
+  group_id = "${azurerm_management_group.root.group_id}"
+
+  depends_on = ["azurerm_management_group.grandchild"]
+}
+`, rInt, rInt, rInt)
+}
@@ -27,6 +27,26 @@ func TestAccDataSourceArmManagementGroup_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceArmManagementGroup_byDisplayName(t *testing.T) {
+	dataSourceName := "data.azurerm_management_group.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceArmManagementGroup_byDisplayName(ri),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "group_id", "azurerm_management_group.test", "group_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "display_name", fmt.Sprintf("acctestmg-%d", ri)),
+					resource.TestCheckResourceAttr(dataSourceName, "subscription_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourceArmManagementGroup_basic(rInt int) string {
 	return fmt.Sprintf(`
 resource "azurerm_management_group" "test" {
@@ -38,3 +58,15 @@ data "azurerm_management_group" "test" {
 }
 `, rInt)
 }
+
+func testAccDataSourceArmManagementGroup_byDisplayName(rInt int) string {
+	return fmt.Sprintf(`
+resource "azurerm_management_group" "test" {
+  display_name = "acctestmg-%d"
+}
+
+data "azurerm_management_group" "test" {
+  display_name = "${azurerm_management_group.test.display_name}"
+}
+`, rInt)
+}
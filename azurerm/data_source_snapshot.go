@@ -1,9 +1,14 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -12,8 +17,18 @@ func dataSourceArmSnapshot() *schema.Resource {
 		Read: dataSourceArmSnapshotRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"source_disk_id"},
+			},
+
+			// when specified the most recent Snapshot of this Disk within the Resource Group is returned
+			"source_disk_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  azure.ValidateResourceID,
+				ConflictsWith: []string{"name"},
 			},
 
 			"resource_group_name": resourceGroupNameForDataSourceSchema(),
@@ -104,16 +119,37 @@ func dataSourceArmSnapshotRead(d *schema.ResourceData, meta interface{}) error {
 
 	resourceGroup := d.Get("resource_group_name").(string)
 	name := d.Get("name").(string)
+	sourceDiskId := d.Get("source_disk_id").(string)
 
-	resp, err := client.Get(ctx, resourceGroup, name)
-	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
-			return fmt.Errorf("Error: Snapshot %q (Resource Group %q) was not found", name, resourceGroup)
+	if name == "" && sourceDiskId == "" {
+		return fmt.Errorf("Error: either `name` or `source_disk_id` must be specified")
+	}
+
+	var resp compute.Snapshot
+	if sourceDiskId != "" {
+		latest, err := findLatestSnapshotOfDisk(ctx, client, resourceGroup, sourceDiskId)
+		if err != nil {
+			return err
+		}
+
+		if latest == nil {
+			return fmt.Errorf("Error: No Snapshots of Disk %q were found in Resource Group %q", sourceDiskId, resourceGroup)
+		}
+
+		resp = *latest
+	} else {
+		var err error
+		resp, err = client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Error: Snapshot %q (Resource Group %q) was not found", name, resourceGroup)
+			}
+			return fmt.Errorf("Error loading Snapshot %q (Resource Group %q): %+v", name, resourceGroup, err)
 		}
-		return fmt.Errorf("Error loading Snapshot %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
 	d.SetId(*resp.ID)
+	d.Set("name", resp.Name)
 
 	if props := resp.SnapshotProperties; props != nil {
 		d.Set("os_type", string(props.OsType))
@@ -143,3 +179,58 @@ func dataSourceArmSnapshotRead(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+// findLatestSnapshotOfDisk returns the most recently created Snapshot within the Resource Group
+// which was taken of the specified Disk, or nil if there isn't one
+func findLatestSnapshotOfDisk(ctx context.Context, client compute.SnapshotsClient, resourceGroup string, diskId string) (*compute.Snapshot, error) {
+	iterator, err := client.ListByResourceGroupComplete(ctx, resourceGroup)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Snapshots (Resource Group %q): %+v", resourceGroup, err)
+	}
+
+	var latest *compute.Snapshot
+	for iterator.NotDone() {
+		snapshot := iterator.Value()
+		if snapshotIsOfDisk(snapshot, diskId) && snapshotIsNewer(snapshot, latest) {
+			latest = &snapshot
+		}
+
+		if err := iterator.Next(); err != nil {
+			return nil, fmt.Errorf("Error listing Snapshots (Resource Group %q): %+v", resourceGroup, err)
+		}
+	}
+
+	return latest, nil
+}
+
+func snapshotIsOfDisk(snapshot compute.Snapshot, diskId string) bool {
+	if props := snapshot.SnapshotProperties; props != nil && props.CreationData != nil {
+		data := props.CreationData
+		if data.SourceResourceID != nil && strings.EqualFold(*data.SourceResourceID, diskId) {
+			return true
+		}
+		if data.SourceURI != nil && strings.EqualFold(*data.SourceURI, diskId) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func snapshotIsNewer(snapshot compute.Snapshot, other *compute.Snapshot) bool {
+	if other == nil {
+		return true
+	}
+
+	created := snapshotTimeCreated(snapshot)
+	otherCreated := snapshotTimeCreated(*other)
+	return created.After(otherCreated)
+}
+
+func snapshotTimeCreated(snapshot compute.Snapshot) time.Time {
+	if props := snapshot.SnapshotProperties; props != nil && props.TimeCreated != nil {
+		return props.TimeCreated.Time
+	}
+
+	return time.Time{}
+}
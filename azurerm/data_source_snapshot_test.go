@@ -48,6 +48,25 @@ func TestAccDataSourceAzureRMSnapshot_importEncryption(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceAzureRMSnapshot_latestOfDisk(t *testing.T) {
+	dataSourceName := "data.azurerm_snapshot.snapshot"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMSnapshot_latestOfDisk(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", "azurerm_snapshot.second", "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "azurerm_snapshot.second", "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourceAzureRMSnapshot_basic(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -178,3 +197,44 @@ data "azurerm_snapshot" "snapshot" {
 }
 `, rInt, location, rInt, rString, rInt)
 }
+
+func testAccDataSourceAzureRMSnapshot_latestOfDisk(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_managed_disk" "test" {
+  name                 = "acctestmd-%[1]d"
+  location             = "${azurerm_resource_group.test.location}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = "10"
+}
+
+resource "azurerm_snapshot" "first" {
+  name                = "acctestss1_%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  create_option       = "Copy"
+  source_uri          = "${azurerm_managed_disk.test.id}"
+}
+
+resource "azurerm_snapshot" "second" {
+  name                = "acctestss2_%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  create_option       = "Copy"
+  source_uri          = "${azurerm_managed_disk.test.id}"
+  depends_on          = ["azurerm_snapshot.first"]
+}
+
+data "azurerm_snapshot" "snapshot" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  source_disk_id      = "${azurerm_managed_disk.test.id}"
+  depends_on          = ["azurerm_snapshot.second"]
+}
+`, rInt, location)
+}
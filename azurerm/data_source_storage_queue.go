@@ -0,0 +1,64 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceArmStorageQueue() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmStorageQueueRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArmStorageQueueName,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"storage_account_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmStorageQueueRead(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx := armClient.StopContext
+	environment := armClient.environment
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	storageAccountName := d.Get("storage_account_name").(string)
+
+	queueClient, accountExists, err := armClient.getQueueServiceClientForStorageAccount(ctx, resourceGroup, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Error: Storage Account %q (Resource Group %q) was not found", storageAccountName, resourceGroup)
+	}
+
+	queueReference := queueClient.GetQueueReference(name)
+	exists, err := queueReference.Exists()
+	if err != nil {
+		return fmt.Errorf("Error checking if Queue %q exists (Storage Account %q / Resource Group %q): %s", name, storageAccountName, resourceGroup, err)
+	}
+	if !exists {
+		return fmt.Errorf("Error: Queue %q (Storage Account %q / Resource Group %q) was not found", name, storageAccountName, resourceGroup)
+	}
+
+	// matches the ID format used by the `azurerm_storage_queue` resource
+	d.SetId(fmt.Sprintf("https://%s.queue.%s/%s", storageAccountName, environment.StorageEndpointSuffix, name))
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("storage_account_name", storageAccountName)
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMStorageQueue_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_storage_queue.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMStorageQueue_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "azurerm_storage_queue.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMStorageQueue_basic(rInt int, rString string, location string) string {
+	config := testAccAzureRMStorageQueue_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_queue" "test" {
+  name                 = "${azurerm_storage_queue.test.name}"
+  resource_group_name  = "${azurerm_storage_queue.test.resource_group_name}"
+  storage_account_name = "${azurerm_storage_queue.test.storage_account_name}"
+}
+`, config)
+}
@@ -0,0 +1,79 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceArmStorageShare() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmStorageShareRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArmStorageShareName,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"storage_account_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"quota": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmStorageShareRead(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx := armClient.StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	storageAccountName := d.Get("storage_account_name").(string)
+
+	fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(ctx, resourceGroup, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Error: Storage Account %q (Resource Group %q) was not found", storageAccountName, resourceGroup)
+	}
+
+	reference := fileClient.GetShareReference(name)
+	exists, err := reference.Exists()
+	if err != nil {
+		return fmt.Errorf("Error checking if Share %q exists (Storage Account %q / Resource Group %q): %s", name, storageAccountName, resourceGroup, err)
+	}
+	if !exists {
+		return fmt.Errorf("Error: Share %q (Storage Account %q / Resource Group %q) was not found", name, storageAccountName, resourceGroup)
+	}
+
+	if err := reference.FetchAttributes(nil); err != nil {
+		return fmt.Errorf("Error retrieving properties for Share %q (Storage Account %q / Resource Group %q): %s", name, storageAccountName, resourceGroup, err)
+	}
+
+	// matches the ID format used by the `azurerm_storage_share` resource
+	d.SetId(fmt.Sprintf("%s/%s/%s", name, resourceGroup, storageAccountName))
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("storage_account_name", storageAccountName)
+	d.Set("quota", reference.Properties.Quota)
+	d.Set("url", reference.URL())
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMStorageShare_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_storage_share.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMStorageShare_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "azurerm_storage_share.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "quota", "5120"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "url"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMStorageShare_basic(rInt int, rString string, location string) string {
+	config := testAccAzureRMStorageShare_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_share" "test" {
+  name                 = "${azurerm_storage_share.test.name}"
+  resource_group_name  = "${azurerm_storage_share.test.resource_group_name}"
+  storage_account_name = "${azurerm_storage_share.test.storage_account_name}"
+}
+`, config)
+}
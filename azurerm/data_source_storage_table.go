@@ -0,0 +1,72 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceArmStorageTable() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmStorageTableRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArmStorageTableName,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"storage_account_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmStorageTableRead(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx := armClient.StopContext
+	environment := armClient.environment
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	storageAccountName := d.Get("storage_account_name").(string)
+
+	tableClient, accountExists, err := armClient.getTableServiceClientForStorageAccount(ctx, resourceGroup, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Error: Storage Account %q (Resource Group %q) was not found", storageAccountName, resourceGroup)
+	}
+
+	tables, err := tableClient.QueryTables(storage.MinimalMetadata, &storage.QueryTablesOptions{})
+	if err != nil {
+		return fmt.Errorf("Error retrieving Tables in Storage Account %q (Resource Group %q): %s", storageAccountName, resourceGroup, err)
+	}
+
+	found := false
+	for _, table := range tables.Tables {
+		if table.Name == name {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("Error: Table %q (Storage Account %q / Resource Group %q) was not found", name, storageAccountName, resourceGroup)
+	}
+
+	// matches the ID format used by the `azurerm_storage_table` resource
+	d.SetId(fmt.Sprintf("https://%s.table.%s/%s", storageAccountName, environment.StorageEndpointSuffix, name))
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("storage_account_name", storageAccountName)
+
+	return nil
+}
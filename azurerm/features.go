@@ -0,0 +1,106 @@
+package azurerm
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// features are opt-in behaviours of the Provider which apply across every instance of a Resource,
+// such as whether a Resource is purged or detached when it's destroyed
+type features struct {
+	keyVault       keyVaultFeatures
+	virtualMachine virtualMachineFeatures
+}
+
+type keyVaultFeatures struct {
+	purgeSoftDeleteOnDestroy    bool
+	recoverSoftDeletedKeyVaults bool
+}
+
+type virtualMachineFeatures struct {
+	deleteOSDiskOnDeletion    bool
+	deleteDataDisksOnDeletion bool
+}
+
+func schemaFeatures() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key_vault": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"purge_soft_delete_on_destroy": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+
+							"recover_soft_deleted_key_vaults": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+						},
+					},
+				},
+
+				"virtual_machine": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"delete_os_disk_on_deletion": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+
+							"delete_data_disks_on_deletion": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandFeatures(input []interface{}) features {
+	output := features{}
+	if len(input) == 0 || input[0] == nil {
+		return output
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	if items, ok := raw["key_vault"].([]interface{}); ok && len(items) > 0 && items[0] != nil {
+		keyVaultRaw := items[0].(map[string]interface{})
+		if v, ok := keyVaultRaw["purge_soft_delete_on_destroy"]; ok {
+			output.keyVault.purgeSoftDeleteOnDestroy = v.(bool)
+		}
+		if v, ok := keyVaultRaw["recover_soft_deleted_key_vaults"]; ok {
+			output.keyVault.recoverSoftDeletedKeyVaults = v.(bool)
+		}
+	}
+
+	if items, ok := raw["virtual_machine"].([]interface{}); ok && len(items) > 0 && items[0] != nil {
+		virtualMachineRaw := items[0].(map[string]interface{})
+		if v, ok := virtualMachineRaw["delete_os_disk_on_deletion"]; ok {
+			output.virtualMachine.deleteOSDiskOnDeletion = v.(bool)
+		}
+		if v, ok := virtualMachineRaw["delete_data_disks_on_deletion"]; ok {
+			output.virtualMachine.deleteDataDisksOnDeletion = v.(bool)
+		}
+	}
+
+	return output
+}
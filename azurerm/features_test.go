@@ -0,0 +1,67 @@
+package azurerm
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExpandFeatures(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    []interface{}
+		Expected features
+	}{
+		{
+			Name:     "Empty Block",
+			Input:    []interface{}{},
+			Expected: features{},
+		},
+		{
+			Name: "Empty Nested Blocks",
+			Input: []interface{}{
+				map[string]interface{}{
+					"key_vault":       []interface{}{},
+					"virtual_machine": []interface{}{},
+				},
+			},
+			Expected: features{},
+		},
+		{
+			Name: "Complete",
+			Input: []interface{}{
+				map[string]interface{}{
+					"key_vault": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy":    true,
+							"recover_soft_deleted_key_vaults": true,
+						},
+					},
+					"virtual_machine": []interface{}{
+						map[string]interface{}{
+							"delete_os_disk_on_deletion":    true,
+							"delete_data_disks_on_deletion": false,
+						},
+					},
+				},
+			},
+			Expected: features{
+				keyVault: keyVaultFeatures{
+					purgeSoftDeleteOnDestroy:    true,
+					recoverSoftDeletedKeyVaults: true,
+				},
+				virtualMachine: virtualMachineFeatures{
+					deleteOSDiskOnDeletion:    true,
+					deleteDataDisksOnDeletion: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result, testCase.Expected) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected, result)
+		}
+	}
+}
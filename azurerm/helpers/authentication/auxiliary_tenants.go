@@ -0,0 +1,79 @@
+package authentication
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+)
+
+// the maximum number of Auxiliary Tenants supported by Azure Resource Manager
+const maxAuxiliaryTenants = 3
+
+const auxiliaryAuthorizationHeader = "x-ms-authorization-auxiliary"
+
+// AuxiliaryTenantAuthorizer wraps an Authorizer for the primary Tenant and additionally sends a
+// token for each Auxiliary Tenant, which Azure Resource Manager uses to authorize cross-tenant operations
+type AuxiliaryTenantAuthorizer struct {
+	primary   autorest.Authorizer
+	auxiliary []adal.OAuthTokenProvider
+}
+
+func NewAuxiliaryTenantAuthorizer(primary autorest.Authorizer, auxiliary []adal.OAuthTokenProvider) *AuxiliaryTenantAuthorizer {
+	return &AuxiliaryTenantAuthorizer{
+		primary:   primary,
+		auxiliary: auxiliary,
+	}
+}
+
+func (a *AuxiliaryTenantAuthorizer) WithAuthorization() autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := a.primary.WithAuthorization()(p).Prepare(r)
+			if err != nil {
+				return r, err
+			}
+
+			tokens := make([]string, 0)
+			for _, provider := range a.auxiliary {
+				if refresher, ok := provider.(adal.Refresher); ok {
+					if err := refresher.EnsureFresh(); err != nil {
+						return r, fmt.Errorf("Error refreshing the token for an Auxiliary Tenant: %+v", err)
+					}
+				}
+
+				tokens = append(tokens, fmt.Sprintf("Bearer %s", provider.OAuthToken()))
+			}
+
+			if len(tokens) == 0 {
+				return r, nil
+			}
+
+			return autorest.Prepare(r, autorest.WithHeader(auxiliaryAuthorizationHeader, strings.Join(tokens, ", ")))
+		})
+	}
+}
+
+func (c *Config) ValidateAuxiliaryTenants() error {
+	if len(c.AuxiliaryTenantIDs) == 0 {
+		return nil
+	}
+
+	if len(c.AuxiliaryTenantIDs) > maxAuxiliaryTenants {
+		return fmt.Errorf("A maximum of %d Auxiliary Tenants can be configured for the AzureRM provider, got %d", maxAuxiliaryTenants, len(c.AuxiliaryTenantIDs))
+	}
+
+	if c.ClientSecret == "" && c.ClientCertPath == "" {
+		return fmt.Errorf("Auxiliary Tenants can only be used when authenticating as a Service Principal using a Client Secret or Client Certificate")
+	}
+
+	for _, tenantId := range c.AuxiliaryTenantIDs {
+		if strings.EqualFold(tenantId, c.TenantID) {
+			return fmt.Errorf("The Tenant ID %q cannot also be specified as an Auxiliary Tenant", tenantId)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package authentication
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+)
+
+type testTokenProvider struct {
+	token string
+}
+
+func (t testTokenProvider) OAuthToken() string {
+	return t.token
+}
+
+func TestAuxiliaryTenantAuthorizer(t *testing.T) {
+	cases := []struct {
+		Description string
+		Auxiliary   []adal.OAuthTokenProvider
+		Expected    string
+	}{
+		{
+			Description: "No Auxiliary Tenants",
+			Auxiliary:   []adal.OAuthTokenProvider{},
+			Expected:    "",
+		},
+		{
+			Description: "Single Auxiliary Tenant",
+			Auxiliary: []adal.OAuthTokenProvider{
+				testTokenProvider{token: "first"},
+			},
+			Expected: "Bearer first",
+		},
+		{
+			Description: "Multiple Auxiliary Tenants",
+			Auxiliary: []adal.OAuthTokenProvider{
+				testTokenProvider{token: "first"},
+				testTokenProvider{token: "second"},
+			},
+			Expected: "Bearer first, Bearer second",
+		},
+	}
+
+	for _, v := range cases {
+		primary := autorest.NewBearerAuthorizer(testTokenProvider{token: "primary"})
+		authorizer := NewAuxiliaryTenantAuthorizer(primary, v.Auxiliary)
+
+		req, err := http.NewRequest(http.MethodGet, "https://management.azure.com/", nil)
+		if err != nil {
+			t.Fatalf("Error building request: %+v", err)
+		}
+
+		req, err = autorest.Prepare(req, authorizer.WithAuthorization())
+		if err != nil {
+			t.Fatalf("Expected there to be no error for %q - but got: %v", v.Description, err)
+		}
+
+		if actual := req.Header.Get("Authorization"); actual != "Bearer primary" {
+			t.Fatalf("Expected the Authorization header for %q to be `Bearer primary` but got %q", v.Description, actual)
+		}
+
+		if actual := req.Header.Get(auxiliaryAuthorizationHeader); actual != v.Expected {
+			t.Fatalf("Expected the Auxiliary Authorization header for %q to be %q but got %q", v.Description, v.Expected, actual)
+		}
+	}
+}
+
+func TestAzureValidateAuxiliaryTenants(t *testing.T) {
+	cases := []struct {
+		Description string
+		Config      Config
+		ExpectError bool
+	}{
+		{
+			Description: "No Auxiliary Tenants",
+			Config:      Config{},
+			ExpectError: false,
+		},
+		{
+			Description: "Using the Azure CLI",
+			Config: Config{
+				TenantID:           "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				AuxiliaryTenantIDs: []string{"62e73395-5017-43b6-8ebf-d6c30a514cf1"},
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Primary Tenant specified as an Auxiliary Tenant",
+			Config: Config{
+				ClientSecret:       "Does Hammer Time have Daylight Savings Time?",
+				TenantID:           "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				AuxiliaryTenantIDs: []string{"9834F8D0-24B3-41B7-8B8D-C611C461A129"},
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Too many Auxiliary Tenants",
+			Config: Config{
+				ClientSecret: "Does Hammer Time have Daylight Savings Time?",
+				TenantID:     "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				AuxiliaryTenantIDs: []string{
+					"00000000-0000-0000-0000-000000000001",
+					"00000000-0000-0000-0000-000000000002",
+					"00000000-0000-0000-0000-000000000003",
+					"00000000-0000-0000-0000-000000000004",
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Using a Client Secret",
+			Config: Config{
+				ClientSecret:       "Does Hammer Time have Daylight Savings Time?",
+				TenantID:           "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				AuxiliaryTenantIDs: []string{"62e73395-5017-43b6-8ebf-d6c30a514cf1"},
+			},
+			ExpectError: false,
+		},
+		{
+			Description: "Using a Client Certificate",
+			Config: Config{
+				ClientCertPath:     "/tmp/certificate.pem",
+				TenantID:           "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				AuxiliaryTenantIDs: []string{"62e73395-5017-43b6-8ebf-d6c30a514cf1"},
+			},
+			ExpectError: false,
+		},
+	}
+
+	for _, v := range cases {
+		err := v.Config.ValidateAuxiliaryTenants()
+
+		if v.ExpectError && err == nil {
+			t.Fatalf("Expected an error for %q: didn't get one", v.Description)
+		}
+
+		if !v.ExpectError && err != nil {
+			t.Fatalf("Expected there to be no error for %q - but got: %v", v.Description, err)
+		}
+	}
+}
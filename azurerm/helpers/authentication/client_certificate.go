@@ -0,0 +1,126 @@
+package authentication
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+
+	"golang.org/x/crypto/pkcs12"
+)
+
+// DecodeClientCertificate loads the Certificate and RSA Private Key used to authenticate
+// as a Service Principal from the PEM-encoded or PFX (PKCS#12) file specified in `ClientCertPath`.
+// An encrypted Private Key (or PFX file) is decrypted using the `ClientCertPassword`.
+func (c *Config) DecodeClientCertificate() (*x509.Certificate, *rsa.PrivateKey, error) {
+	contents, err := ioutil.ReadFile(c.ClientCertPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error reading Client Certificate %q: %+v", c.ClientCertPath, err)
+	}
+
+	return decodeClientCertificate(contents, c.ClientCertPassword)
+}
+
+func decodeClientCertificate(contents []byte, password string) (*x509.Certificate, *rsa.PrivateKey, error) {
+	// PFX files are binary, whereas PEM files contain one or more PEM blocks
+	if block, _ := pem.Decode(contents); block == nil && len(contents) > 0 {
+		return decodePfxClientCertificate(contents, password)
+	}
+
+	return decodePemClientCertificate(contents, password)
+}
+
+func decodePfxClientCertificate(contents []byte, password string) (*x509.Certificate, *rsa.PrivateKey, error) {
+	key, certificate, err := pkcs12.Decode(contents, password)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error decoding the PFX Client Certificate: %+v", err)
+	}
+
+	privateKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, nil, fmt.Errorf("The Private Key in the PFX Client Certificate must be an RSA Private Key")
+	}
+
+	return certificate, privateKey, nil
+}
+
+func decodePemClientCertificate(contents []byte, password string) (*x509.Certificate, *rsa.PrivateKey, error) {
+	var certificate *x509.Certificate
+	var privateKey *rsa.PrivateKey
+
+	for {
+		var block *pem.Block
+		block, contents = pem.Decode(contents)
+		if block == nil {
+			break
+		}
+
+		switch block.Type {
+		case "CERTIFICATE":
+			// the first certificate is the leaf, any others are the chain
+			if certificate != nil {
+				continue
+			}
+
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, nil, fmt.Errorf("Error parsing Client Certificate: %+v", err)
+			}
+			certificate = cert
+
+		case "RSA PRIVATE KEY", "PRIVATE KEY":
+			key, err := parseClientCertificatePrivateKey(block, password)
+			if err != nil {
+				return nil, nil, err
+			}
+			privateKey = key
+		}
+	}
+
+	if certificate == nil {
+		return nil, nil, fmt.Errorf("No Certificate was found in the Client Certificate")
+	}
+
+	if privateKey == nil {
+		return nil, nil, fmt.Errorf("No RSA Private Key was found in the Client Certificate")
+	}
+
+	return certificate, privateKey, nil
+}
+
+func parseClientCertificatePrivateKey(block *pem.Block, password string) (*rsa.PrivateKey, error) {
+	data := block.Bytes
+
+	if x509.IsEncryptedPEMBlock(block) {
+		if password == "" {
+			return nil, fmt.Errorf("The Private Key in the Client Certificate is encrypted but no Client Certificate Password was specified")
+		}
+
+		decrypted, err := x509.DecryptPEMBlock(block, []byte(password))
+		if err != nil {
+			return nil, fmt.Errorf("Error decrypting the Private Key in the Client Certificate: %+v", err)
+		}
+		data = decrypted
+	}
+
+	if block.Type == "RSA PRIVATE KEY" {
+		key, err := x509.ParsePKCS1PrivateKey(data)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing the Private Key in the Client Certificate: %+v", err)
+		}
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(data)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing the Private Key in the Client Certificate: %+v", err)
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("The Private Key in the Client Certificate must be an RSA Private Key")
+	}
+
+	return rsaKey, nil
+}
@@ -0,0 +1,151 @@
+package authentication
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestDecodeClientCertificate(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Error generating RSA Key: %+v", err)
+	}
+
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject: pkix.Name{
+			CommonName: "acctest",
+		},
+		NotBefore: time.Now(),
+		NotAfter:  time.Now().Add(time.Hour),
+	}
+	certificate, err := x509.CreateCertificate(rand.Reader, &template, &template, &privateKey.PublicKey, privateKey)
+	if err != nil {
+		t.Fatalf("Error generating Certificate: %+v", err)
+	}
+
+	certificateBlock := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate})
+	pkcs1Block := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})
+
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		t.Fatalf("Error marshalling PKCS8 Private Key: %+v", err)
+	}
+	pkcs8Block := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8})
+
+	cases := []struct {
+		Description string
+		Contents    []byte
+		Password    string
+		ExpectError bool
+	}{
+		{
+			Description: "Empty",
+			Contents:    []byte{},
+			ExpectError: true,
+		},
+		{
+			Description: "Certificate Only",
+			Contents:    certificateBlock,
+			ExpectError: true,
+		},
+		{
+			Description: "Private Key Only",
+			Contents:    pkcs1Block,
+			ExpectError: true,
+		},
+		{
+			Description: "Certificate and PKCS1 Private Key",
+			Contents:    append(certificateBlock, pkcs1Block...),
+			ExpectError: false,
+		},
+		{
+			Description: "PKCS8 Private Key and Certificate",
+			Contents:    append(pkcs8Block, certificateBlock...),
+			ExpectError: false,
+		},
+	}
+
+	for _, v := range cases {
+		cert, key, err := decodeClientCertificate(v.Contents, v.Password)
+
+		if v.ExpectError {
+			if err == nil {
+				t.Fatalf("Expected an error for %q: didn't get one", v.Description)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected there to be no error for %q - but got: %v", v.Description, err)
+		}
+
+		if cert.Subject.CommonName != "acctest" {
+			t.Fatalf("Expected the Common Name for %q to be `acctest` but got %q", v.Description, cert.Subject.CommonName)
+		}
+
+		if key.N.Cmp(privateKey.N) != 0 {
+			t.Fatalf("Expected the Private Key for %q to match", v.Description)
+		}
+	}
+}
+
+func TestDecodeClientCertificatePfx(t *testing.T) {
+	contents, err := ioutil.ReadFile(filepath.Join("testdata", "client_certificate.pfx"))
+	if err != nil {
+		t.Fatalf("Error reading the PFX file: %+v", err)
+	}
+
+	cases := []struct {
+		Description string
+		Password    string
+		ExpectError bool
+	}{
+		{
+			Description: "No Password",
+			Password:    "",
+			ExpectError: true,
+		},
+		{
+			Description: "Incorrect Password",
+			Password:    "incorrect",
+			ExpectError: true,
+		},
+		{
+			Description: "Correct Password",
+			Password:    "terraform",
+			ExpectError: false,
+		},
+	}
+
+	for _, v := range cases {
+		cert, key, err := decodeClientCertificate(contents, v.Password)
+
+		if v.ExpectError {
+			if err == nil {
+				t.Fatalf("Expected an error for %q: didn't get one", v.Description)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected there to be no error for %q - but got: %v", v.Description, err)
+		}
+
+		if cert.Subject.CommonName != "acctest" {
+			t.Fatalf("Expected the Common Name for %q to be `acctest` but got %q", v.Description, cert.Subject.CommonName)
+		}
+
+		if key.N.Cmp(cert.PublicKey.(*rsa.PublicKey).N) != 0 {
+			t.Fatalf("Expected the Private Key for %q to match the Certificate", v.Description)
+		}
+	}
+}
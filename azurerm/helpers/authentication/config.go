@@ -21,10 +21,32 @@ type Config struct {
 	Environment               string
 	SkipCredentialsValidation bool
 	SkipProviderRegistration  bool
+	AuxiliaryTenantIDs        []string
+	PartnerID                 string
+
+	// when specified only these Resource Providers are registered, rather than all of them
+	ResourceProvidersToRegister []string
+
+	// Networking
+	ProxyURL          string
+	CACertificatePath string
+
+	// Retries - when not specified the defaults from the SDK are used
+	MaxRetries          *int
+	RetryBackoffSeconds *int
+
+	// Custom Endpoints (e.g. Azure Stack)
+	ResourceManagerEndpoint string
+	ActiveDirectoryEndpoint string
+	GraphEndpoint           string
 
 	// Service Principal Auth
 	ClientSecret string
 
+	// Service Principal (Client Certificate) Auth
+	ClientCertPath     string
+	ClientCertPassword string
+
 	// Bearer Auth
 	AccessToken  *adal.Token
 	IsCloudShell bool
@@ -1,6 +1,55 @@
 package authentication
 
-import "strings"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// AzureEnvironment returns the Azure Environment (Cloud) which should be used. When a custom
+// Resource Manager Endpoint is configured (e.g. for Azure Stack) the remaining endpoints are
+// discovered from its metadata endpoint, otherwise the Environment is looked up by name.
+func (c *Config) AzureEnvironment() (*azure.Environment, error) {
+	if c.ResourceManagerEndpoint != "" {
+		overrides := make([]azure.OverrideProperty, 0)
+		if c.ActiveDirectoryEndpoint != "" {
+			overrides = append(overrides, azure.OverrideProperty{
+				Key:   azure.EnvironmentActiveDirectoryEndpoint,
+				Value: c.ActiveDirectoryEndpoint,
+			})
+		}
+		if c.GraphEndpoint != "" {
+			overrides = append(overrides, azure.OverrideProperty{
+				Key:   azure.EnvironmentGraphEndpoint,
+				Value: c.GraphEndpoint,
+			})
+		}
+
+		env, err := azure.EnvironmentFromURL(c.ResourceManagerEndpoint, overrides...)
+		if err != nil {
+			return nil, fmt.Errorf("Error loading the Environment from the Resource Manager Endpoint %q: %+v", c.ResourceManagerEndpoint, err)
+		}
+
+		return &env, nil
+	}
+
+	if c.ActiveDirectoryEndpoint != "" || c.GraphEndpoint != "" {
+		return nil, fmt.Errorf("A Resource Manager Endpoint must be configured when overriding the Active Directory or Graph Endpoints")
+	}
+
+	env, envErr := azure.EnvironmentFromName(c.Environment)
+	if envErr != nil {
+		// try again with wrapped value to support readable values like german instead of AZUREGERMANCLOUD
+		wrapped := fmt.Sprintf("AZURE%sCLOUD", c.Environment)
+		var innerErr error
+		if env, innerErr = azure.EnvironmentFromName(wrapped); innerErr != nil {
+			return nil, envErr
+		}
+	}
+
+	return &env, nil
+}
 
 func normalizeEnvironmentName(input string) string {
 	// Environment is stored as `Azure{Environment}Cloud`
@@ -1,6 +1,8 @@
 package authentication
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -21,3 +23,101 @@ func TestAzureEnvironmentNames(t *testing.T) {
 		}
 	}
 }
+
+func TestAzureEnvironmentFromName(t *testing.T) {
+	testData := map[string]string{
+		"public":                 "https://management.azure.com/",
+		"german":                 "https://management.microsoftazure.de/",
+		"AZUREUSGOVERNMENTCLOUD": "https://management.usgovcloudapi.net/",
+	}
+
+	for input, expected := range testData {
+		config := Config{
+			Environment: input,
+		}
+
+		env, err := config.AzureEnvironment()
+		if err != nil {
+			t.Fatalf("Expected no error for %q but got: %+v", input, err)
+		}
+
+		if env.ResourceManagerEndpoint != expected {
+			t.Fatalf("Expected the Resource Manager Endpoint for %q to be %q: got %q!", input, expected, env.ResourceManagerEndpoint)
+		}
+	}
+
+	config := Config{
+		Environment: "does-not-exist",
+	}
+	if _, err := config.AzureEnvironment(); err == nil {
+		t.Fatalf("Expected an error for an unknown Environment but didn't get one")
+	}
+
+	config = Config{
+		Environment:   "public",
+		GraphEndpoint: "https://graph.example.com/",
+	}
+	if _, err := config.AzureEnvironment(); err == nil {
+		t.Fatalf("Expected an error when overriding the Graph Endpoint without a Resource Manager Endpoint but didn't get one")
+	}
+}
+
+func TestAzureEnvironmentFromResourceManagerEndpoint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/metadata/endpoints" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+  "galleryEndpoint": "https://gallery.local.azurestack.external/",
+  "graphEndpoint": "https://graph.local.azurestack.external/",
+  "portalEndpoint": "https://portal.local.azurestack.external/",
+  "authentication": {
+    "loginEndpoint": "https://login.local.azurestack.external/",
+    "audiences": [
+      "https://management.local.azurestack.external/"
+    ]
+  }
+}`))
+	}))
+	defer server.Close()
+
+	config := Config{
+		ResourceManagerEndpoint: server.URL,
+	}
+
+	env, err := config.AzureEnvironment()
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if env.ResourceManagerEndpoint != server.URL {
+		t.Fatalf("Expected the Resource Manager Endpoint to be %q: got %q", server.URL, env.ResourceManagerEndpoint)
+	}
+
+	if env.ActiveDirectoryEndpoint != "https://login.local.azurestack.external/" {
+		t.Fatalf("Expected the Active Directory Endpoint to be discovered: got %q", env.ActiveDirectoryEndpoint)
+	}
+
+	if env.GraphEndpoint != "https://graph.local.azurestack.external/" {
+		t.Fatalf("Expected the Graph Endpoint to be discovered: got %q", env.GraphEndpoint)
+	}
+
+	config.ActiveDirectoryEndpoint = "https://adfs.local.azurestack.external/adfs"
+	config.GraphEndpoint = "https://graph.example.com/"
+
+	env, err = config.AzureEnvironment()
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if env.ActiveDirectoryEndpoint != config.ActiveDirectoryEndpoint {
+		t.Fatalf("Expected the Active Directory Endpoint to be overridden to %q: got %q", config.ActiveDirectoryEndpoint, env.ActiveDirectoryEndpoint)
+	}
+
+	if env.GraphEndpoint != config.GraphEndpoint {
+		t.Fatalf("Expected the Graph Endpoint to be overridden to %q: got %q", config.GraphEndpoint, env.GraphEndpoint)
+	}
+}
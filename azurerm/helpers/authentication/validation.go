@@ -50,6 +50,28 @@ func (c *Config) ValidateServicePrincipal() error {
 	return err.ErrorOrNil()
 }
 
+func (c *Config) ValidateServicePrincipalCertificate() error {
+	var err *multierror.Error
+
+	if c.SubscriptionID == "" {
+		err = multierror.Append(err, fmt.Errorf("Subscription ID must be configured for the AzureRM provider"))
+	}
+	if c.ClientID == "" {
+		err = multierror.Append(err, fmt.Errorf("Client ID must be configured for the AzureRM provider"))
+	}
+	if c.ClientCertPath == "" {
+		err = multierror.Append(err, fmt.Errorf("Client Certificate Path must be configured for the AzureRM provider"))
+	}
+	if c.TenantID == "" {
+		err = multierror.Append(err, fmt.Errorf("Tenant ID must be configured for the AzureRM provider"))
+	}
+	if c.Environment == "" {
+		err = multierror.Append(err, fmt.Errorf("Environment must be configured for the AzureRM provider"))
+	}
+
+	return err.ErrorOrNil()
+}
+
 func (c *Config) ValidateMsi() error {
 	var err *multierror.Error
 
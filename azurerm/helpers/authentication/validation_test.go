@@ -165,6 +165,93 @@ func TestAzureValidateServicePrincipal(t *testing.T) {
 	}
 }
 
+func TestAzureValidateServicePrincipalCertificate(t *testing.T) {
+	cases := []struct {
+		Description string
+		Config      Config
+		ExpectError bool
+	}{
+		{
+			Description: "Empty Configuration",
+			Config:      Config{},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing Subscription ID",
+			Config: Config{
+				ClientID:       "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				ClientCertPath: "/tmp/certificate.pem",
+				TenantID:       "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				Environment:    "public",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing Client ID",
+			Config: Config{
+				SubscriptionID: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				ClientCertPath: "/tmp/certificate.pem",
+				TenantID:       "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				Environment:    "public",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing Client Certificate Path",
+			Config: Config{
+				SubscriptionID: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				ClientID:       "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				TenantID:       "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				Environment:    "public",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing Tenant ID",
+			Config: Config{
+				SubscriptionID: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				ClientID:       "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				ClientCertPath: "/tmp/certificate.pem",
+				Environment:    "public",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing Environment",
+			Config: Config{
+				SubscriptionID: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				ClientID:       "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				ClientCertPath: "/tmp/certificate.pem",
+				TenantID:       "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Valid Configuration",
+			Config: Config{
+				SubscriptionID: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				ClientID:       "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				ClientCertPath: "/tmp/certificate.pem",
+				TenantID:       "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				Environment:    "public",
+			},
+			ExpectError: false,
+		},
+	}
+
+	for _, v := range cases {
+		err := v.Config.ValidateServicePrincipalCertificate()
+
+		if v.ExpectError && err == nil {
+			t.Fatalf("Expected an error for %q: didn't get one", v.Description)
+		}
+
+		if !v.ExpectError && err != nil {
+			t.Fatalf("Expected there to be no error for %q - but got: %v", v.Description, err)
+		}
+	}
+}
+
 func TestAzureValidateMsi(t *testing.T) {
 	cases := []struct {
 		Description string
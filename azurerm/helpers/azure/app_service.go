@@ -1,6 +1,7 @@
 package azure
 
 import (
+	"encoding/base64"
 	"log"
 	"net"
 	"strings"
@@ -9,9 +10,15 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+const (
+	appServiceLinuxFxVersionComposePrefix    = "COMPOSE|"
+	appServiceLinuxFxVersionKubernetesPrefix = "KUBE|"
+)
+
 func SchemaAppServiceSiteConfig() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -26,6 +33,151 @@ func SchemaAppServiceSiteConfig() *schema.Schema {
 					Default:  false,
 				},
 
+				"auto_heal": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"trigger": {
+								Type:     schema.TypeList,
+								Required: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"requests": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"count": {
+														Type:         schema.TypeInt,
+														Required:     true,
+														ValidateFunc: validation.IntAtLeast(1),
+													},
+													"interval": {
+														Type:         schema.TypeString,
+														Required:     true,
+														ValidateFunc: validate.TimeSpan,
+													},
+												},
+											},
+										},
+
+										"slow_request": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"count": {
+														Type:         schema.TypeInt,
+														Required:     true,
+														ValidateFunc: validation.IntAtLeast(1),
+													},
+													"interval": {
+														Type:         schema.TypeString,
+														Required:     true,
+														ValidateFunc: validate.TimeSpan,
+													},
+													"time_taken": {
+														Type:         schema.TypeString,
+														Required:     true,
+														ValidateFunc: validate.TimeSpan,
+													},
+												},
+											},
+										},
+
+										"status_code": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"status": {
+														Type:         schema.TypeInt,
+														Required:     true,
+														ValidateFunc: validation.IntBetween(101, 599),
+													},
+													"count": {
+														Type:         schema.TypeInt,
+														Required:     true,
+														ValidateFunc: validation.IntAtLeast(1),
+													},
+													"interval": {
+														Type:         schema.TypeString,
+														Required:     true,
+														ValidateFunc: validate.TimeSpan,
+													},
+													"sub_status": {
+														Type:     schema.TypeInt,
+														Optional: true,
+													},
+													"win32_status": {
+														Type:     schema.TypeInt,
+														Optional: true,
+													},
+												},
+											},
+										},
+
+										"private_memory_kb": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											ValidateFunc: validation.IntAtLeast(1),
+										},
+									},
+								},
+							},
+
+							"action": {
+								Type:     schema.TypeList,
+								Required: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"action_type": {
+											Type:     schema.TypeString,
+											Required: true,
+											ValidateFunc: validation.StringInSlice([]string{
+												string(web.CustomAction),
+												string(web.LogEvent),
+												string(web.Recycle),
+											}, false),
+										},
+
+										"custom_action": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"executable": {
+														Type:         schema.TypeString,
+														Required:     true,
+														ValidateFunc: validation.NoZeroValues,
+													},
+													"parameters": {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+												},
+											},
+										},
+
+										"minimum_process_execution_time": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validate.TimeSpan,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+
 				"default_documents": {
 					Type:     schema.TypeList,
 					Optional: true,
@@ -64,6 +216,27 @@ func SchemaAppServiceSiteConfig() *schema.Schema {
 								Optional: true,
 								Default:  "255.255.255.255",
 							},
+							"name": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.NoZeroValues,
+							},
+							"priority": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      65000,
+								ValidateFunc: validation.IntBetween(1, 2147483647),
+							},
+							"action": {
+								Type:     schema.TypeString,
+								Optional: true,
+								Default:  "Allow",
+								ValidateFunc: validation.StringInSlice([]string{
+									"Allow",
+									"Deny",
+								}, false),
+							},
 						},
 					},
 				},
@@ -186,6 +359,20 @@ func SchemaAppServiceSiteConfig() *schema.Schema {
 					Computed: true,
 				},
 
+				"docker_compose_file": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					ValidateFunc:  validation.NoZeroValues,
+					ConflictsWith: []string{"site_config.0.linux_fx_version", "site_config.0.kubernetes_file"},
+				},
+
+				"kubernetes_file": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					ValidateFunc:  validation.NoZeroValues,
+					ConflictsWith: []string{"site_config.0.linux_fx_version", "site_config.0.docker_compose_file"},
+				},
+
 				"min_tls_version": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -220,6 +407,12 @@ func ExpandAppServiceSiteConfig(input interface{}) web.SiteConfig {
 		siteConfig.AlwaysOn = utils.Bool(v.(bool))
 	}
 
+	if v, ok := config["auto_heal"]; ok {
+		autoHeal := v.([]interface{})
+		siteConfig.AutoHealEnabled = utils.Bool(len(autoHeal) > 0)
+		siteConfig.AutoHealRules = expandAppServiceAutoHealRules(autoHeal)
+	}
+
 	if v, ok := config["default_documents"]; ok {
 		input := v.([]interface{})
 
@@ -251,6 +444,15 @@ func ExpandAppServiceSiteConfig(input interface{}) web.SiteConfig {
 		siteConfig.LinuxFxVersion = utils.String(v.(string))
 	}
 
+	// multi-container apps are configured by passing the base64 encoded configuration file as the linux_fx_version
+	if v, ok := config["docker_compose_file"]; ok && v.(string) != "" {
+		siteConfig.LinuxFxVersion = utils.String(appServiceLinuxFxVersionComposePrefix + base64.StdEncoding.EncodeToString([]byte(v.(string))))
+	}
+
+	if v, ok := config["kubernetes_file"]; ok && v.(string) != "" {
+		siteConfig.LinuxFxVersion = utils.String(appServiceLinuxFxVersionKubernetesPrefix + base64.StdEncoding.EncodeToString([]byte(v.(string))))
+	}
+
 	if v, ok := config["http2_enabled"]; ok {
 		siteConfig.HTTP20Enabled = utils.Bool(v.(bool))
 	}
@@ -274,10 +476,18 @@ func ExpandAppServiceSiteConfig(input interface{}) web.SiteConfig {
 				cidrAddress += "/32"
 			}
 
-			restrictions = append(restrictions, web.IPSecurityRestriction{
+			ipSecurityRestriction := web.IPSecurityRestriction{
 				IPAddress:  &cidrAddress,
 				SubnetMask: &restrictionMask,
-			})
+				Priority:   utils.Int32(int32(restriction["priority"].(int))),
+				Action:     utils.String(restriction["action"].(string)),
+			}
+
+			if name := restriction["name"].(string); name != "" {
+				ipSecurityRestriction.Name = utils.String(name)
+			}
+
+			restrictions = append(restrictions, ipSecurityRestriction)
 		}
 		siteConfig.IPSecurityRestrictions = &restrictions
 	}
@@ -396,6 +606,15 @@ func FlattenAppServiceSiteConfig(input *web.SiteConfig) []interface{} {
 			if subnet := v.SubnetMask; subnet != nil {
 				result["subnet_mask"] = *subnet
 			}
+			if name := v.Name; name != nil {
+				result["name"] = *name
+			}
+			if priority := v.Priority; priority != nil {
+				result["priority"] = int(*priority)
+			}
+			if action := v.Action; action != nil {
+				result["action"] = *action
+			}
 			restrictions = append(restrictions, result)
 		}
 	}
@@ -427,8 +646,21 @@ func FlattenAppServiceSiteConfig(input *web.SiteConfig) []interface{} {
 		result["websockets_enabled"] = *input.WebSocketsEnabled
 	}
 
+	if input.AutoHealEnabled != nil && *input.AutoHealEnabled {
+		result["auto_heal"] = flattenAppServiceAutoHealRules(input.AutoHealRules)
+	}
+
 	if input.LinuxFxVersion != nil {
-		result["linux_fx_version"] = *input.LinuxFxVersion
+		linuxFxVersion := *input.LinuxFxVersion
+		result["linux_fx_version"] = linuxFxVersion
+
+		if strings.HasPrefix(linuxFxVersion, appServiceLinuxFxVersionComposePrefix) {
+			result["docker_compose_file"] = decodeAppServiceLinuxFxVersionFile(strings.TrimPrefix(linuxFxVersion, appServiceLinuxFxVersionComposePrefix))
+		}
+
+		if strings.HasPrefix(linuxFxVersion, appServiceLinuxFxVersionKubernetesPrefix) {
+			result["kubernetes_file"] = decodeAppServiceLinuxFxVersionFile(strings.TrimPrefix(linuxFxVersion, appServiceLinuxFxVersionKubernetesPrefix))
+		}
 	}
 
 	if input.VnetName != nil {
@@ -441,3 +673,177 @@ func FlattenAppServiceSiteConfig(input *web.SiteConfig) []interface{} {
 
 	return append(results, result)
 }
+
+func expandAppServiceAutoHealRules(input []interface{}) *web.AutoHealRules {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	autoHeal := input[0].(map[string]interface{})
+	rules := web.AutoHealRules{
+		Triggers: &web.AutoHealTriggers{},
+		Actions:  &web.AutoHealActions{},
+	}
+
+	if triggers := autoHeal["trigger"].([]interface{}); len(triggers) > 0 && triggers[0] != nil {
+		trigger := triggers[0].(map[string]interface{})
+
+		if requests := trigger["requests"].([]interface{}); len(requests) > 0 && requests[0] != nil {
+			request := requests[0].(map[string]interface{})
+			rules.Triggers.Requests = &web.RequestsBasedTrigger{
+				Count:        utils.Int32(int32(request["count"].(int))),
+				TimeInterval: utils.String(request["interval"].(string)),
+			}
+		}
+
+		if slowRequests := trigger["slow_request"].([]interface{}); len(slowRequests) > 0 && slowRequests[0] != nil {
+			slowRequest := slowRequests[0].(map[string]interface{})
+			rules.Triggers.SlowRequests = &web.SlowRequestsBasedTrigger{
+				Count:        utils.Int32(int32(slowRequest["count"].(int))),
+				TimeInterval: utils.String(slowRequest["interval"].(string)),
+				TimeTaken:    utils.String(slowRequest["time_taken"].(string)),
+			}
+		}
+
+		statusCodes := make([]web.StatusCodesBasedTrigger, 0)
+		for _, v := range trigger["status_code"].([]interface{}) {
+			statusCode := v.(map[string]interface{})
+			statusCodes = append(statusCodes, web.StatusCodesBasedTrigger{
+				Status:       utils.Int32(int32(statusCode["status"].(int))),
+				SubStatus:    utils.Int32(int32(statusCode["sub_status"].(int))),
+				Win32Status:  utils.Int32(int32(statusCode["win32_status"].(int))),
+				Count:        utils.Int32(int32(statusCode["count"].(int))),
+				TimeInterval: utils.String(statusCode["interval"].(string)),
+			})
+		}
+		rules.Triggers.StatusCodes = &statusCodes
+
+		if v := trigger["private_memory_kb"].(int); v > 0 {
+			rules.Triggers.PrivateBytesInKB = utils.Int32(int32(v))
+		}
+	}
+
+	if actions := autoHeal["action"].([]interface{}); len(actions) > 0 && actions[0] != nil {
+		action := actions[0].(map[string]interface{})
+		rules.Actions.ActionType = web.AutoHealActionType(action["action_type"].(string))
+
+		if customActions := action["custom_action"].([]interface{}); len(customActions) > 0 && customActions[0] != nil {
+			customAction := customActions[0].(map[string]interface{})
+			rules.Actions.CustomAction = &web.AutoHealCustomAction{
+				Exe:        utils.String(customAction["executable"].(string)),
+				Parameters: utils.String(customAction["parameters"].(string)),
+			}
+		}
+
+		if v := action["minimum_process_execution_time"].(string); v != "" {
+			rules.Actions.MinProcessExecutionTime = utils.String(v)
+		}
+	}
+
+	return &rules
+}
+
+func flattenAppServiceAutoHealRules(input *web.AutoHealRules) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	trigger := make(map[string]interface{})
+	if triggers := input.Triggers; triggers != nil {
+		requests := make([]interface{}, 0)
+		if v := triggers.Requests; v != nil {
+			request := make(map[string]interface{})
+			if v.Count != nil {
+				request["count"] = int(*v.Count)
+			}
+			if v.TimeInterval != nil {
+				request["interval"] = *v.TimeInterval
+			}
+			requests = append(requests, request)
+		}
+		trigger["requests"] = requests
+
+		slowRequests := make([]interface{}, 0)
+		if v := triggers.SlowRequests; v != nil {
+			slowRequest := make(map[string]interface{})
+			if v.Count != nil {
+				slowRequest["count"] = int(*v.Count)
+			}
+			if v.TimeInterval != nil {
+				slowRequest["interval"] = *v.TimeInterval
+			}
+			if v.TimeTaken != nil {
+				slowRequest["time_taken"] = *v.TimeTaken
+			}
+			slowRequests = append(slowRequests, slowRequest)
+		}
+		trigger["slow_request"] = slowRequests
+
+		statusCodes := make([]interface{}, 0)
+		if triggers.StatusCodes != nil {
+			for _, v := range *triggers.StatusCodes {
+				statusCode := make(map[string]interface{})
+				if v.Status != nil {
+					statusCode["status"] = int(*v.Status)
+				}
+				if v.SubStatus != nil {
+					statusCode["sub_status"] = int(*v.SubStatus)
+				}
+				if v.Win32Status != nil {
+					statusCode["win32_status"] = int(*v.Win32Status)
+				}
+				if v.Count != nil {
+					statusCode["count"] = int(*v.Count)
+				}
+				if v.TimeInterval != nil {
+					statusCode["interval"] = *v.TimeInterval
+				}
+				statusCodes = append(statusCodes, statusCode)
+			}
+		}
+		trigger["status_code"] = statusCodes
+
+		if v := triggers.PrivateBytesInKB; v != nil {
+			trigger["private_memory_kb"] = int(*v)
+		}
+	}
+
+	action := make(map[string]interface{})
+	if actions := input.Actions; actions != nil {
+		action["action_type"] = string(actions.ActionType)
+
+		customActions := make([]interface{}, 0)
+		if v := actions.CustomAction; v != nil {
+			customAction := make(map[string]interface{})
+			if v.Exe != nil {
+				customAction["executable"] = *v.Exe
+			}
+			if v.Parameters != nil {
+				customAction["parameters"] = *v.Parameters
+			}
+			customActions = append(customActions, customAction)
+		}
+		action["custom_action"] = customActions
+
+		if v := actions.MinProcessExecutionTime; v != nil {
+			action["minimum_process_execution_time"] = *v
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"trigger": []interface{}{trigger},
+			"action":  []interface{}{action},
+		},
+	}
+}
+
+func decodeAppServiceLinuxFxVersionFile(input string) string {
+	decoded, err := base64.StdEncoding.DecodeString(input)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to decode the configuration file from `linux_fx_version`: %+v", err)
+		return ""
+	}
+
+	return string(decoded)
+}
@@ -0,0 +1,52 @@
+package azure
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// ImporterValidatingResourceId returns an Importer which validates the ID being imported is a
+// Resource ID containing each of the specified segments (for example `virtualNetworks` and `subnets`
+// for a Subnet), before passing it through to the Read function
+func ImporterValidatingResourceId(segments ...string) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			if err := validateResourceIdForImport(d.Id(), segments...); err != nil {
+				return nil, err
+			}
+
+			return schema.ImportStatePassthrough(d, meta)
+		},
+	}
+}
+
+// ImporterValidatingScopedResourceId returns an Importer which validates the ID being imported is
+// a Resource ID of the specified type beneath any Scope (such as a Subscription or Resource Group),
+// before passing it through to the Read function
+func ImporterValidatingScopedResourceId(provider string, resourceType string) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			if _, err := ParseScopedResourceID(d.Id(), provider, resourceType); err != nil {
+				return nil, fmt.Errorf("Error importing %q: %+v", d.Id(), err)
+			}
+
+			return schema.ImportStatePassthrough(d, meta)
+		},
+	}
+}
+
+func validateResourceIdForImport(input string, segments ...string) error {
+	id, err := ParseAzureResourceID(input)
+	if err != nil {
+		return fmt.Errorf("Error importing %q: %+v", input, err)
+	}
+
+	for _, segment := range segments {
+		if _, err := id.PopSegment(segment); err != nil {
+			return fmt.Errorf("Error importing %q: %+v", input, err)
+		}
+	}
+
+	return nil
+}
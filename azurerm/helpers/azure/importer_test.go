@@ -0,0 +1,62 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestValidateResourceIdForImport(t *testing.T) {
+	testCases := []struct {
+		id       string
+		segments []string
+		valid    bool
+	}{
+		{
+			id:       "",
+			segments: []string{},
+			valid:    false,
+		},
+		{
+			id:       "hello-world",
+			segments: []string{},
+			valid:    false,
+		},
+		{
+			id:       "/subscriptions/00000000-0000-0000-0000-000000000000",
+			segments: []string{},
+			valid:    false,
+		},
+		{
+			id:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			segments: []string{},
+			valid:    true,
+		},
+		{
+			id:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			segments: []string{"virtualNetworks", "subnets"},
+			valid:    false,
+		},
+		{
+			id:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			segments: []string{"virtualNetworks", "subnets"},
+			valid:    true,
+		},
+		{
+			id:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualnetworks/network1/Subnets/subnet1",
+			segments: []string{"virtualNetworks", "subnets"},
+			valid:    true,
+		},
+		{
+			id:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkSecurityGroups/nsg1/subnets/subnet1",
+			segments: []string{"virtualNetworks", "subnets"},
+			valid:    false,
+		},
+	}
+
+	for _, test := range testCases {
+		err := validateResourceIdForImport(test.id, test.segments...)
+		valid := err == nil
+		if valid != test.valid {
+			t.Fatalf("Expected %q to be valid %t but got %t (%+v)", test.id, test.valid, valid, err)
+		}
+	}
+}
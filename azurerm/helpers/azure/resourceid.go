@@ -18,11 +18,32 @@ type ResourceID struct {
 	Path           map[string]string
 }
 
-// parseAzureResourceID converts a long-form Azure Resource Manager ID
+// ParseAzureResourceID converts a long-form Azure Resource Manager ID
 // into a ResourceID. We make assumptions about the structure of URLs,
 // which is obviously not good, but the best thing available given the
 // SDK.
 func ParseAzureResourceID(id string) (*ResourceID, error) {
+	idObj, err := ParseResourceID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if idObj.SubscriptionID == "" {
+		return nil, fmt.Errorf("No subscription ID found in: %q", id)
+	}
+
+	if idObj.ResourceGroup == "" {
+		return nil, fmt.Errorf("No resource group name found in: %q", id)
+	}
+
+	return idObj, nil
+}
+
+// ParseResourceID converts an Azure Resource Manager ID at any scope (for example a Tenant,
+// Management Group, Subscription or Resource Group) into a ResourceID. The `subscriptions`,
+// `resourceGroups` and `providers` segments are matched case-insensitively, since Azure
+// doesn't consistently return these in the same casing.
+func ParseResourceID(id string) (*ResourceID, error) {
 	idURL, err := url.ParseRequestURI(id)
 	if err != nil {
 		return nil, fmt.Errorf("Cannot parse Azure ID: %s", err)
@@ -46,10 +67,11 @@ func ParseAzureResourceID(id string) (*ResourceID, error) {
 		return nil, fmt.Errorf("The number of path segments is not divisible by 2 in %q", path)
 	}
 
-	var subscriptionID string
+	idObj := &ResourceID{
+		Path: make(map[string]string, len(components)/2),
+	}
 
 	// Put the constituent key-value pairs into a map
-	componentMap := make(map[string]string, len(components)/2)
 	for current := 0; current < len(components); current += 2 {
 		key := components[current]
 		value := components[current+1]
@@ -59,47 +81,76 @@ func ParseAzureResourceID(id string) (*ResourceID, error) {
 			return nil, fmt.Errorf("Key/Value cannot be empty strings. Key: '%s', Value: '%s'", key, value)
 		}
 
+		switch {
 		// Catch the subscriptionID before it can be overwritten by another "subscriptions"
 		// value in the ID which is the case for the Service Bus subscription resource
-		if key == "subscriptions" && subscriptionID == "" {
-			subscriptionID = value
-		} else {
-			componentMap[key] = value
+		case strings.EqualFold(key, "subscriptions") && idObj.SubscriptionID == "":
+			idObj.SubscriptionID = value
+
+		case strings.EqualFold(key, "resourceGroups") && idObj.ResourceGroup == "":
+			idObj.ResourceGroup = value
+
+		// It is OK not to have a provider in the case of a resource group
+		case strings.EqualFold(key, "providers"):
+			idObj.Provider = value
+
+		default:
+			idObj.Path[key] = value
 		}
 	}
 
-	// Build up a ResourceID from the map
-	idObj := &ResourceID{}
-	idObj.Path = componentMap
-
-	if subscriptionID != "" {
-		idObj.SubscriptionID = subscriptionID
-	} else {
-		return nil, fmt.Errorf("No subscription ID found in: %q", path)
-	}
-
-	if resourceGroup, ok := componentMap["resourceGroups"]; ok {
-		idObj.ResourceGroup = resourceGroup
-		delete(componentMap, "resourceGroups")
-	} else {
-		// Some Azure APIs are weird and provide things in lower case...
-		// However it's not clear whether the casing of other elements in the URI
-		// matter, so we explicitly look for that case here.
-		if resourceGroup, ok := componentMap["resourcegroups"]; ok {
-			idObj.ResourceGroup = resourceGroup
-			delete(componentMap, "resourcegroups")
-		} else {
-			return nil, fmt.Errorf("No resource group name found in: %q", path)
+	return idObj, nil
+}
+
+// PopSegment retrieves the value of the segment with the specified name (matched case-insensitively)
+// from the Path, removing it such that it's not returned again - returning an error if it doesn't exist
+func (id *ResourceID) PopSegment(name string) (string, error) {
+	key := name
+	if _, ok := id.Path[key]; !ok {
+		for k := range id.Path {
+			if strings.EqualFold(k, name) {
+				key = k
+				break
+			}
 		}
 	}
 
-	// It is OK not to have a provider in the case of a resource group
-	if provider, ok := componentMap["providers"]; ok {
-		idObj.Provider = provider
-		delete(componentMap, "providers")
+	value, ok := id.Path[key]
+	if !ok {
+		return "", fmt.Errorf("ID was missing the `%s` element", name)
 	}
 
-	return idObj, nil
+	delete(id.Path, key)
+	return value, nil
+}
+
+// ScopedResourceID represents the ID of a Resource which can be created at any scope, for example
+// a Role Assignment in the format `{scope}/providers/Microsoft.Authorization/roleAssignments/{name}`
+type ScopedResourceID struct {
+	Scope string
+	Name  string
+}
+
+// ParseScopedResourceID parses the ID of a Resource of the specified Provider and Type which can be
+// created at any scope, matching the Provider and Type case-insensitively
+func ParseScopedResourceID(input string, provider string, resourceType string) (*ScopedResourceID, error) {
+	separator := fmt.Sprintf("/providers/%s/%s/", provider, resourceType)
+
+	index := strings.LastIndex(strings.ToLower(input), strings.ToLower(separator))
+	if index == -1 {
+		return nil, fmt.Errorf("Expected the ID to be in the format `{scope}%s{name}` but got %q", separator, input)
+	}
+
+	name := input[index+len(separator):]
+	if name == "" || strings.Contains(name, "/") {
+		return nil, fmt.Errorf("Expected the ID to be in the format `{scope}%s{name}` but got %q", separator, input)
+	}
+
+	id := ScopedResourceID{
+		Scope: input[:index],
+		Name:  name,
+	}
+	return &id, nil
 }
 
 func composeAzureResourceID(idObj *ResourceID) (id string, err error) {
@@ -141,7 +192,7 @@ func ParseNetworkSecurityGroupName(networkSecurityGroupId string) (string, error
 		return "", fmt.Errorf("[ERROR] Unable to Parse Network Security Group ID '%s': %+v", networkSecurityGroupId, err)
 	}
 
-	return id.Path["networkSecurityGroups"], nil
+	return id.PopSegment("networkSecurityGroups")
 }
 
 func ParseRouteTableName(routeTableId string) (string, error) {
@@ -150,5 +201,5 @@ func ParseRouteTableName(routeTableId string) (string, error) {
 		return "", fmt.Errorf("[ERROR] Unable to parse Route Table ID '%s': %+v", routeTableId, err)
 	}
 
-	return id.Path["routeTables"], nil
+	return id.PopSegment("routeTables")
 }
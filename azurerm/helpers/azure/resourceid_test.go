@@ -144,6 +144,159 @@ func TestParseAzureResourceID(t *testing.T) {
 	}
 }
 
+func TestParseResourceID(t *testing.T) {
+	testCases := []struct {
+		id                 string
+		expectedResourceID *ResourceID
+		expectError        bool
+	}{
+		{
+			"random",
+			nil,
+			true,
+		},
+		{
+			"/providers/Microsoft.Management/managementGroups",
+			nil,
+			true,
+		},
+		{
+			"/providers/Microsoft.Management/managementGroups/group1",
+			&ResourceID{
+				Provider: "Microsoft.Management",
+				Path: map[string]string{
+					"managementGroups": "group1",
+				},
+			},
+			false,
+		},
+		{
+			"/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/providers/Microsoft.Authorization/policyDefinitions/policy1",
+			&ResourceID{
+				SubscriptionID: "6d74bdd2-9f84-11e5-9bd9-7831c1c4c038",
+				Provider:       "Microsoft.Authorization",
+				Path: map[string]string{
+					"policyDefinitions": "policy1",
+				},
+			},
+			false,
+		},
+		{
+			"/SUBSCRIPTIONS/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/RESOURCEGROUPS/testGroup1/PROVIDERS/Microsoft.Network/virtualnetworks/virtualNetwork1",
+			&ResourceID{
+				SubscriptionID: "6d74bdd2-9f84-11e5-9bd9-7831c1c4c038",
+				ResourceGroup:  "testGroup1",
+				Provider:       "Microsoft.Network",
+				Path: map[string]string{
+					"virtualnetworks": "virtualNetwork1",
+				},
+			},
+			false,
+		},
+	}
+
+	for _, test := range testCases {
+		parsed, err := ParseResourceID(test.id)
+		if test.expectError && err != nil {
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if !reflect.DeepEqual(test.expectedResourceID, parsed) {
+			t.Fatalf("Unexpected resource ID:\nExpected: %+v\nGot:      %+v\n", test.expectedResourceID, parsed)
+		}
+	}
+}
+
+func TestResourceIDPopSegment(t *testing.T) {
+	id, err := ParseAzureResourceID("/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/resourceGroups/testGroup1/providers/Microsoft.Network/virtualnetworks/virtualNetwork1/subnets/subnet1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	name, err := id.PopSegment("virtualNetworks")
+	if err != nil {
+		t.Fatalf("Expected the `virtualNetworks` segment to be matched case-insensitively but got: %s", err)
+	}
+	if name != "virtualNetwork1" {
+		t.Fatalf("Expected the name to be `virtualNetwork1` but got %q", name)
+	}
+
+	if _, err := id.PopSegment("virtualNetworks"); err == nil {
+		t.Fatalf("Expected an error when popping a segment which has already been popped")
+	}
+
+	subnetName, err := id.PopSegment("subnets")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if subnetName != "subnet1" {
+		t.Fatalf("Expected the name to be `subnet1` but got %q", subnetName)
+	}
+
+	if len(id.Path) != 0 {
+		t.Fatalf("Expected no segments to remain but got %+v", id.Path)
+	}
+}
+
+func TestParseScopedResourceID(t *testing.T) {
+	testCases := []struct {
+		id            string
+		expectedScope string
+		expectedName  string
+		expectError   bool
+	}{
+		{
+			id:          "/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038",
+			expectError: true,
+		},
+		{
+			id:          "/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/providers/Microsoft.Authorization/roleAssignments/",
+			expectError: true,
+		},
+		{
+			id:            "/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/providers/Microsoft.Authorization/roleAssignments/assignment1",
+			expectedScope: "/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038",
+			expectedName:  "assignment1",
+		},
+		{
+			id:            "/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/resourceGroups/testGroup1/providers/microsoft.authorization/roleassignments/assignment1",
+			expectedScope: "/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/resourceGroups/testGroup1",
+			expectedName:  "assignment1",
+		},
+		{
+			id:            "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/roleAssignments/assignment1",
+			expectedScope: "/providers/Microsoft.Management/managementGroups/group1",
+			expectedName:  "assignment1",
+		},
+	}
+
+	for _, test := range testCases {
+		parsed, err := ParseScopedResourceID(test.id, "Microsoft.Authorization", "roleAssignments")
+		if err != nil {
+			if test.expectError {
+				continue
+			}
+
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if test.expectError {
+			t.Fatalf("Expected an error for %q but didn't get one", test.id)
+		}
+
+		if parsed.Scope != test.expectedScope {
+			t.Fatalf("Expected the Scope to be %q but got %q", test.expectedScope, parsed.Scope)
+		}
+
+		if parsed.Name != test.expectedName {
+			t.Fatalf("Expected the Name to be %q but got %q", test.expectedName, parsed.Name)
+		}
+	}
+}
+
 func TestComposeAzureResourceID(t *testing.T) {
 	testCases := []struct {
 		resourceID  *ResourceID
@@ -32,3 +32,25 @@ func ValidateResourceIDOrEmpty(i interface{}, k string) (_ []string, errors []er
 
 	return ValidateResourceID(i, k)
 }
+
+// ValidateResourceIDAtAnyScope validates a Resource ID at any scope, such as a Tenant,
+// Management Group, Subscription or Resource Group - rather than only within a Resource Group
+func ValidateResourceIDAtAnyScope(i interface{}, k string) (_ []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	id, err := ParseResourceID(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a resource id: %v", k, err))
+		return
+	}
+
+	if id.SubscriptionID == "" && id.Provider == "" {
+		errors = append(errors, fmt.Errorf("Expected %q to be a Resource ID at a Tenant, Management Group, Subscription or Resource Group scope but got %q", k, v))
+	}
+
+	return
+}
@@ -90,3 +90,49 @@ func TestAzureResourceIDOrEmpty(t *testing.T) {
 		})
 	}
 }
+
+func TestAzureResourceIDAtAnyScope(t *testing.T) {
+	cases := []struct {
+		ID     string
+		Errors int
+	}{
+		{
+			ID:     "",
+			Errors: 1,
+		},
+		{
+			ID:     "nonsense",
+			Errors: 1,
+		},
+		{
+			ID:     "/path/to/nothing",
+			Errors: 1,
+		},
+		{
+			ID:     "/providers/Microsoft.Management/managementGroups/group1",
+			Errors: 0,
+		},
+		{
+			ID:     "/subscriptions/00000000-0000-0000-0000-000000000000",
+			Errors: 0,
+		},
+		{
+			ID:     "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyDefinitions/policy1",
+			Errors: 0,
+		},
+		{
+			ID:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			Errors: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.ID, func(t *testing.T) {
+			_, errors := ValidateResourceIDAtAnyScope(tc.ID, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected ValidateResourceIDAtAnyScope to have %d not %d errors for %q", tc.Errors, len(errors), tc.ID)
+			}
+		})
+	}
+}
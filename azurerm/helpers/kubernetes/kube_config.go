@@ -27,6 +27,26 @@ type user struct {
 	ClientKeyData        string `yaml:"client-key-data"`
 }
 
+type userItemAAD struct {
+	Name string  `yaml:"name"`
+	User userAAD `yaml:"user"`
+}
+
+type userAAD struct {
+	AuthProvider authProvider `yaml:"auth-provider"`
+}
+
+type authProvider struct {
+	Name   string             `yaml:"name"`
+	Config authProviderConfig `yaml:"config"`
+}
+
+type authProviderConfig struct {
+	APIServerID string `yaml:"apiserver-id"`
+	ClientID    string `yaml:"client-id"`
+	TenantID    string `yaml:"tenant-id"`
+}
+
 type contextItem struct {
 	Name    string  `yaml:"name"`
 	Context context `yaml:"context"`
@@ -48,6 +68,18 @@ type KubeConfig struct {
 	Preferences    map[string]interface{} `yaml:"preferences,omitempty"`
 }
 
+// KubeConfigAAD is a Kubernetes Config which uses the `azure` auth provider, as returned for
+// clusters with Azure Active Directory integration enabled
+type KubeConfigAAD struct {
+	APIVersion     string                 `yaml:"apiVersion"`
+	Clusters       []clusterItem          `yaml:"clusters"`
+	Users          []userItemAAD          `yaml:"users"`
+	Contexts       []contextItem          `yaml:"contexts,omitempty"`
+	CurrentContext string                 `yaml:"current-context,omitempty"`
+	Kind           string                 `yaml:"kind,omitempty"`
+	Preferences    map[string]interface{} `yaml:"preferences,omitempty"`
+}
+
 func ParseKubeConfig(config string) (*KubeConfig, error) {
 	if config == "" {
 		return nil, fmt.Errorf("Cannot parse empty config")
@@ -72,3 +104,28 @@ func ParseKubeConfig(config string) (*KubeConfig, error) {
 
 	return &kubeConfig, nil
 }
+
+func ParseKubeConfigAAD(config string) (*KubeConfigAAD, error) {
+	if config == "" {
+		return nil, fmt.Errorf("Cannot parse empty config")
+	}
+
+	var kubeConfig KubeConfigAAD
+	err := yaml.Unmarshal([]byte(config), &kubeConfig)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal YAML config with error %+v", err)
+	}
+	if len(kubeConfig.Clusters) <= 0 || len(kubeConfig.Users) <= 0 {
+		return nil, fmt.Errorf("Config %+v contains no valid clusters or users", kubeConfig)
+	}
+	user := kubeConfig.Users[0].User
+	if user.AuthProvider.Name != "azure" {
+		return nil, fmt.Errorf("Config requires the `azure` auth provider for user %+v", user)
+	}
+	cluster := kubeConfig.Clusters[0].Cluster
+	if cluster.Server == "" {
+		return nil, fmt.Errorf("Config has invalid or non existent server for cluster %+v", cluster)
+	}
+
+	return &kubeConfig, nil
+}
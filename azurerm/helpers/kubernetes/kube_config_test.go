@@ -187,3 +187,102 @@ func LoadConfig(fileName string) string {
 
 	return string(bytes)
 }
+
+func TestParseKubeConfigAAD(t *testing.T) {
+	testCases := []struct {
+		sourceFile string
+		expected   KubeConfigAAD
+		checkFunc  func(expected KubeConfigAAD, config string) (bool, error)
+	}{
+		{
+			"user_with_aad.yml",
+			KubeConfigAAD{
+				APIVersion: "v1",
+				Clusters: []clusterItem{
+					{
+						Name: "test-cluster",
+						Cluster: cluster{
+							ClusterAuthorityData: "test-cluster-authority-data",
+							Server:               "https://testcluster.org:443",
+						},
+					},
+				},
+				Users: []userItemAAD{
+					{
+						Name: "test-user",
+						User: userAAD{
+							AuthProvider: authProvider{
+								Name: "azure",
+								Config: authProviderConfig{
+									APIServerID: "test-apiserver-id",
+									ClientID:    "test-client-id",
+									TenantID:    "test-tenant-id",
+								},
+							},
+						},
+					},
+				},
+				Contexts: []contextItem{
+					{
+						Name: "test-cluster",
+						Context: context{
+							Cluster: "test-cluster",
+							User:    "test-user",
+						},
+					},
+				},
+				CurrentContext: "test-cluster",
+				Kind:           "Config",
+			},
+			isValidConfigAAD,
+		},
+		{
+			"user_with_cert.yml",
+			KubeConfigAAD{},
+			isInvalidConfigAAD,
+		},
+		{
+			"no_cluster.yml",
+			KubeConfigAAD{},
+			isInvalidConfigAAD,
+		},
+		{
+			"cluster_with_no_server.yml",
+			KubeConfigAAD{},
+			isInvalidConfigAAD,
+		},
+	}
+
+	for i, test := range testCases {
+		encodedConfig := LoadConfig(test.sourceFile)
+		if len(encodedConfig) <= 0 {
+			t.Fatalf("Test case [%d]: Failed to read config from file '%+v' \n",
+				i, test.sourceFile)
+		}
+		if success, err := test.checkFunc(test.expected, encodedConfig); !success {
+			t.Fatalf("Test case [%d]: Failed, config '%+v' with error: '%+v'",
+				i, test.sourceFile, err)
+		}
+	}
+}
+
+func isValidConfigAAD(expected KubeConfigAAD, encodedConfig string) (bool, error) {
+	result, err := ParseKubeConfigAAD(encodedConfig)
+	if err != nil {
+		return false, err
+	}
+
+	if !reflect.DeepEqual(expected, *result) {
+		return false, fmt.Errorf("expected '%+v but got '%+v' with encoded config '%+v'",
+			expected, *result, encodedConfig)
+	}
+	return true, nil
+}
+
+func isInvalidConfigAAD(expected KubeConfigAAD, encodedConfig string) (bool, error) {
+	_, err := ParseKubeConfigAAD(encodedConfig)
+	if err == nil {
+		return false, fmt.Errorf("expected test to throw error but didn't")
+	}
+	return true, nil
+}
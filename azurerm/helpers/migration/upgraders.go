@@ -0,0 +1,39 @@
+package migration
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// StateUpgrader upgrades the State of a Resource from one Schema Version to the next
+type StateUpgrader func(is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error)
+
+// MigrateStateUsingUpgraders returns a MigrateState function which upgrades the State of a Resource to
+// the latest Schema Version, where `upgraders[n]` upgrades the State from version `n` to `n+1` - as such
+// the Resource's SchemaVersion must be `len(upgraders)`.
+//
+// Terraform only calls MigrateState once with the version present in the State, so each Upgrader
+// from that version onwards is applied in sequence - allowing State multiple versions behind to be
+// migrated. Upgraders must modify the InstanceState in-place.
+func MigrateStateUsingUpgraders(resourceName string, upgraders ...StateUpgrader) schema.StateMigrateFunc {
+	return func(v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+		if v < 0 || v >= len(upgraders) {
+			return is, fmt.Errorf("Unexpected schema version: %d", v)
+		}
+
+		for version := v; version < len(upgraders); version++ {
+			log.Printf("[INFO] Found AzureRM %s State v%d; migrating to v%d", resourceName, version, version+1)
+
+			var err error
+			is, err = upgraders[version](is, meta)
+			if err != nil {
+				return is, fmt.Errorf("Error migrating %s State from v%d to v%d: %+v", resourceName, version, version+1, err)
+			}
+		}
+
+		return is, nil
+	}
+}
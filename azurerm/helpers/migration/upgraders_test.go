@@ -0,0 +1,94 @@
+package migration
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestMigrateStateUsingUpgraders(t *testing.T) {
+	upgraders := []StateUpgrader{
+		func(is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+			is.Attributes["v1"] = "true"
+			return is, nil
+		},
+		func(is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+			is.Attributes["v2"] = "true"
+			return is, nil
+		},
+	}
+
+	testCases := []struct {
+		version  int
+		expected map[string]string
+		error    bool
+	}{
+		{
+			version: 0,
+			expected: map[string]string{
+				"v1": "true",
+				"v2": "true",
+			},
+		},
+		{
+			version: 1,
+			expected: map[string]string{
+				"v2": "true",
+			},
+		},
+		{
+			version: 2,
+			error:   true,
+		},
+		{
+			version: -1,
+			error:   true,
+		},
+	}
+
+	migrate := MigrateStateUsingUpgraders("Example", upgraders...)
+	for _, test := range testCases {
+		is := &terraform.InstanceState{
+			ID:         "some_id",
+			Attributes: map[string]string{},
+		}
+
+		actual, err := migrate(test.version, is, nil)
+		if test.error {
+			if err == nil {
+				t.Fatalf("Expected an error migrating from v%d but didn't get one", test.version)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Error migrating from v%d: %+v", test.version, err)
+		}
+
+		if !reflect.DeepEqual(actual.Attributes, test.expected) {
+			t.Fatalf("Expected %+v but got %+v when migrating from v%d", test.expected, actual.Attributes, test.version)
+		}
+	}
+}
+
+func TestMigrateStateUsingUpgraders_error(t *testing.T) {
+	upgraders := []StateUpgrader{
+		func(is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+			return is, fmt.Errorf("bad things")
+		},
+		func(is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+			t.Fatalf("Upgrader shouldn't be called after a previous Upgrader failed")
+			return is, nil
+		},
+	}
+
+	is := &terraform.InstanceState{
+		ID:         "some_id",
+		Attributes: map[string]string{},
+	}
+	if _, err := MigrateStateUsingUpgraders("Example", upgraders...)(0, is, nil); err == nil {
+		t.Fatalf("Expected an error but didn't get one")
+	}
+}
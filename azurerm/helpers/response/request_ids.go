@@ -0,0 +1,125 @@
+package response
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// RequestIDs are the identifiers returned by Azure Resource Manager which allow a request
+// to be traced by Microsoft Support
+type RequestIDs struct {
+	CorrelationRequestID string
+	RequestID            string
+	ActivityID           string
+}
+
+// ExtractRequestIDs returns the request identifiers present in the headers of the specified response
+func ExtractRequestIDs(resp *http.Response) RequestIDs {
+	if resp == nil {
+		return RequestIDs{}
+	}
+
+	return RequestIDs{
+		CorrelationRequestID: resp.Header.Get("x-ms-correlation-request-id"),
+		RequestID:            resp.Header.Get("x-ms-request-id"),
+		ActivityID:           resp.Header.Get("x-ms-activity-id"),
+	}
+}
+
+// String returns the request identifiers in the format `Correlation ID: {id}, Request ID: {id}`
+// omitting any which aren't present
+func (ids RequestIDs) String() string {
+	values := make([]string, 0)
+	if ids.CorrelationRequestID != "" {
+		values = append(values, fmt.Sprintf("Correlation ID: %s", ids.CorrelationRequestID))
+	}
+	if ids.RequestID != "" {
+		values = append(values, fmt.Sprintf("Request ID: %s", ids.RequestID))
+	}
+	if ids.ActivityID != "" && ids.ActivityID != ids.RequestID {
+		values = append(values, fmt.Sprintf("Activity ID: %s", ids.ActivityID))
+	}
+
+	return strings.Join(values, ", ")
+}
+
+// WithRequestIDsInErrors returns a SendDecorator which appends the request identifiers of a failed response
+// to the error message within the response body - since every client parses this message into the returned
+// error, this surfaces them in the error returned from any failing API call
+func WithRequestIDsInErrors() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			resp, err := s.Do(r)
+			if err == nil {
+				appendRequestIDsToErrorMessage(resp)
+			}
+			return resp, err
+		})
+	}
+}
+
+func appendRequestIDsToErrorMessage(resp *http.Response) {
+	if resp == nil || resp.Body == nil || resp.StatusCode < http.StatusBadRequest {
+		return
+	}
+
+	ids := ExtractRequestIDs(resp).String()
+	if ids == "" {
+		return
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+		return
+	}
+
+	if updated, ok := appendToErrorMessage(body, ids); ok {
+		body = updated
+		resp.ContentLength = int64(len(body))
+		resp.Header.Set("Content-Length", strconv.Itoa(len(body)))
+	}
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+}
+
+// appendToErrorMessage appends the suffix to the `message` of an ARM error body, which is either
+// nested within an `error` object or (for some older API's) at the top level
+func appendToErrorMessage(body []byte, ids string) ([]byte, bool) {
+	var payload map[string]interface{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, false
+	}
+
+	target := payload
+	if v, ok := payload["error"].(map[string]interface{}); ok {
+		target = v
+	}
+
+	updated := false
+	for _, key := range []string{"message", "Message"} {
+		if message, ok := target[key].(string); ok {
+			target[key] = fmt.Sprintf("%s (%s)", message, ids)
+			updated = true
+			break
+		}
+	}
+	if !updated {
+		return nil, false
+	}
+
+	output, err := json.Marshal(payload)
+	if err != nil {
+		return nil, false
+	}
+
+	return output, true
+}
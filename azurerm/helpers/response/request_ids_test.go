@@ -0,0 +1,135 @@
+package response
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestRequestIDsString(t *testing.T) {
+	testCases := []struct {
+		ids      RequestIDs
+		expected string
+	}{
+		{
+			ids:      RequestIDs{},
+			expected: "",
+		},
+		{
+			ids: RequestIDs{
+				CorrelationRequestID: "abc",
+			},
+			expected: "Correlation ID: abc",
+		},
+		{
+			ids: RequestIDs{
+				CorrelationRequestID: "abc",
+				RequestID:            "def",
+			},
+			expected: "Correlation ID: abc, Request ID: def",
+		},
+		{
+			ids: RequestIDs{
+				CorrelationRequestID: "abc",
+				RequestID:            "def",
+				ActivityID:           "def",
+			},
+			expected: "Correlation ID: abc, Request ID: def",
+		},
+		{
+			ids: RequestIDs{
+				CorrelationRequestID: "abc",
+				RequestID:            "def",
+				ActivityID:           "ghi",
+			},
+			expected: "Correlation ID: abc, Request ID: def, Activity ID: ghi",
+		},
+	}
+
+	for _, test := range testCases {
+		if actual := test.ids.String(); actual != test.expected {
+			t.Fatalf("Expected %q but got %q", test.expected, actual)
+		}
+	}
+}
+
+func TestAppendRequestIDsToErrorMessage(t *testing.T) {
+	testCases := []struct {
+		statusCode int
+		body       string
+		expected   string
+	}{
+		{
+			statusCode: http.StatusOK,
+			body:       `{"message":"hello"}`,
+			expected:   `{"message":"hello"}`,
+		},
+		{
+			statusCode: http.StatusNotFound,
+			body:       `{"error":{"code":"ResourceNotFound","message":"not found"}}`,
+			expected:   `{"error":{"code":"ResourceNotFound","message":"not found (Correlation ID: abc, Request ID: def)"}}`,
+		},
+		{
+			statusCode: http.StatusBadRequest,
+			body:       `{"Code":"BadRequest","Message":"bad request"}`,
+			expected:   `{"Code":"BadRequest","Message":"bad request (Correlation ID: abc, Request ID: def)"}`,
+		},
+		{
+			statusCode: http.StatusConflict,
+			body:       `{"error":{"code":"Conflict"}}`,
+			expected:   `{"error":{"code":"Conflict"}}`,
+		},
+		{
+			statusCode: http.StatusInternalServerError,
+			body:       `<html>Internal Server Error</html>`,
+			expected:   `<html>Internal Server Error</html>`,
+		},
+		{
+			statusCode: http.StatusBadGateway,
+			body:       ``,
+			expected:   ``,
+		},
+	}
+
+	for _, test := range testCases {
+		resp := &http.Response{
+			StatusCode: test.statusCode,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(bytes.NewBufferString(test.body)),
+		}
+		resp.Header.Set("x-ms-correlation-request-id", "abc")
+		resp.Header.Set("x-ms-request-id", "def")
+
+		appendRequestIDsToErrorMessage(resp)
+
+		actual, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("Error reading body: %+v", err)
+		}
+
+		if string(actual) != test.expected {
+			t.Fatalf("Expected %q but got %q", test.expected, string(actual))
+		}
+	}
+}
+
+func TestAppendRequestIDsToErrorMessage_NoHeaders(t *testing.T) {
+	body := `{"error":{"code":"ResourceNotFound","message":"not found"}}`
+	resp := &http.Response{
+		StatusCode: http.StatusNotFound,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+	}
+
+	appendRequestIDsToErrorMessage(resp)
+
+	actual, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("Error reading body: %+v", err)
+	}
+
+	if string(actual) != body {
+		t.Fatalf("Expected %q but got %q", body, string(actual))
+	}
+}
@@ -0,0 +1,54 @@
+package timeouts
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// ForCreate returns the context wrapped with the timeout for a Create operation
+func ForCreate(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+}
+
+// ForCreateUpdate returns the context wrapped with the timeout for a combined Create/Update operation,
+// using the Create timeout when the resource is new and the Update timeout otherwise
+func ForCreateUpdate(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc) {
+	if d.IsNewResource() {
+		return ForCreate(ctx, d)
+	}
+
+	return ForUpdate(ctx, d)
+}
+
+// ForDelete returns the context wrapped with the timeout for a Delete operation
+func ForDelete(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+}
+
+// ForRead returns the context wrapped with the timeout for a Read operation
+func ForRead(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
+}
+
+// ForUpdate returns the context wrapped with the timeout for an Update operation
+func ForUpdate(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+}
+
+// Default returns the ResourceTimeout used for resources which don't define their own -
+// Read operations are expected to be quick, whereas Create/Update/Delete can be long-running
+func Default(supportsUpdate bool) *schema.ResourceTimeout {
+	timeout := &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(60 * time.Minute),
+		Read:   schema.DefaultTimeout(5 * time.Minute),
+		Delete: schema.DefaultTimeout(60 * time.Minute),
+	}
+
+	if supportsUpdate {
+		timeout.Update = schema.DefaultTimeout(60 * time.Minute)
+	}
+
+	return timeout
+}
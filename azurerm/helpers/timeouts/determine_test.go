@@ -0,0 +1,20 @@
+package timeouts
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDefault(t *testing.T) {
+	if v := Default(false); v.Update != nil {
+		t.Fatalf("Expected no Update timeout for a resource which doesn't support Update but got %s", *v.Update)
+	}
+
+	v := Default(true)
+	if v.Update == nil || *v.Update != 60*time.Minute {
+		t.Fatalf("Expected an Update timeout of 60 minutes but got %+v", v.Update)
+	}
+	if v.Read == nil || *v.Read != 5*time.Minute {
+		t.Fatalf("Expected a Read timeout of 5 minutes but got %+v", v.Read)
+	}
+}
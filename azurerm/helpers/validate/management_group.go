@@ -0,0 +1,14 @@
+package validate
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func ManagementGroupName() schema.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile(`^[a-zA-Z0-9_().-]{0,89}[a-zA-Z0-9_()-]$`),
+		"Management Group Name can be up to 90 characters, can only include alphanumeric characters, underscores, hyphens, periods and parentheses and cannot end with a period.")
+}
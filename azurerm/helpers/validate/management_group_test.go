@@ -0,0 +1,38 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateManagementGroupName(t *testing.T) {
+	validNames := []string{
+		"platform-prod",
+		"00000000-0000-0000-0000-000000000000",
+		"Platform_Prod",
+		"platform.prod",
+		"platform(prod)",
+		strings.Repeat("a", 90),
+	}
+	for _, v := range validNames {
+		_, errors := ManagementGroupName()(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Management Group Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"platform prod",
+		"platform/prod",
+		"platform.",
+		"platform!",
+		strings.Repeat("a", 91),
+	}
+	for _, v := range invalidNames {
+		_, errors := ManagementGroupName()(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Management Group Name", v)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package validate
 
 import (
 	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/Azure/go-autorest/autorest/date"
@@ -46,3 +47,19 @@ func RFC3339DateInFutureBy(d time.Duration) schema.SchemaValidateFunc {
 		return
 	}
 }
+
+// TimeSpan validates the value is a .net style TimeSpan in the format [d.]hh:mm:ss
+// with two-digit hours, since Azure returns these zero-padded (e.g. `01:00:00` rather than `1:00:00`)
+func TimeSpan(i interface{}, k string) (_ []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^([0-9]+\.)?([01][0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9]$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be a TimeSpan in the format [d.]hh:mm:ss, got %q", k, v))
+	}
+
+	return
+}
@@ -102,3 +102,61 @@ func TestRfc3339DateInFutureBy(t *testing.T) {
 		})
 	}
 }
+
+func TestTimeSpan(t *testing.T) {
+	cases := []struct {
+		Value  string
+		Errors int
+	}{
+		{
+			Value:  "",
+			Errors: 1,
+		},
+		{
+			Value:  "5 minutes",
+			Errors: 1,
+		},
+		{
+			Value:  "00:05",
+			Errors: 1,
+		},
+		{
+			Value:  "24:00:00",
+			Errors: 1,
+		},
+		{
+			Value:  "00:60:00",
+			Errors: 1,
+		},
+		{
+			Value:  "1:00:00",
+			Errors: 1,
+		},
+		{
+			Value:  "01:00:00",
+			Errors: 0,
+		},
+		{
+			Value:  "00:05:00",
+			Errors: 0,
+		},
+		{
+			Value:  "23:59:59",
+			Errors: 0,
+		},
+		{
+			Value:  "1.02:00:00",
+			Errors: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Value, func(t *testing.T) {
+			_, errors := TimeSpan(tc.Value, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected TimeSpan to have %d not %d errors for %q", tc.Errors, len(errors), tc.Value)
+			}
+		})
+	}
+}
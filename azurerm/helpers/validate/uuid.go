@@ -19,3 +19,17 @@ func UUID(i interface{}, k string) (_ []string, errors []error) {
 
 	return
 }
+
+func UUIDOrEmpty(i interface{}, k string) (_ []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if v == "" {
+		return
+	}
+
+	return UUID(i, k)
+}
@@ -31,6 +31,27 @@ func IntBetweenAndNot(min, max, not int) schema.SchemaValidateFunc {
 	}
 }
 
+// IntInSlice returns a SchemaValidateFunc which tests if the provided value
+// is of type int and matches the value of an element in the valid slice
+func IntInSlice(valid []int) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (_ []string, errors []error) {
+		v, ok := i.(int)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %q to be int", k))
+			return
+		}
+
+		for _, validInt := range valid {
+			if v == validInt {
+				return
+			}
+		}
+
+		errors = append(errors, fmt.Errorf("expected %s to be one of %v, got %d", k, valid, v))
+		return
+	}
+}
+
 func UrlIsHttpOrHttps() schema.SchemaValidateFunc {
 	return UrlWithScheme([]string{"http", "https"})
 }
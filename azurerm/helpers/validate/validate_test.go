@@ -43,3 +43,41 @@ func TestUrlWithScheme(t *testing.T) {
 		}
 	})
 }
+
+func TestIntInSlice(t *testing.T) {
+	valid := []int{8, 16, 24}
+	testCases := []struct {
+		Value           int
+		ShouldHaveError bool
+	}{
+		{
+			Value:           8,
+			ShouldHaveError: false,
+		},
+		{
+			Value:           24,
+			ShouldHaveError: false,
+		},
+		{
+			Value:           0,
+			ShouldHaveError: true,
+		},
+		{
+			Value:           12,
+			ShouldHaveError: true,
+		},
+	}
+
+	for _, v := range testCases {
+		_, errors := IntInSlice(valid)(v.Value, "field_name")
+
+		hasErrors := len(errors) > 0
+		if v.ShouldHaveError && !hasErrors {
+			t.Fatalf("Expected an error but didn't get one for %d", v.Value)
+		}
+
+		if !v.ShouldHaveError && hasErrors {
+			t.Fatalf("Expected %d to return no errors, but got some %+v", v.Value, errors)
+		}
+	}
+}
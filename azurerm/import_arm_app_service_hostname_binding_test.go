@@ -17,6 +17,10 @@ func TestAccAzureRMAppServiceCustomHostnameBinding(t *testing.T) {
 			"multiple": testAccAzureRMAppServiceCustomHostnameBinding_multiple,
 			"import":   testAccAzureRMAppServiceCustomHostnameBinding_import,
 		},
+		"certificateBinding": {
+			"basic":  testAccAzureRMAppServiceCertificateBinding_basic,
+			"update": testAccAzureRMAppServiceCertificateBinding_update,
+		},
 	}
 
 	for group, m := range testCases {
@@ -57,3 +57,27 @@ func TestAccAzureRMRoleDefinition_importComplete(t *testing.T) {
 		},
 	})
 }
+
+func TestAccAzureRMRoleDefinition_importManagementGroup(t *testing.T) {
+	resourceName := "azurerm_role_definition.test"
+
+	id := uuid.New().String()
+	ri := acctest.RandInt()
+	config := testAccAzureRMRoleDefinition_managementGroup(id, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRoleDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,33 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMTemplateDeployment_importBasic(t *testing.T) {
+	resourceName := "azurerm_template_deployment.test"
+
+	ri := acctest.RandInt()
+	config := testAccAzureRMTemplateDeployment_basicMultiple(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the Template and Parameters can't be retrieved from the API in the format they were specified
+				ImportStateVerifyIgnore: []string{"template_body", "parameters", "parameters_body"},
+			},
+		},
+	})
+}
@@ -29,7 +29,10 @@ func resourceLogicAppComponentUpdate(d *schema.ResourceData, meta interface{}, k
 	}
 
 	resourceGroup := id.ResourceGroup
-	logicAppName := id.Path["workflows"]
+	logicAppName, err := id.PopSegment("workflows")
+	if err != nil {
+		return err
+	}
 
 	log.Printf("[DEBUG] Preparing arguments for Logic App Workspace %q (Resource Group %q) %s %q", logicAppName, resourceGroup, kind, name)
 
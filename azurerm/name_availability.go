@@ -0,0 +1,54 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// nameAvailabilityCheckFunc checks whether the specified name is available, returning
+// the reason from Azure when it isn't
+type nameAvailabilityCheckFunc func(ctx context.Context, name string) (available *bool, message *string, err error)
+
+// nameToCheckForAvailability returns the globally unique name which should be checked for availability
+// at plan time - which is only the case when the resource is being created (or recreated with a different
+// name), since the name of an existing resource is by definition taken. Names which are interpolated from
+// other resources aren't known at plan time and so can't be checked.
+func nameToCheckForAvailability(diff *schema.ResourceDiff) (string, bool) {
+	if diff.Id() != "" && !diff.HasChange("name") {
+		return "", false
+	}
+
+	name := diff.Get("name").(string)
+	if name == "" {
+		return "", false
+	}
+
+	return name, true
+}
+
+// validateNameIsAvailable checks that the globally unique name of the resource (if it needs to be checked)
+// is available at plan time, using the resource-specific CheckNameAvailability API
+func validateNameIsAvailable(diff *schema.ResourceDiff, meta interface{}, resourceType string, check nameAvailabilityCheckFunc) error {
+	name, ok := nameToCheckForAvailability(diff)
+	if !ok {
+		return nil
+	}
+
+	ctx := meta.(*ArmClient).StopContext
+	available, message, err := check(ctx, name)
+	if err != nil {
+		return fmt.Errorf("Error checking if the name %q was available: %+v", name, err)
+	}
+
+	if available != nil && !*available {
+		reason := ""
+		if message != nil {
+			reason = *message
+		}
+		return fmt.Errorf("The name %q used for the %s needs to be globally unique and isn't available: %s", name, resourceType, reason)
+	}
+
+	return nil
+}
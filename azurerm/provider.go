@@ -14,9 +14,12 @@ import (
 	"github.com/Azure/go-autorest/autorest/adal"
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/authentication"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 )
 
 // Provider returns a terraform.ResourceProvider.
@@ -42,18 +45,65 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_SECRET", ""),
 			},
 
+			"client_certificate_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_CERTIFICATE_PATH", ""),
+			},
+
+			"client_certificate_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_CERTIFICATE_PASSWORD", ""),
+			},
+
 			"tenant_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_TENANT_ID", ""),
 			},
 
+			"auxiliary_tenant_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 3,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
 			"environment": {
 				Type:        schema.TypeString,
 				Required:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_ENVIRONMENT", "public"),
 			},
 
+			"resource_manager_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_RESOURCE_MANAGER_ENDPOINT", ""),
+			},
+
+			"active_directory_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_ACTIVE_DIRECTORY_ENDPOINT", ""),
+			},
+
+			"graph_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_GRAPH_ENDPOINT", ""),
+			},
+
+			"partner_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.UUIDOrEmpty,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_PARTNER_ID", ""),
+			},
+
 			"skip_credentials_validation": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -65,6 +115,50 @@ func Provider() terraform.ResourceProvider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_SKIP_PROVIDER_REGISTRATION", false),
 			},
+			"resource_providers_to_register": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"proxy_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_PROXY_URL", ""),
+			},
+
+			"ca_certificate_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_CA_CERTIFICATE_PATH", ""),
+			},
+
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_RETRIES", 3),
+			},
+
+			"retry_backoff_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_RETRY_BACKOFF_SECONDS", 30),
+			},
+
+			"default_tags": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: validateAzureRMTags,
+			},
+
+			"ignore_tags": schemaIgnoreTags(),
+
+			"features": schemaFeatures(),
+
 			"use_msi": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -83,7 +177,9 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_api_management":                        dataSourceApiManagementService(),
 			"azurerm_application_security_group":            dataSourceArmApplicationSecurityGroup(),
 			"azurerm_app_service":                           dataSourceArmAppService(),
+			"azurerm_app_service_environment":               dataSourceArmAppServiceEnvironment(),
 			"azurerm_app_service_plan":                      dataSourceAppServicePlan(),
+			"azurerm_builtin_policy_definition":             dataSourceArmBuiltInPolicyDefinition(),
 			"azurerm_builtin_role_definition":               dataSourceArmBuiltInRoleDefinition(),
 			"azurerm_cdn_profile":                           dataSourceArmCdnProfile(),
 			"azurerm_client_config":                         dataSourceArmClientConfig(),
@@ -97,11 +193,13 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_key_vault":                             dataSourceArmKeyVault(),
 			"azurerm_key_vault_access_policy":               dataSourceArmKeyVaultAccessPolicy(),
 			"azurerm_key_vault_secret":                      dataSourceArmKeyVaultSecret(),
+			"azurerm_key_vault_secrets":                     dataSourceArmKeyVaultSecrets(),
 			"azurerm_kubernetes_cluster":                    dataSourceArmKubernetesCluster(),
 			"azurerm_log_analytics_workspace":               dataSourceLogAnalyticsWorkspace(),
 			"azurerm_logic_app_workflow":                    dataSourceArmLogicAppWorkflow(),
 			"azurerm_managed_disk":                          dataSourceArmManagedDisk(),
 			"azurerm_management_group":                      dataSourceArmManagementGroup(),
+			"azurerm_management_group_descendants":          dataSourceArmManagementGroupDescendants(),
 			"azurerm_network_interface":                     dataSourceArmNetworkInterface(),
 			"azurerm_network_security_group":                dataSourceArmNetworkSecurityGroup(),
 			"azurerm_notification_hub":                      dataSourceNotificationHub(),
@@ -120,12 +218,16 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_snapshot":                              dataSourceArmSnapshot(),
 			"azurerm_storage_account":                       dataSourceArmStorageAccount(),
 			"azurerm_storage_account_sas":                   dataSourceArmStorageAccountSharedAccessSignature(),
+			"azurerm_storage_queue":                         dataSourceArmStorageQueue(),
+			"azurerm_storage_share":                         dataSourceArmStorageShare(),
+			"azurerm_storage_table":                         dataSourceArmStorageTable(),
 			"azurerm_subnet":                                dataSourceArmSubnet(),
 			"azurerm_subscription":                          dataSourceArmSubscription(),
 			"azurerm_subscriptions":                         dataSourceArmSubscriptions(),
 			"azurerm_traffic_manager_geographical_location": dataSourceArmTrafficManagerGeographicalLocation(),
 			"azurerm_virtual_network":                       dataSourceArmVirtualNetwork(),
 			"azurerm_virtual_network_gateway":               dataSourceArmVirtualNetworkGateway(),
+			"azurerm_virtual_network_peering":               dataSourceArmVirtualNetworkPeering(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -139,8 +241,14 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_app_service":                                                            resourceArmAppService(),
 			"azurerm_app_service_plan":                                                       resourceArmAppServicePlan(),
 			"azurerm_app_service_active_slot":                                                resourceArmAppServiceActiveSlot(),
+			"azurerm_app_service_certificate":                                                resourceArmAppServiceCertificate(),
+			"azurerm_app_service_certificate_binding":                                        resourceArmAppServiceCertificateBinding(),
 			"azurerm_app_service_custom_hostname_binding":                                    resourceArmAppServiceCustomHostnameBinding(),
+			"azurerm_app_service_environment":                                                resourceArmAppServiceEnvironment(),
+			"azurerm_app_service_hybrid_connection":                                          resourceArmAppServiceHybridConnection(),
 			"azurerm_app_service_slot":                                                       resourceArmAppServiceSlot(),
+			"azurerm_app_service_virtual_network_swift_connection":                           resourceArmAppServiceVirtualNetworkSwiftConnection(),
+			"azurerm_arm_resource":                                                           resourceArmArmResource(),
 			"azurerm_automation_account":                                                     resourceArmAutomationAccount(),
 			"azurerm_automation_credential":                                                  resourceArmAutomationCredential(),
 			"azurerm_automation_runbook":                                                     resourceArmAutomationRunbook(),
@@ -185,6 +293,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_express_route_circuit_authorization":                                    resourceArmExpressRouteCircuitAuthorization(),
 			"azurerm_express_route_circuit_peering":                                          resourceArmExpressRouteCircuitPeering(),
 			"azurerm_firewall":                                                               resourceArmFirewall(),
+			"azurerm_firewall_application_rule_collection":                                   resourceArmFirewallApplicationRuleCollection(),
 			"azurerm_firewall_network_rule_collection":                                       resourceArmFirewallNetworkRuleCollection(),
 			"azurerm_function_app":                                                           resourceArmFunctionApp(),
 			"azurerm_image":                                                                  resourceArmImage(),
@@ -194,6 +303,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_key_vault_certificate":                                                  resourceArmKeyVaultCertificate(),
 			"azurerm_key_vault_key":                                                          resourceArmKeyVaultKey(),
 			"azurerm_key_vault_secret":                                                       resourceArmKeyVaultSecret(),
+			"azurerm_key_vault_subnet_association":                                           resourceArmKeyVaultSubnetAssociation(),
 			"azurerm_kubernetes_cluster":                                                     resourceArmKubernetesCluster(),
 			"azurerm_lb":                                                                     resourceArmLoadBalancer(),
 			"azurerm_lb_backend_address_pool":                                                resourceArmLoadBalancerBackendAddressPool(),
@@ -213,6 +323,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_managed_disk":                                                           resourceArmManagedDisk(),
 			"azurerm_management_lock":                                                        resourceArmManagementLock(),
 			"azurerm_management_group":                                                       resourceArmManagementGroup(),
+			"azurerm_management_group_subscription_association":                             resourceArmManagementGroupSubscriptionAssociation(),
 			"azurerm_metric_alertrule":                                                       resourceArmMetricAlertRule(),
 			"azurerm_monitor_action_group":                                                   resourceArmMonitorActionGroup(),
 			"azurerm_monitor_activity_log_alert":                                             resourceArmMonitorActivityLogAlert(),
@@ -226,9 +337,11 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_network_interface_application_gateway_backend_address_pool_association": resourceArmNetworkInterfaceApplicationGatewayBackendAddressPoolAssociation(),
 			"azurerm_network_interface_backend_address_pool_association":                     resourceArmNetworkInterfaceBackendAddressPoolAssociation(),
 			"azurerm_network_interface_nat_rule_association":                                 resourceArmNetworkInterfaceNatRuleAssociation(),
+			"azurerm_network_ddos_protection_plan":                                           resourceArmNetworkDDoSProtectionPlan(),
 			"azurerm_network_security_group":                                                 resourceArmNetworkSecurityGroup(),
 			"azurerm_network_security_rule":                                                  resourceArmNetworkSecurityRule(),
 			"azurerm_network_watcher":                                                        resourceArmNetworkWatcher(),
+			"azurerm_network_watcher_flow_log":                                               resourceArmNetworkWatcherFlowLog(),
 			"azurerm_notification_hub":                                                       resourceArmNotificationHub(),
 			"azurerm_notification_hub_authorization_rule":                                    resourceArmNotificationHubAuthorizationRule(),
 			"azurerm_notification_hub_namespace":                                             resourceArmNotificationHubNamespace(),
@@ -270,8 +383,11 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_scheduler_job":                                                          resourceArmSchedulerJob(),
 			"azurerm_scheduler_job_collection":                                               resourceArmSchedulerJobCollection(),
 			"azurerm_sql_database":                                                           resourceArmSqlDatabase(),
+			"azurerm_sql_database_auditing_policy":                                           resourceArmSqlDatabaseAuditingPolicy(),
 			"azurerm_sql_elasticpool":                                                        resourceArmSqlElasticPool(),
+			"azurerm_sql_failover_group":                                                     resourceArmSqlFailoverGroup(),
 			"azurerm_sql_firewall_rule":                                                      resourceArmSqlFirewallRule(),
+			"azurerm_sql_managed_instance":                                                   resourceArmSqlManagedInstance(),
 			"azurerm_sql_active_directory_administrator":                                     resourceArmSqlAdministrator(),
 			"azurerm_sql_server":                                                             resourceArmSqlServer(),
 			"azurerm_sql_virtual_network_rule":                                               resourceArmSqlVirtualNetworkRule(),
@@ -292,6 +408,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_virtual_machine_data_disk_attachment":                                   resourceArmVirtualMachineDataDiskAttachment(),
 			"azurerm_virtual_machine_extension":                                              resourceArmVirtualMachineExtensions(),
 			"azurerm_virtual_machine_scale_set":                                              resourceArmVirtualMachineScaleSet(),
+			"azurerm_virtual_machine_scale_set_extension":                                    resourceArmVirtualMachineScaleSetExtension(),
 			"azurerm_virtual_network":                                                        resourceArmVirtualNetwork(),
 			"azurerm_virtual_network_gateway":                                                resourceArmVirtualNetworkGateway(),
 			"azurerm_virtual_network_gateway_connection":                                     resourceArmVirtualNetworkGatewayConnection(),
@@ -299,6 +416,16 @@ func Provider() terraform.ResourceProvider {
 		},
 	}
 
+	// Resources which don't define their own Timeouts are assigned the defaults, such that
+	// these can be overridden via the `timeouts` block
+	for _, resource := range p.ResourcesMap {
+		if resource.Timeouts == nil {
+			resource.Timeouts = timeouts.Default(resource.Update != nil)
+		}
+	}
+
+	applyTagsCustomizeDiffToResources(p.ResourcesMap)
+
 	p.ConfigureFunc = providerConfigure(p)
 
 	return p
@@ -310,12 +437,34 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			SubscriptionID:            d.Get("subscription_id").(string),
 			ClientID:                  d.Get("client_id").(string),
 			ClientSecret:              d.Get("client_secret").(string),
+			ClientCertPath:            d.Get("client_certificate_path").(string),
+			ClientCertPassword:        d.Get("client_certificate_password").(string),
 			TenantID:                  d.Get("tenant_id").(string),
 			Environment:               d.Get("environment").(string),
+			ResourceManagerEndpoint:   d.Get("resource_manager_endpoint").(string),
+			ActiveDirectoryEndpoint:   d.Get("active_directory_endpoint").(string),
+			GraphEndpoint:             d.Get("graph_endpoint").(string),
 			UseMsi:                    d.Get("use_msi").(bool),
 			MsiEndpoint:               d.Get("msi_endpoint").(string),
 			SkipCredentialsValidation: d.Get("skip_credentials_validation").(bool),
 			SkipProviderRegistration:  d.Get("skip_provider_registration").(bool),
+			PartnerID:                 d.Get("partner_id").(string),
+			ProxyURL:                  d.Get("proxy_url").(string),
+			CACertificatePath:         d.Get("ca_certificate_path").(string),
+		}
+
+		for _, v := range d.Get("auxiliary_tenant_ids").([]interface{}) {
+			config.AuxiliaryTenantIDs = append(config.AuxiliaryTenantIDs, v.(string))
+		}
+
+		maxRetries := d.Get("max_retries").(int)
+		config.MaxRetries = &maxRetries
+
+		retryBackoffSeconds := d.Get("retry_backoff_seconds").(int)
+		config.RetryBackoffSeconds = &retryBackoffSeconds
+
+		for _, v := range d.Get("resource_providers_to_register").([]interface{}) {
+			config.ResourceProvidersToRegister = append(config.ResourceProvidersToRegister, v.(string))
 		}
 
 		if config.UseMsi {
@@ -337,6 +486,11 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			if err := config.ValidateServicePrincipal(); err != nil {
 				return nil, err
 			}
+		} else if config.ClientCertPath != "" {
+			log.Printf("[DEBUG] Client Certificate specified - using Service Principal (Client Certificate) for Authentication")
+			if err := config.ValidateServicePrincipalCertificate(); err != nil {
+				return nil, err
+			}
 		} else {
 			log.Printf("[DEBUG] No Client Secret specified - loading credentials from Azure CLI")
 			if err := config.LoadTokensFromAzureCLI(); err != nil {
@@ -348,12 +502,19 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			}
 		}
 
+		if err := config.ValidateAuxiliaryTenants(); err != nil {
+			return nil, err
+		}
+
 		client, err := getArmClient(config)
 		if err != nil {
 			return nil, err
 		}
 
 		client.StopContext = p.StopContext()
+		client.defaultTags = d.Get("default_tags").(map[string]interface{})
+		client.ignoredTags = expandIgnoredTags(d.Get("ignore_tags").([]interface{}))
+		client.features = expandFeatures(d.Get("features").([]interface{}))
 
 		// replaces the context between tests
 		p.MetaReset = func() error {
@@ -373,7 +534,8 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			}
 
 			if !config.SkipProviderRegistration {
-				err = registerAzureResourceProvidersWithSubscription(ctx, providerList.Values(), client.providersClient)
+				providersToRegister := resourceProvidersFromNames(config.ResourceProvidersToRegister)
+				err = registerAzureResourceProvidersWithSubscription(ctx, providerList.Values(), client.providersClient, providersToRegister)
 				if err != nil {
 					return nil, err
 				}
@@ -393,8 +555,9 @@ func registerProviderWithSubscription(ctx context.Context, providerName string,
 	return nil
 }
 
-func determineAzureResourceProvidersToRegister(providerList []resources.Provider) map[string]struct{} {
-	providers := map[string]struct{}{
+// requiredResourceProviders returns all of the Resource Providers which the Terraform provider may require
+func requiredResourceProviders() map[string]struct{} {
+	return map[string]struct{}{
 		"Microsoft.ApiManagement":       {},
 		"Microsoft.Authorization":       {},
 		"Microsoft.Automation":          {},
@@ -430,16 +593,44 @@ func determineAzureResourceProvidersToRegister(providerList []resources.Provider
 		"Microsoft.Sql":                 {},
 		"Microsoft.Storage":             {},
 	}
+}
+
+// resourceProvidersFromNames returns the set of Resource Providers to register - which is either the
+// Resource Providers specified by the user, or (when none are specified) all of the required Resource Providers
+func resourceProvidersFromNames(input []string) map[string]struct{} {
+	if len(input) == 0 {
+		return requiredResourceProviders()
+	}
+
+	providers := make(map[string]struct{}, len(input))
+	for _, v := range input {
+		providers[v] = struct{}{}
+	}
+	return providers
+}
+
+func determineAzureResourceProvidersToRegister(providerList []resources.Provider, requested map[string]struct{}) map[string]struct{} {
+	providers := make(map[string]struct{}, len(requested))
+	for k := range requested {
+		providers[k] = struct{}{}
+	}
 
 	// filter out any providers already registered
 	for _, p := range providerList {
-		if _, ok := providers[*p.Namespace]; !ok {
+		if p.Namespace == nil || p.RegistrationState == nil {
 			continue
 		}
 
-		if strings.ToLower(*p.RegistrationState) == "registered" {
-			log.Printf("[DEBUG] Skipping provider registration for namespace %s\n", *p.Namespace)
-			delete(providers, *p.Namespace)
+		for name := range providers {
+			// Resource Provider Namespaces are case-insensitive (e.g. `microsoft.insights`)
+			if !strings.EqualFold(name, *p.Namespace) {
+				continue
+			}
+
+			if strings.ToLower(*p.RegistrationState) == "registered" {
+				log.Printf("[DEBUG] Skipping provider registration for namespace %s\n", *p.Namespace)
+				delete(providers, name)
+			}
 		}
 	}
 
@@ -450,10 +641,11 @@ func determineAzureResourceProvidersToRegister(providerList []resources.Provider
 // all Azure resource providers which the Terraform provider may require (regardless of
 // whether they are actually used by the configuration or not). It was confirmed by Microsoft
 // that this is the approach their own internal tools also take.
-func registerAzureResourceProvidersWithSubscription(ctx context.Context, providerList []resources.Provider, client resources.ProvidersClient) error {
-	providers := determineAzureResourceProvidersToRegister(providerList)
+func registerAzureResourceProvidersWithSubscription(ctx context.Context, providerList []resources.Provider, client resources.ProvidersClient, requested map[string]struct{}) error {
+	providers := determineAzureResourceProvidersToRegister(providerList, requested)
 
 	var err error
+	var lock sync.Mutex
 	var wg sync.WaitGroup
 	wg.Add(len(providers))
 
@@ -461,8 +653,10 @@ func registerAzureResourceProvidersWithSubscription(ctx context.Context, provide
 		go func(p string) {
 			defer wg.Done()
 			log.Printf("[DEBUG] Registering provider with namespace %s\n", p)
-			if innerErr := registerProviderWithSubscription(ctx, p, client); err != nil {
+			if innerErr := registerProviderWithSubscription(ctx, p, client); innerErr != nil {
+				lock.Lock()
 				err = innerErr
+				lock.Unlock()
 			}
 		}(providerName)
 	}
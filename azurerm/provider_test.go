@@ -5,12 +5,14 @@ import (
 	"os"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2017-05-10/resources"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/authentication"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 var testAccProviders map[string]terraform.ResourceProvider
@@ -33,6 +35,30 @@ func TestProvider_impl(t *testing.T) {
 	var _ terraform.ResourceProvider = Provider()
 }
 
+func TestProvider_migrateStateForSchemaVersion(t *testing.T) {
+	provider := Provider().(*schema.Provider)
+	for name, resource := range provider.ResourcesMap {
+		if resource.SchemaVersion > 0 && resource.MigrateState == nil {
+			t.Fatalf("%q has a SchemaVersion of %d but no MigrateState function", name, resource.SchemaVersion)
+		}
+
+		if resource.MigrateState == nil {
+			continue
+		}
+
+		// each version prior to the current SchemaVersion must be able to be migrated
+		for version := 0; version < resource.SchemaVersion; version++ {
+			is := &terraform.InstanceState{
+				ID:         "",
+				Attributes: map[string]string{},
+			}
+			if _, err := resource.MigrateState(version, is, nil); err != nil {
+				t.Fatalf("Error migrating empty State for %q from v%d: %+v", name, version, err)
+			}
+		}
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	variables := []string{
 		"ARM_CLIENT_ID",
@@ -105,6 +131,60 @@ func testGetAzureConfig(t *testing.T) *authentication.Config {
 	return &config
 }
 
+func TestDetermineAzureResourceProvidersToRegister(t *testing.T) {
+	providerList := []resources.Provider{
+		{
+			Namespace:         utils.String("Microsoft.Compute"),
+			RegistrationState: utils.String("Registered"),
+		},
+		{
+			Namespace:         utils.String("Microsoft.Network"),
+			RegistrationState: utils.String("NotRegistered"),
+		},
+		{
+			Namespace:         utils.String("microsoft.insights"),
+			RegistrationState: utils.String("Registered"),
+		},
+	}
+
+	cases := []struct {
+		Description string
+		Input       []string
+		Expected    []string
+	}{
+		{
+			Description: "Explicit Resource Providers",
+			Input:       []string{"Microsoft.Compute", "Microsoft.Network", "Microsoft.Storage"},
+			Expected:    []string{"Microsoft.Network", "Microsoft.Storage"},
+		},
+		{
+			Description: "Case-Insensitive Namespaces",
+			Input:       []string{"Microsoft.Insights", "microsoft.network"},
+			Expected:    []string{"microsoft.network"},
+		},
+	}
+
+	for _, v := range cases {
+		actual := determineAzureResourceProvidersToRegister(providerList, resourceProvidersFromNames(v.Input))
+		if len(actual) != len(v.Expected) {
+			t.Fatalf("Expected %d Resource Providers for %q but got %d: %+v", len(v.Expected), v.Description, len(actual), actual)
+		}
+
+		for _, name := range v.Expected {
+			if _, ok := actual[name]; !ok {
+				t.Fatalf("Expected %q to need registering for %q but it didn't: %+v", name, v.Description, actual)
+			}
+		}
+	}
+
+	// when no Resource Providers are specified all of the required ones are registered
+	required := requiredResourceProviders()
+	actual := determineAzureResourceProvidersToRegister(providerList, resourceProvidersFromNames([]string{}))
+	if len(actual) != len(required)-2 {
+		t.Fatalf("Expected %d Resource Providers but got %d", len(required)-2, len(actual))
+	}
+}
+
 func TestAccAzureRMResourceProviderRegistration(t *testing.T) {
 	config := testGetAzureConfig(t)
 	if config == nil {
@@ -125,12 +205,13 @@ func TestAccAzureRMResourceProviderRegistration(t *testing.T) {
 			"error: %s", err)
 	}
 
-	err = registerAzureResourceProvidersWithSubscription(ctx, providerList.Values(), client)
+	requiredProviders := requiredResourceProviders()
+	err = registerAzureResourceProvidersWithSubscription(ctx, providerList.Values(), client, requiredProviders)
 	if err != nil {
 		t.Fatalf("Error registering Resource Providers: %+v", err)
 	}
 
-	needingRegistration := determineAzureResourceProvidersToRegister(providerList.Values())
+	needingRegistration := determineAzureResourceProvidersToRegister(providerList.Values(), requiredProviders)
 	if len(needingRegistration) > 0 {
 		t.Fatalf("'%d' Resource Providers are still Pending Registration: %s", len(needingRegistration), spew.Sprint(needingRegistration))
 	}
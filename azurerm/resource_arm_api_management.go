@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -24,13 +25,11 @@ var apimTripleDesChipers = "Microsoft.WindowsAzure.ApiManagement.Gateway.Securit
 
 func resourceArmApiManagementService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmApiManagementServiceCreateUpdate,
-		Read:   resourceArmApiManagementServiceRead,
-		Update: resourceArmApiManagementServiceCreateUpdate,
-		Delete: resourceArmApiManagementServiceDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmApiManagementServiceCreateUpdate,
+		Read:     resourceArmApiManagementServiceRead,
+		Update:   resourceArmApiManagementServiceCreateUpdate,
+		Delete:   resourceArmApiManagementServiceDelete,
+		Importer: azure.ImporterValidatingResourceId("service"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -283,7 +282,8 @@ func resourceArmApiManagementService() *schema.Resource {
 
 func resourceArmApiManagementServiceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagementServiceClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for API Management Service creation.")
 
@@ -352,7 +352,8 @@ func resourceArmApiManagementServiceCreateUpdate(d *schema.ResourceData, meta in
 
 func resourceArmApiManagementServiceRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagementServiceClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -360,7 +361,10 @@ func resourceArmApiManagementServiceRead(d *schema.ResourceData, meta interface{
 	}
 
 	resourceGroup := id.ResourceGroup
-	name := id.Path["service"]
+	name, err := id.PopSegment("service")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -421,14 +425,18 @@ func resourceArmApiManagementServiceRead(d *schema.ResourceData, meta interface{
 
 func resourceArmApiManagementServiceDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagementServiceClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["service"]
+	name, err := id.PopSegment("service")
+	if err != nil {
+		return err
+	}
 
 	log.Printf("[DEBUG] Deleting API Management Service %q (Resource Grouo %q)", name, resourceGroup)
 	resp, err := client.Delete(ctx, resourceGroup, name)
@@ -581,8 +589,6 @@ func flattenApiManagementHostnameConfigurations(input *[]apimanagement.HostnameC
 			"scm":        scmResults,
 		},
 	}
-
-	return nil
 }
 
 func expandAzureRmApiManagementCertificates(d *schema.ResourceData) *[]apimanagement.CertificateConfiguration {
@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -9,18 +10,24 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+const (
+	appServiceDockerRegistryServerUrlAppSetting      = "DOCKER_REGISTRY_SERVER_URL"
+	appServiceDockerRegistryServerUsernameAppSetting = "DOCKER_REGISTRY_SERVER_USERNAME"
+	appServiceDockerRegistryServerPasswordAppSetting = "DOCKER_REGISTRY_SERVER_PASSWORD"
+)
+
 func resourceArmAppService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmAppServiceCreate,
-		Read:   resourceArmAppServiceRead,
-		Update: resourceArmAppServiceUpdate,
-		Delete: resourceArmAppServiceDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:        resourceArmAppServiceCreate,
+		Read:          resourceArmAppServiceRead,
+		Update:        resourceArmAppServiceUpdate,
+		Delete:        resourceArmAppServiceDelete,
+		Importer:      azure.ImporterValidatingResourceId("sites"),
+		CustomizeDiff: resourceArmAppServiceCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -93,6 +100,32 @@ func resourceArmAppService() *schema.Resource {
 				Computed: true,
 			},
 
+			"docker_registry": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"server_url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"username": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"password": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+
 			"connection_string": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -181,9 +214,26 @@ func resourceArmAppService() *schema.Resource {
 	}
 }
 
+func resourceArmAppServiceCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	return validateNameIsAvailable(diff, meta, "App Service", func(ctx context.Context, name string) (*bool, *string, error) {
+		client := meta.(*ArmClient).appServicesClient
+		availabilityRequest := web.ResourceNameAvailabilityRequest{
+			Name: utils.String(name),
+			Type: web.CheckNameResourceTypesMicrosoftWebsites,
+		}
+		available, err := client.CheckNameAvailability(ctx, availabilityRequest)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return available.NameAvailable, available.Message, nil
+	})
+}
+
 func resourceArmAppServiceCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).appServicesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM App Service creation.")
 
@@ -256,7 +306,8 @@ func resourceArmAppServiceCreate(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmAppServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).appServicesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -264,7 +315,10 @@ func resourceArmAppServiceUpdate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["sites"]
+	name, err := id.PopSegment("sites")
+	if err != nil {
+		return err
+	}
 
 	location := azureRMNormalizeLocation(d.Get("location").(string))
 	appServicePlanId := d.Get("app_service_plan_id").(string)
@@ -323,9 +377,13 @@ func resourceArmAppServiceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	if d.HasChange("app_settings") {
+	if d.HasChange("app_settings") || d.HasChange("docker_registry") {
 		// update the AppSettings
 		appSettings := expandAppServiceAppSettings(d)
+		for k, v := range expandAppServiceDockerRegistry(d.Get("docker_registry").([]interface{})) {
+			appSettings[k] = v
+		}
+
 		settings := web.StringDictionary{
 			Properties: appSettings,
 		}
@@ -383,9 +441,13 @@ func resourceArmAppServiceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["sites"]
+	name, err := id.PopSegment("sites")
+	if err != nil {
+		return err
+	}
 
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	resp, err := client.Get(ctx, resGroup, name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
@@ -444,7 +506,12 @@ func resourceArmAppServiceRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("outbound_ip_addresses", props.OutboundIPAddresses)
 	}
 
-	if err := d.Set("app_settings", flattenAppServiceAppSettings(appSettingsResp.Properties)); err != nil {
+	appSettings := flattenAppServiceAppSettings(appSettingsResp.Properties)
+	if err := setAppServiceDockerRegistry(d, appSettings); err != nil {
+		return err
+	}
+
+	if err := d.Set("app_settings", appSettings); err != nil {
 		return err
 	}
 	if err := d.Set("connection_string", flattenAppServiceConnectionStrings(connectionStringsResp.Properties)); err != nil {
@@ -452,6 +519,7 @@ func resourceArmAppServiceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	siteConfig := azure.FlattenAppServiceSiteConfig(configResp.SiteConfig)
+	removeUnusedAppServiceMultiContainerFiles(d, siteConfig)
 	if err := d.Set("site_config", siteConfig); err != nil {
 		return err
 	}
@@ -484,13 +552,17 @@ func resourceArmAppServiceDelete(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["sites"]
+	name, err := id.PopSegment("sites")
+	if err != nil {
+		return err
+	}
 
 	log.Printf("[DEBUG] Deleting App Service %q (resource group %q)", name, resGroup)
 
 	deleteMetrics := true
 	deleteEmptyServerFarm := false
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	resp, err := client.Delete(ctx, resGroup, name, &deleteMetrics, &deleteEmptyServerFarm)
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp) {
@@ -567,6 +639,44 @@ func flattenAppServiceConnectionStrings(input map[string]*web.ConnStringValueTyp
 	return results
 }
 
+func expandAppServiceDockerRegistry(input []interface{}) map[string]*string {
+	output := make(map[string]*string)
+	if len(input) == 0 || input[0] == nil {
+		return output
+	}
+
+	registry := input[0].(map[string]interface{})
+	output[appServiceDockerRegistryServerUrlAppSetting] = utils.String(registry["server_url"].(string))
+	output[appServiceDockerRegistryServerUsernameAppSetting] = utils.String(registry["username"].(string))
+	output[appServiceDockerRegistryServerPasswordAppSetting] = utils.String(registry["password"].(string))
+
+	return output
+}
+
+// setAppServiceDockerRegistry sets the `docker_registry` block from the App Settings and removes the
+// Docker Registry App Settings from appSettings - this only happens when the `docker_registry` block is used,
+// since the same App Settings can otherwise be managed directly through `app_settings`
+func setAppServiceDockerRegistry(d *schema.ResourceData, appSettings map[string]string) error {
+	if _, ok := d.GetOk("docker_registry"); !ok {
+		return nil
+	}
+
+	registry := map[string]interface{}{
+		"server_url": appSettings[appServiceDockerRegistryServerUrlAppSetting],
+		"username":   appSettings[appServiceDockerRegistryServerUsernameAppSetting],
+		"password":   appSettings[appServiceDockerRegistryServerPasswordAppSetting],
+	}
+	if err := d.Set("docker_registry", []interface{}{registry}); err != nil {
+		return fmt.Errorf("Error setting `docker_registry`: %+v", err)
+	}
+
+	delete(appSettings, appServiceDockerRegistryServerUrlAppSetting)
+	delete(appSettings, appServiceDockerRegistryServerUsernameAppSetting)
+	delete(appSettings, appServiceDockerRegistryServerPasswordAppSetting)
+
+	return nil
+}
+
 func flattenAppServiceAppSettings(input map[string]*string) map[string]string {
 	output := make(map[string]string, 0)
 	for k, v := range input {
@@ -613,6 +723,22 @@ func validateAppServiceName(v interface{}, k string) (ws []string, es []error) {
 	return
 }
 
+// removeUnusedAppServiceMultiContainerFiles removes the `docker_compose_file` and `kubernetes_file` derived from the
+// `linux_fx_version` unless they're being used - so that a `linux_fx_version` specified directly doesn't cause a diff.
+// These are always kept when the `linux_fx_version` isn't known (e.g. when importing).
+func removeUnusedAppServiceMultiContainerFiles(d *schema.ResourceData, siteConfig []interface{}) {
+	if len(siteConfig) == 0 || d.Get("site_config.0.linux_fx_version").(string) == "" {
+		return
+	}
+
+	config := siteConfig[0].(map[string]interface{})
+	for _, key := range []string{"docker_compose_file", "kubernetes_file"} {
+		if d.Get(fmt.Sprintf("site_config.0.%s", key)).(string) == "" {
+			delete(config, key)
+		}
+	}
+}
+
 func flattenAppServiceSiteCredential(input *web.UserProperties) []interface{} {
 	results := make([]interface{}, 0)
 	result := make(map[string]interface{}, 0)
@@ -6,18 +6,18 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmAppServiceActiveSlot() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmAppServiceActiveSlotCreate,
-		Read:   resourceArmAppServiceActiveSlotRead,
-		Update: resourceArmAppServiceActiveSlotCreate,
-		Delete: resourceArmAppServiceActiveSlotDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmAppServiceActiveSlotCreate,
+		Read:     resourceArmAppServiceActiveSlotRead,
+		Update:   resourceArmAppServiceActiveSlotCreate,
+		Delete:   resourceArmAppServiceActiveSlotDelete,
+		Importer: azure.ImporterValidatingResourceId("sites"),
 		Schema: map[string]*schema.Schema{
 
 			"resource_group_name": resourceGroupNameSchema(),
@@ -38,7 +38,8 @@ func resourceArmAppServiceActiveSlot() *schema.Resource {
 
 func resourceArmAppServiceActiveSlotCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).appServicesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	appServiceName := d.Get("app_service_name").(string)
 	resGroup := d.Get("resource_group_name").(string)
@@ -53,9 +54,9 @@ func resourceArmAppServiceActiveSlotCreate(d *schema.ResourceData, meta interfac
 		return fmt.Errorf("Error making Read request on AzureRM App Service %q: %+v", appServiceName, err)
 	}
 
-	_, err = client.Get(ctx, resGroup, targetSlot)
+	slotResp, err := client.GetSlot(ctx, resGroup, appServiceName, targetSlot)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if utils.ResponseWasNotFound(slotResp.Response) {
 			return fmt.Errorf("[DEBUG] App Service Target Active Slot %q/%q (resource group %q) was not found.", appServiceName, targetSlot, resGroup)
 		}
 		return fmt.Errorf("Error making Read request on AzureRM App Service Slot %q/%q: %+v", appServiceName, targetSlot, err)
@@ -80,7 +81,8 @@ func resourceArmAppServiceActiveSlotCreate(d *schema.ResourceData, meta interfac
 
 func resourceArmAppServiceActiveSlotRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).appServicesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -88,7 +90,10 @@ func resourceArmAppServiceActiveSlotRead(d *schema.ResourceData, meta interface{
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["sites"]
+	name, err := id.PopSegment("sites")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resGroup, name)
 	if err != nil {
@@ -102,7 +107,13 @@ func resourceArmAppServiceActiveSlotRead(d *schema.ResourceData, meta interface{
 
 	d.Set("app_service_name", resp.Name)
 	d.Set("resource_group_name", resp.ResourceGroup)
-	d.Set("app_service_slot_name", resp.SiteProperties.SlotSwapStatus.SourceSlotName)
+
+	if props := resp.SiteProperties; props != nil {
+		if slotSwapStatus := props.SlotSwapStatus; slotSwapStatus != nil {
+			d.Set("app_service_slot_name", slotSwapStatus.SourceSlotName)
+		}
+	}
+
 	return nil
 }
 
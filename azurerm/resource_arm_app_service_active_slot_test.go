@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -57,6 +58,24 @@ func TestAccAzureRMAppServiceActiveSlot_update(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMAppServiceActiveSlot_slotNotFound(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccAzureRMAppServiceActiveSlot_slotNotFound(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		// Destroy actually does nothing so we just return nil
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("App Service Target Active Slot .* was not found"),
+			},
+		},
+	})
+}
+
 func testAccAzureRMAppServiceActiveSlot_basic(rInt int, location string) string {
 	return fmt.Sprintf(`
   resource "azurerm_resource_group" "test" {
@@ -195,3 +214,36 @@ func testAccAzureRMAppServiceActiveSlot_updated(rInt int, location string) strin
   }
   `, rInt, location, rInt, rInt, rInt, rInt)
 }
+
+func testAccAzureRMAppServiceActiveSlot_slotNotFound(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    tier = "Standard"
+    size = "S1"
+  }
+}
+
+resource "azurerm_app_service" "test" {
+  name                = "acctestAS-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+}
+
+resource "azurerm_app_service_active_slot" "test" {
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  app_service_name      = "${azurerm_app_service.test.name}"
+  app_service_slot_name = "acctestASSlot-%d"
+}
+`, rInt, location, rInt, rInt, rInt)
+}
@@ -0,0 +1,295 @@
+package azurerm
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2018-02-14/keyvault"
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmAppServiceCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceArmAppServiceCertificateCreateUpdate,
+		Read:     resourceArmAppServiceCertificateRead,
+		Update:   resourceArmAppServiceCertificateCreateUpdate,
+		Delete:   resourceArmAppServiceCertificateDelete,
+		Importer: azure.ImporterValidatingResourceId("certificates"),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"pfx_blob": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Sensitive:     true,
+				ValidateFunc:  validation.NoZeroValues,
+				ConflictsWith: []string{"key_vault_secret_id"},
+			},
+
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+
+			"key_vault_secret_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.NoZeroValues,
+				ConflictsWith: []string{"pfx_blob", "password"},
+			},
+
+			"friendly_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"subject_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"host_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"issuer": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"issue_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"expiration_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"thumbprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmAppServiceCertificateCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServiceCertificatesClient
+	vaultClient := meta.(*ArmClient).keyVaultClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	pfxBlob := d.Get("pfx_blob").(string)
+	password := d.Get("password").(string)
+	keyVaultSecretId := d.Get("key_vault_secret_id").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	if pfxBlob == "" && keyVaultSecretId == "" {
+		return fmt.Errorf("Either `pfx_blob` or `key_vault_secret_id` must be specified")
+	}
+
+	properties := web.CertificateProperties{
+		Password: utils.String(password),
+	}
+
+	if pfxBlob != "" {
+		decodedPfxBlob, err := base64.StdEncoding.DecodeString(pfxBlob)
+		if err != nil {
+			return fmt.Errorf("Error decoding `pfx_blob` for App Service Certificate %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+		properties.PfxBlob = &decodedPfxBlob
+	}
+
+	if keyVaultSecretId != "" {
+		secretId, err := parseKeyVaultChildID(keyVaultSecretId)
+		if err != nil {
+			return fmt.Errorf("Error parsing `key_vault_secret_id` %q: %+v", keyVaultSecretId, err)
+		}
+
+		keyVaultId, err := azureRMKeyVaultIDFromBaseUrl(ctx, vaultClient, secretId.KeyVaultBaseUrl)
+		if err != nil {
+			return fmt.Errorf("Error looking up Key Vault for `key_vault_secret_id` %q: %+v", keyVaultSecretId, err)
+		}
+
+		properties.KeyVaultID = keyVaultId
+		properties.KeyVaultSecretName = utils.String(secretId.Name)
+	}
+
+	certificate := web.Certificate{
+		Location:              utils.String(location),
+		CertificateProperties: &properties,
+		Tags:                  expandTags(tags),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, certificate); err != nil {
+		return fmt.Errorf("Error creating/updating App Service Certificate %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving App Service Certificate %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ID for App Service Certificate %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmAppServiceCertificateRead(d, meta)
+}
+
+func resourceArmAppServiceCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServiceCertificatesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name, err := id.PopSegment("certificates")
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] App Service Certificate %q (Resource Group %q) was not found - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving App Service Certificate %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	// `pfx_blob`, `password` and `key_vault_secret_id` aren't returned from the API
+	if props := resp.CertificateProperties; props != nil {
+		d.Set("friendly_name", props.FriendlyName)
+		d.Set("subject_name", props.SubjectName)
+		d.Set("issuer", props.Issuer)
+		d.Set("thumbprint", props.Thumbprint)
+
+		if issueDate := props.IssueDate; issueDate != nil {
+			d.Set("issue_date", issueDate.Format(time.RFC3339))
+		}
+
+		if expirationDate := props.ExpirationDate; expirationDate != nil {
+			d.Set("expiration_date", expirationDate.Format(time.RFC3339))
+		}
+
+		hostNames := make([]interface{}, 0)
+		if props.HostNames != nil {
+			for _, hostName := range *props.HostNames {
+				hostNames = append(hostNames, hostName)
+			}
+		}
+		if err := d.Set("host_names", hostNames); err != nil {
+			return fmt.Errorf("Error setting `host_names`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmAppServiceCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServiceCertificatesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name, err := id.PopSegment("certificates")
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting App Service Certificate %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+// azureRMKeyVaultIDFromBaseUrl returns the Resource ID of the Key Vault with the specified Base URL
+// (e.g. `https://example.vault.azure.net/`) - which must exist within the current Subscription
+func azureRMKeyVaultIDFromBaseUrl(ctx context.Context, client keyvault.VaultsClient, keyVaultBaseUrl string) (*string, error) {
+	u, err := url.Parse(keyVaultBaseUrl)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Key Vault Base URL %q: %+v", keyVaultBaseUrl, err)
+	}
+
+	// Key Vault names are globally unique and form the first label of the hostname
+	keyVaultName := strings.Split(u.Host, ".")[0]
+
+	iterator, err := client.ListComplete(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Key Vaults: %+v", err)
+	}
+
+	for iterator.NotDone() {
+		v := iterator.Value()
+		if v.Name != nil && v.ID != nil && strings.EqualFold(*v.Name, keyVaultName) {
+			return v.ID, nil
+		}
+
+		if err := iterator.Next(); err != nil {
+			return nil, fmt.Errorf("Error listing Key Vaults: %+v", err)
+		}
+	}
+
+	return nil, fmt.Errorf("Key Vault %q was not found in the current Subscription", keyVaultName)
+}
@@ -0,0 +1,296 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmAppServiceCertificateBinding() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceArmAppServiceCertificateBindingCreateUpdate,
+		Read:     resourceArmAppServiceCertificateBindingRead,
+		Update:   resourceArmAppServiceCertificateBindingCreateUpdate,
+		Delete:   resourceArmAppServiceCertificateBindingDelete,
+		Importer: azure.ImporterValidatingResourceId("sites", "hostNameBindings"),
+
+		Schema: map[string]*schema.Schema{
+			"hostname_binding_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"certificate_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"ssl_state": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(web.SslStateIPBasedEnabled),
+					string(web.SslStateSniEnabled),
+				}, false),
+			},
+
+			"hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"app_service_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"thumbprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"virtual_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmAppServiceCertificateBindingCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServicesClient
+	certificatesClient := meta.(*ArmClient).appServiceCertificatesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for App Service Certificate Binding creation/update.")
+
+	hostnameBindingId := d.Get("hostname_binding_id").(string)
+	certificateId := d.Get("certificate_id").(string)
+	sslState := d.Get("ssl_state").(string)
+
+	id, err := parseAzureResourceID(hostnameBindingId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	appServiceName, err := id.PopSegment("sites")
+	if err != nil {
+		return err
+	}
+	hostname, err := id.PopSegment("hostNameBindings")
+	if err != nil {
+		return err
+	}
+
+	parsedCertificateId, err := parseAzureResourceID(certificateId)
+	if err != nil {
+		return err
+	}
+	certificateName, err := parsedCertificateId.PopSegment("certificates")
+	if err != nil {
+		return err
+	}
+
+	certificate, err := certificatesClient.Get(ctx, parsedCertificateId.ResourceGroup, certificateName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving App Service Certificate %q (Resource Group %q): %+v", certificateName, parsedCertificateId.ResourceGroup, err)
+	}
+	if certificate.CertificateProperties == nil || certificate.CertificateProperties.Thumbprint == nil {
+		return fmt.Errorf("Error: `thumbprint` was nil for App Service Certificate %q (Resource Group %q)", certificateName, parsedCertificateId.ResourceGroup)
+	}
+
+	azureRMLockByName(appServiceName, appServiceCustomHostnameBindingResourceName)
+	defer azureRMUnlockByName(appServiceName, appServiceCustomHostnameBindingResourceName)
+
+	existing, err := client.GetHostNameBinding(ctx, resourceGroup, appServiceName, hostname)
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("App Service Hostname Binding %q (App Service %q / Resource Group %q) was not found!", hostname, appServiceName, resourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving App Service Hostname Binding %q (App Service %q / Resource Group %q): %+v", hostname, appServiceName, resourceGroup, err)
+	}
+
+	props := existing.HostNameBindingProperties
+	if props == nil {
+		return fmt.Errorf("Error: `properties` was nil for App Service Hostname Binding %q (App Service %q / Resource Group %q)", hostname, appServiceName, resourceGroup)
+	}
+
+	if d.IsNewResource() && props.SslState != "" && props.SslState != web.SslStateDisabled {
+		return fmt.Errorf("A Certificate is already bound to the App Service Hostname Binding %q - to be managed via Terraform this resource needs to be imported into the State. Please see the resource documentation for `azurerm_app_service_certificate_binding` for more information.", hostnameBindingId)
+	}
+
+	// the Hostname Binding is otherwise managed by the `azurerm_app_service_custom_hostname_binding` resource
+	props.SslState = web.SslState(sslState)
+	props.Thumbprint = certificate.CertificateProperties.Thumbprint
+	props.VirtualIP = nil
+
+	if _, err := client.CreateOrUpdateHostNameBinding(ctx, resourceGroup, appServiceName, hostname, existing); err != nil {
+		return fmt.Errorf("Error binding Certificate %q to App Service Hostname Binding %q (App Service %q / Resource Group %q): %+v", certificateName, hostname, appServiceName, resourceGroup, err)
+	}
+
+	d.SetId(hostnameBindingId)
+
+	return resourceArmAppServiceCertificateBindingRead(d, meta)
+}
+
+func resourceArmAppServiceCertificateBindingRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServicesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	appServiceName, err := id.PopSegment("sites")
+	if err != nil {
+		return err
+	}
+	hostname, err := id.PopSegment("hostNameBindings")
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetHostNameBinding(ctx, resourceGroup, appServiceName, hostname)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] App Service Hostname Binding %q (App Service %q / Resource Group %q) was not found - removing from state", hostname, appServiceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving App Service Hostname Binding %q (App Service %q / Resource Group %q): %+v", hostname, appServiceName, resourceGroup, err)
+	}
+
+	props := resp.HostNameBindingProperties
+	if props == nil || props.SslState == "" || props.SslState == web.SslStateDisabled || props.Thumbprint == nil {
+		log.Printf("[DEBUG] No Certificate is bound to App Service Hostname Binding %q (App Service %q / Resource Group %q) - removing from state", hostname, appServiceName, resourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	certificateId, err := findAppServiceCertificateIdByThumbprint(ctx, meta, d.Get("certificate_id").(string), resourceGroup, *props.Thumbprint)
+	if err != nil {
+		return err
+	}
+
+	d.Set("hostname_binding_id", resp.ID)
+	d.Set("certificate_id", certificateId)
+	d.Set("ssl_state", string(props.SslState))
+	d.Set("hostname", hostname)
+	d.Set("app_service_name", appServiceName)
+	d.Set("thumbprint", props.Thumbprint)
+	d.Set("virtual_ip", props.VirtualIP)
+
+	return nil
+}
+
+func resourceArmAppServiceCertificateBindingDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServicesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	appServiceName, err := id.PopSegment("sites")
+	if err != nil {
+		return err
+	}
+	hostname, err := id.PopSegment("hostNameBindings")
+	if err != nil {
+		return err
+	}
+
+	azureRMLockByName(appServiceName, appServiceCustomHostnameBindingResourceName)
+	defer azureRMUnlockByName(appServiceName, appServiceCustomHostnameBindingResourceName)
+
+	existing, err := client.GetHostNameBinding(ctx, resourceGroup, appServiceName, hostname)
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving App Service Hostname Binding %q (App Service %q / Resource Group %q): %+v", hostname, appServiceName, resourceGroup, err)
+	}
+
+	props := existing.HostNameBindingProperties
+	if props == nil {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Removing the Certificate from App Service Hostname Binding %q (App Service %q / Resource Group %q)", hostname, appServiceName, resourceGroup)
+
+	props.SslState = web.SslStateDisabled
+	props.Thumbprint = nil
+	props.VirtualIP = nil
+
+	if _, err := client.CreateOrUpdateHostNameBinding(ctx, resourceGroup, appServiceName, hostname, existing); err != nil {
+		return fmt.Errorf("Error removing the Certificate from App Service Hostname Binding %q (App Service %q / Resource Group %q): %+v", hostname, appServiceName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// findAppServiceCertificateIdByThumbprint returns the ID of the App Service Certificate bound using the specified thumbprint,
+// checking the existing Certificate first - and otherwise the Certificates within the App Service's Resource Group (e.g. on import)
+func findAppServiceCertificateIdByThumbprint(ctx context.Context, meta interface{}, existingId string, resourceGroup string, thumbprint string) (string, error) {
+	client := meta.(*ArmClient).appServiceCertificatesClient
+
+	if existingId != "" {
+		id, err := parseAzureResourceID(existingId)
+		if err != nil {
+			return "", err
+		}
+		name, err := id.PopSegment("certificates")
+		if err != nil {
+			return "", err
+		}
+
+		certificate, err := client.Get(ctx, id.ResourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(certificate.Response) {
+				return "", fmt.Errorf("Error retrieving App Service Certificate %q (Resource Group %q): %+v", name, id.ResourceGroup, err)
+			}
+		} else if props := certificate.CertificateProperties; props != nil && props.Thumbprint != nil && strings.EqualFold(*props.Thumbprint, thumbprint) {
+			return existingId, nil
+		}
+	}
+
+	iterator, err := client.ListByResourceGroupComplete(ctx, resourceGroup)
+	if err != nil {
+		return "", fmt.Errorf("Error listing App Service Certificates (Resource Group %q): %+v", resourceGroup, err)
+	}
+
+	for iterator.NotDone() {
+		certificate := iterator.Value()
+		if props := certificate.CertificateProperties; certificate.ID != nil && props != nil && props.Thumbprint != nil {
+			if strings.EqualFold(*props.Thumbprint, thumbprint) {
+				return *certificate.ID, nil
+			}
+		}
+
+		if err := iterator.Next(); err != nil {
+			return "", fmt.Errorf("Error listing App Service Certificates (Resource Group %q): %+v", resourceGroup, err)
+		}
+	}
+
+	// the Certificate isn't one we can find, so the binding will be updated to use the Certificate in the config
+	return "", nil
+}
@@ -0,0 +1,176 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func testAccAzureRMAppServiceCertificateBinding_basic(t *testing.T) {
+	appServiceEnv, domainEnv, certificatePathEnv, certificatePasswordEnv := testAccAzureRMAppServiceCertificateBindingEnvironment(t)
+
+	resourceName := "azurerm_app_service_certificate_binding.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceCertificateBindingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppServiceCertificateBinding_config(ri, location, appServiceEnv, domainEnv, certificatePathEnv, certificatePasswordEnv, "SniEnabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceCertificateBindingExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ssl_state", "SniEnabled"),
+					resource.TestCheckResourceAttrPair(resourceName, "thumbprint", "azurerm_app_service_certificate.test", "thumbprint"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAzureRMAppServiceCertificateBinding_update(t *testing.T) {
+	appServiceEnv, domainEnv, certificatePathEnv, certificatePasswordEnv := testAccAzureRMAppServiceCertificateBindingEnvironment(t)
+
+	resourceName := "azurerm_app_service_certificate_binding.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceCertificateBindingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppServiceCertificateBinding_config(ri, location, appServiceEnv, domainEnv, certificatePathEnv, certificatePasswordEnv, "SniEnabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceCertificateBindingExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ssl_state", "SniEnabled"),
+				),
+			},
+			{
+				Config: testAccAzureRMAppServiceCertificateBinding_config(ri, location, appServiceEnv, domainEnv, certificatePathEnv, certificatePasswordEnv, "IpBasedEnabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceCertificateBindingExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ssl_state", "IpBasedEnabled"),
+					resource.TestCheckResourceAttrSet(resourceName, "virtual_ip"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAzureRMAppServiceCertificateBindingEnvironment(t *testing.T) (string, string, string, string) {
+	values := make([]string, 0)
+	for _, variable := range []string{"ARM_TEST_APP_SERVICE", "ARM_TEST_DOMAIN", "ARM_TEST_CERTIFICATE_PATH", "ARM_TEST_CERTIFICATE_PASSWORD"} {
+		value := os.Getenv(variable)
+		if value == "" {
+			t.Skipf("Skipping as %q is not specified", variable)
+		}
+		values = append(values, value)
+	}
+
+	return values[0], values[1], values[2], values[3]
+}
+
+func testCheckAzureRMAppServiceCertificateBindingExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		appServiceName := id.Path["sites"]
+		hostname := id.Path["hostNameBindings"]
+
+		client := testAccProvider.Meta().(*ArmClient).appServicesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.GetHostNameBinding(ctx, resourceGroup, appServiceName, hostname)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Hostname Binding %q (App Service %q / Resource Group: %q) does not exist", hostname, appServiceName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on appServicesClient: %+v", err)
+		}
+
+		if props := resp.HostNameBindingProperties; props == nil || props.SslState == web.SslStateDisabled || props.Thumbprint == nil {
+			return fmt.Errorf("Bad: No Certificate is bound to Hostname Binding %q (App Service %q / Resource Group: %q)", hostname, appServiceName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMAppServiceCertificateBindingDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).appServicesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_app_service_certificate_binding" {
+			continue
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		appServiceName := id.Path["sites"]
+		hostname := id.Path["hostNameBindings"]
+
+		resp, err := client.GetHostNameBinding(ctx, resourceGroup, appServiceName, hostname)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		if props := resp.HostNameBindingProperties; props != nil && props.SslState != web.SslStateDisabled {
+			return fmt.Errorf("A Certificate is still bound to Hostname Binding %q (App Service %q / Resource Group: %q)", hostname, appServiceName, resourceGroup)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMAppServiceCertificateBinding_config(rInt int, location, appServiceName, domain, certificatePath, certificatePassword, sslState string) string {
+	template := testAccAzureRMAppServiceCustomHostnameBinding_basicConfig(rInt, location, appServiceName, domain)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_service_certificate" "test" {
+  name                = "acctest%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  pfx_blob            = "${base64encode(file("%s"))}"
+  password            = "%s"
+}
+
+resource "azurerm_app_service_certificate_binding" "test" {
+  hostname_binding_id = "${azurerm_app_service_custom_hostname_binding.test.id}"
+  certificate_id      = "${azurerm_app_service_certificate.test.id}"
+  ssl_state           = "%s"
+}
+`, template, rInt, certificatePath, certificatePassword, sslState)
+}
@@ -0,0 +1,223 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMAppServiceCertificate_pfx(t *testing.T) {
+	resourceName := "azurerm_app_service_certificate.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppServiceCertificate_pfx(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceCertificateExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "thumbprint"),
+					resource.TestCheckResourceAttrSet(resourceName, "expiration_date"),
+					resource.TestCheckResourceAttr(resourceName, "subject_name", "api.terraform.io"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"pfx_blob", "password"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAppServiceCertificate_keyVault(t *testing.T) {
+	resourceName := "azurerm_app_service_certificate.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppServiceCertificate_keyVault(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceCertificateExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "thumbprint"),
+					resource.TestCheckResourceAttrPair(resourceName, "thumbprint", "azurerm_key_vault_certificate.test", "thumbprint"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAppServiceCertificateExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).appServiceCertificatesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: App Service Certificate %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on appServiceCertificatesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMAppServiceCertificateDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).appServiceCertificatesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_app_service_certificate" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("App Service Certificate %q (Resource Group %q) still exists", name, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMAppServiceCertificate_pfx(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_app_service_certificate" "test" {
+  name                = "acctest%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  pfx_blob            = "${base64encode(file("testdata/api_management_api_test.pfx"))}"
+  password            = "terraform"
+}
+`, rInt, location)
+}
+
+func testAccAzureRMAppServiceCertificate_keyVault(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+// the Microsoft.Azure.WebSites Service Principal needs access to read the Secret
+data "azurerm_azuread_service_principal" "test" {
+  application_id = "abfa0a7c-a6b6-4736-8310-5855508787cd"
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[3]s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv%[2]s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  sku {
+    name = "standard"
+  }
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+    certificate_permissions = [
+      "delete",
+      "import",
+      "get",
+    ]
+
+    key_permissions = [
+      "create",
+    ]
+
+    secret_permissions = [
+      "get",
+      "set",
+    ]
+  }
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_azuread_service_principal.test.id}"
+
+    secret_permissions = [
+      "get",
+    ]
+  }
+}
+
+resource "azurerm_key_vault_certificate" "test" {
+  name      = "acctestcert%[2]s"
+  vault_uri = "${azurerm_key_vault.test.vault_uri}"
+
+  certificate {
+    contents = "${base64encode(file("testdata/keyvaultcert.pfx"))}"
+    password = ""
+  }
+
+  certificate_policy {
+    issuer_parameters {
+      name = "Self"
+    }
+
+    key_properties {
+      exportable = true
+      key_size   = 2048
+      key_type   = "RSA"
+      reuse_key  = false
+    }
+
+    secret_properties {
+      content_type = "application/x-pkcs12"
+    }
+  }
+}
+
+resource "azurerm_app_service_certificate" "test" {
+  name                = "acctest%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  key_vault_secret_id = "${azurerm_key_vault_certificate.test.secret_id}"
+}
+`, rInt, rString, location)
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -13,12 +15,10 @@ var appServiceCustomHostnameBindingResourceName = "azurerm_app_service_custom_ho
 
 func resourceArmAppServiceCustomHostnameBinding() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmAppServiceCustomHostnameBindingCreate,
-		Read:   resourceArmAppServiceCustomHostnameBindingRead,
-		Delete: resourceArmAppServiceCustomHostnameBindingDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmAppServiceCustomHostnameBindingCreate,
+		Read:     resourceArmAppServiceCustomHostnameBindingRead,
+		Delete:   resourceArmAppServiceCustomHostnameBindingDelete,
+		Importer: azure.ImporterValidatingResourceId("sites", "hostNameBindings"),
 
 		Schema: map[string]*schema.Schema{
 			"hostname": {
@@ -40,7 +40,8 @@ func resourceArmAppServiceCustomHostnameBinding() *schema.Resource {
 
 func resourceArmAppServiceCustomHostnameBindingCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).appServicesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for App Service Hostname Binding creation.")
 
@@ -83,10 +84,17 @@ func resourceArmAppServiceCustomHostnameBindingRead(d *schema.ResourceData, meta
 	}
 
 	resourceGroup := id.ResourceGroup
-	appServiceName := id.Path["sites"]
-	hostname := id.Path["hostNameBindings"]
+	appServiceName, err := id.PopSegment("sites")
+	if err != nil {
+		return err
+	}
+	hostname, err := id.PopSegment("hostNameBindings")
+	if err != nil {
+		return err
+	}
 
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	resp, err := client.GetHostNameBinding(ctx, resourceGroup, appServiceName, hostname)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
@@ -112,15 +120,22 @@ func resourceArmAppServiceCustomHostnameBindingDelete(d *schema.ResourceData, me
 		return err
 	}
 	resGroup := id.ResourceGroup
-	appServiceName := id.Path["sites"]
-	hostname := id.Path["hostNameBindings"]
+	appServiceName, err := id.PopSegment("sites")
+	if err != nil {
+		return err
+	}
+	hostname, err := id.PopSegment("hostNameBindings")
+	if err != nil {
+		return err
+	}
 
 	azureRMLockByName(appServiceName, appServiceCustomHostnameBindingResourceName)
 	defer azureRMUnlockByName(appServiceName, appServiceCustomHostnameBindingResourceName)
 
 	log.Printf("[DEBUG] Deleting App Service Hostname Binding %q (App Service %q / Resource Group %q)", hostname, appServiceName, resGroup)
 
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	resp, err := client.DeleteHostNameBinding(ctx, resGroup, appServiceName, hostname)
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp) {
@@ -0,0 +1,452 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// the Front End size for an App Service Environment is surfaced as a Pricing Tier in the Portal
+var appServiceEnvironmentPricingTiers = map[string]string{
+	"I1": "Standard_D1_V2",
+	"I2": "Standard_D2_V2",
+	"I3": "Standard_D3_V2",
+}
+
+func resourceArmAppServiceEnvironment() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceArmAppServiceEnvironmentCreate,
+		Read:     resourceArmAppServiceEnvironmentRead,
+		Update:   resourceArmAppServiceEnvironmentUpdate,
+		Delete:   resourceArmAppServiceEnvironmentDelete,
+		Importer: azure.ImporterValidatingResourceId("hostingEnvironments"),
+
+		// provisioning or scaling an App Service Environment commonly takes multiple hours
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(6 * time.Hour),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(6 * time.Hour),
+			Delete: schema.DefaultTimeout(6 * time.Hour),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAppServiceEnvironmentName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			// the App Service Environment is always provisioned in the same location as the Virtual Network
+			"location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"internal_load_balancing_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(web.InternalLoadBalancingModeNone),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(web.InternalLoadBalancingModeNone),
+					string(web.InternalLoadBalancingModePublishing),
+					string(web.InternalLoadBalancingModeWeb),
+					"Web, Publishing",
+				}, false),
+			},
+
+			"pricing_tier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "I1",
+				ValidateFunc: validation.StringInSlice([]string{
+					"I1",
+					"I2",
+					"I3",
+				}, false),
+			},
+
+			"front_end_scale_factor": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      15,
+				ValidateFunc: validation.IntBetween(5, 15),
+			},
+
+			"user_whitelisted_ip_ranges": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.CIDRNetwork(0, 32),
+				},
+			},
+
+			"cluster_setting": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"dns_suffix": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmAppServiceEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServiceEnvironmentsClient
+	vnetClient := meta.(*ArmClient).vnetClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	subnetId := d.Get("subnet_id").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	parsedSubnetId, err := parseAzureResourceID(subnetId)
+	if err != nil {
+		return fmt.Errorf("Error parsing `subnet_id`: %+v", err)
+	}
+
+	subnetName := parsedSubnetId.Path["subnets"]
+	virtualNetworkName := parsedSubnetId.Path["virtualNetworks"]
+	virtualNetworkResourceGroup := parsedSubnetId.ResourceGroup
+
+	vnet, err := vnetClient.Get(ctx, virtualNetworkResourceGroup, virtualNetworkName, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Virtual Network %q (Resource Group %q): %+v", virtualNetworkName, virtualNetworkResourceGroup, err)
+	}
+
+	if vnet.Location == nil {
+		return fmt.Errorf("Error determining the location of Virtual Network %q (Resource Group %q)", virtualNetworkName, virtualNetworkResourceGroup)
+	}
+	location := azureRMNormalizeLocation(*vnet.Location)
+
+	environment := web.AppServiceEnvironmentResource{
+		Kind:     utils.String("ASEV2"),
+		Location: utils.String(location),
+		AppServiceEnvironment: &web.AppServiceEnvironment{
+			Name:     utils.String(name),
+			Location: utils.String(location),
+			VirtualNetwork: &web.VirtualNetworkProfile{
+				ID:     utils.String(subnetId),
+				Subnet: utils.String(subnetName),
+			},
+			InternalLoadBalancingMode: web.InternalLoadBalancingMode(d.Get("internal_load_balancing_mode").(string)),
+			MultiSize:                 utils.String(appServiceEnvironmentPricingTiers[d.Get("pricing_tier").(string)]),
+			FrontEndScaleFactor:       utils.Int32(int32(d.Get("front_end_scale_factor").(int))),
+			UserWhitelistedIPRanges:   expandAppServiceEnvironmentUserWhitelistedIPRanges(d.Get("user_whitelisted_ip_ranges").([]interface{})),
+			ClusterSettings:           expandAppServiceEnvironmentClusterSettings(d.Get("cluster_setting").([]interface{})),
+			// the API requires Worker Pools to be specified, however they're only used by v1 App Service Environments
+			WorkerPools: &[]web.WorkerPool{},
+		},
+		Tags: expandTags(tags),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, environment)
+	if err != nil {
+		return fmt.Errorf("Error creating App Service Environment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation of App Service Environment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving App Service Environment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for App Service Environment %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmAppServiceEnvironmentRead(d, meta)
+}
+
+func resourceArmAppServiceEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServiceEnvironmentsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["hostingEnvironments"]
+
+	environment := web.AppServiceEnvironmentPatchResource{
+		AppServiceEnvironment: &web.AppServiceEnvironment{
+			MultiSize:               utils.String(appServiceEnvironmentPricingTiers[d.Get("pricing_tier").(string)]),
+			FrontEndScaleFactor:     utils.Int32(int32(d.Get("front_end_scale_factor").(int))),
+			UserWhitelistedIPRanges: expandAppServiceEnvironmentUserWhitelistedIPRanges(d.Get("user_whitelisted_ip_ranges").([]interface{})),
+			ClusterSettings:         expandAppServiceEnvironmentClusterSettings(d.Get("cluster_setting").([]interface{})),
+		},
+	}
+
+	if _, err := client.Update(ctx, resourceGroup, name, environment); err != nil {
+		return fmt.Errorf("Error updating App Service Environment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	// the Update operation is accepted immediately, whilst the Front End is scaled in the background
+	log.Printf("[DEBUG] Waiting for App Service Environment %q (Resource Group %q) to finish updating..", name, resourceGroup)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{string(web.ProvisioningStateInProgress)},
+		Target:     []string{string(web.ProvisioningStateSucceeded)},
+		Refresh:    appServiceEnvironmentProvisioningStateRefreshFunc(ctx, client, resourceGroup, name),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		MinTimeout: 1 * time.Minute,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for update of App Service Environment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if d.HasChange("tags") {
+		tags := d.Get("tags").(map[string]interface{})
+
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error retrieving App Service Environment %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		// tags can only be updated via a PUT, which must contain the existing configuration
+		existing.Tags = expandTags(tags)
+		future, err := client.CreateOrUpdate(ctx, resourceGroup, name, existing)
+		if err != nil {
+			return fmt.Errorf("Error updating Tags for App Service Environment %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for update of Tags for App Service Environment %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return resourceArmAppServiceEnvironmentRead(d, meta)
+}
+
+func resourceArmAppServiceEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServiceEnvironmentsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["hostingEnvironments"]
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] App Service Environment %q (Resource Group %q) was not found - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving App Service Environment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.AppServiceEnvironment; props != nil {
+		if vnet := props.VirtualNetwork; vnet != nil {
+			d.Set("subnet_id", vnet.ID)
+		}
+
+		d.Set("internal_load_balancing_mode", string(props.InternalLoadBalancingMode))
+		d.Set("dns_suffix", props.DNSSuffix)
+
+		if frontEndScaleFactor := props.FrontEndScaleFactor; frontEndScaleFactor != nil {
+			d.Set("front_end_scale_factor", int(*frontEndScaleFactor))
+		}
+
+		if multiSize := props.MultiSize; multiSize != nil {
+			d.Set("pricing_tier", flattenAppServiceEnvironmentPricingTier(*multiSize))
+		}
+
+		if err := d.Set("user_whitelisted_ip_ranges", flattenAppServiceEnvironmentUserWhitelistedIPRanges(props.UserWhitelistedIPRanges)); err != nil {
+			return fmt.Errorf("Error setting `user_whitelisted_ip_ranges`: %+v", err)
+		}
+
+		if err := d.Set("cluster_setting", flattenAppServiceEnvironmentClusterSettings(props.ClusterSettings)); err != nil {
+			return fmt.Errorf("Error setting `cluster_setting`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmAppServiceEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServiceEnvironmentsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["hostingEnvironments"]
+
+	// only an empty App Service Environment can be deleted, rather than removing any App Service Plans within it
+	forceDelete := false
+	future, err := client.Delete(ctx, resourceGroup, name, &forceDelete)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting App Service Environment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of App Service Environment %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func appServiceEnvironmentProvisioningStateRefreshFunc(ctx context.Context, client web.AppServiceEnvironmentsClient, resourceGroup string, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error polling for the state of App Service Environment %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if props := resp.AppServiceEnvironment; props != nil {
+			return resp, string(props.ProvisioningState), nil
+		}
+
+		return resp, "", fmt.Errorf("Error polling for the state of App Service Environment %q (Resource Group %q): `properties` was nil", name, resourceGroup)
+	}
+}
+
+func expandAppServiceEnvironmentUserWhitelistedIPRanges(input []interface{}) *[]string {
+	output := make([]string, 0)
+	for _, v := range input {
+		output = append(output, v.(string))
+	}
+	return &output
+}
+
+func flattenAppServiceEnvironmentUserWhitelistedIPRanges(input *[]string) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, v)
+	}
+
+	return output
+}
+
+func expandAppServiceEnvironmentClusterSettings(input []interface{}) *[]web.NameValuePair {
+	output := make([]web.NameValuePair, 0)
+	for _, v := range input {
+		setting := v.(map[string]interface{})
+		output = append(output, web.NameValuePair{
+			Name:  utils.String(setting["name"].(string)),
+			Value: utils.String(setting["value"].(string)),
+		})
+	}
+	return &output
+}
+
+func flattenAppServiceEnvironmentClusterSettings(input *[]web.NameValuePair) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		setting := make(map[string]interface{})
+		if v.Name != nil {
+			setting["name"] = *v.Name
+		}
+		if v.Value != nil {
+			setting["value"] = *v.Value
+		}
+		output = append(output, setting)
+	}
+
+	return output
+}
+
+func flattenAppServiceEnvironmentPricingTier(multiSize string) string {
+	for tier, size := range appServiceEnvironmentPricingTiers {
+		if strings.EqualFold(size, multiSize) {
+			return tier
+		}
+	}
+
+	return ""
+}
+
+func validateAppServiceEnvironmentName(v interface{}, k string) (ws []string, es []error) {
+	value := v.(string)
+
+	if matched := regexp.MustCompile(`^[0-9a-zA-Z][-0-9a-zA-Z]{0,35}$`).Match([]byte(value)); !matched {
+		es = append(es, fmt.Errorf("%q may only contain alphanumeric characters and dashes, must start with an alphanumeric character and be up to 36 characters in length", k))
+	}
+
+	return
+}
@@ -0,0 +1,251 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestValidateAppServiceEnvironmentName(t *testing.T) {
+	testCases := []struct {
+		input       string
+		shouldError bool
+	}{
+		{"", true},
+		{"a", false},
+		{"example-ase", false},
+		{"-example", true},
+		{"example_ase", true},
+		{"abcdefghijklmnopqrstuvwxyz0123456789", false},
+		{"abcdefghijklmnopqrstuvwxyz0123456789a", true},
+	}
+
+	for _, test := range testCases {
+		_, es := validateAppServiceEnvironmentName(test.input, "name")
+
+		if test.shouldError && len(es) == 0 {
+			t.Fatalf("Expected validating name %q to fail", test.input)
+		}
+
+		if !test.shouldError && len(es) > 0 {
+			t.Fatalf("Expected validating name %q not to fail", test.input)
+		}
+	}
+}
+
+func TestAccAzureRMAppServiceEnvironment_basic(t *testing.T) {
+	resourceName := "azurerm_app_service_environment.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppServiceEnvironment_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceEnvironmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "pricing_tier", "I1"),
+					resource.TestCheckResourceAttr(resourceName, "front_end_scale_factor", "15"),
+					resource.TestCheckResourceAttr(resourceName, "internal_load_balancing_mode", "None"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAppServiceEnvironment_update(t *testing.T) {
+	resourceName := "azurerm_app_service_environment.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppServiceEnvironment_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceEnvironmentExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMAppServiceEnvironment_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceEnvironmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "pricing_tier", "I2"),
+					resource.TestCheckResourceAttr(resourceName, "front_end_scale_factor", "10"),
+					resource.TestCheckResourceAttr(resourceName, "cluster_setting.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cluster_setting.0.name", "DisableTls1.0"),
+					resource.TestCheckResourceAttr(resourceName, "cluster_setting.0.value", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAppServiceEnvironment_internalLoadBalancer(t *testing.T) {
+	resourceName := "azurerm_app_service_environment.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppServiceEnvironment_internalLoadBalancer(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceEnvironmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "internal_load_balancing_mode", "Web, Publishing"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAppServiceEnvironmentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).appServiceEnvironmentsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: App Service Environment %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on appServiceEnvironmentsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMAppServiceEnvironmentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).appServiceEnvironmentsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_app_service_environment" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("App Service Environment %q (Resource Group %q) still exists", name, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMAppServiceEnvironment_basic(rInt int, location string) string {
+	template := testAccAzureRMAppServiceEnvironment_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_service_environment" "test" {
+  name                = "acctest-ase-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  subnet_id           = "${azurerm_subnet.test.id}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMAppServiceEnvironment_complete(rInt int, location string) string {
+	template := testAccAzureRMAppServiceEnvironment_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_service_environment" "test" {
+  name                   = "acctest-ase-%d"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  subnet_id              = "${azurerm_subnet.test.id}"
+  pricing_tier           = "I2"
+  front_end_scale_factor = 10
+
+  cluster_setting {
+    name  = "DisableTls1.0"
+    value = "1"
+  }
+
+  tags {
+    environment = "production"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMAppServiceEnvironment_internalLoadBalancer(rInt int, location string) string {
+	template := testAccAzureRMAppServiceEnvironment_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_service_environment" "test" {
+  name                         = "acctest-ase-%d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  subnet_id                    = "${azurerm_subnet.test.id}"
+  internal_load_balancing_mode = "Web, Publishing"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMAppServiceEnvironment_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  address_space       = ["10.0.0.0/16"]
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsubnet%[1]d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.1.0/24"
+}
+`, rInt, location)
+}
@@ -0,0 +1,227 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmAppServiceHybridConnection() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceArmAppServiceHybridConnectionCreateUpdate,
+		Read:     resourceArmAppServiceHybridConnectionRead,
+		Update:   resourceArmAppServiceHybridConnectionCreateUpdate,
+		Delete:   resourceArmAppServiceHybridConnectionDelete,
+		Importer: azure.ImporterValidatingResourceId("sites", "hybridConnectionNamespaces", "relays"),
+
+		Schema: map[string]*schema.Schema{
+			"app_service_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAppServiceName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"relay_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"hostname": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validate.PortNumber,
+			},
+
+			"send_key_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "RootManageSharedAccessKey",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"namespace_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"relay_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"service_bus_namespace": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"service_bus_suffix": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"send_key_value": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceArmAppServiceHybridConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServicesClient
+	relayClient := meta.(*ArmClient).relayNamespacesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	appServiceName := d.Get("app_service_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	relayId := d.Get("relay_id").(string)
+	sendKeyName := d.Get("send_key_name").(string)
+
+	parsedRelayId, err := parseAzureResourceID(relayId)
+	if err != nil {
+		return fmt.Errorf("Error parsing `relay_id`: %+v", err)
+	}
+
+	relayResourceGroup := parsedRelayId.ResourceGroup
+	namespaceName := parsedRelayId.Path["namespaces"]
+	relayName := parsedRelayId.Path["hybridConnections"]
+	if namespaceName == "" || relayName == "" {
+		return fmt.Errorf("`relay_id` must be the ID of a Relay Hybrid Connection, got %q", relayId)
+	}
+
+	// the Send Key is used by the App Service to authenticate to the Relay, so we look it up rather than requiring it
+	keys, err := relayClient.ListKeys(ctx, relayResourceGroup, namespaceName, sendKeyName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Keys for Authorization Rule %q (Relay Namespace %q / Resource Group %q): %+v", sendKeyName, namespaceName, relayResourceGroup, err)
+	}
+
+	if keys.PrimaryKey == nil {
+		return fmt.Errorf("Error retrieving Keys for Authorization Rule %q (Relay Namespace %q / Resource Group %q): `primaryKey` was nil", sendKeyName, namespaceName, relayResourceGroup)
+	}
+
+	connection := web.HybridConnection{
+		HybridConnectionProperties: &web.HybridConnectionProperties{
+			ServiceBusNamespace: utils.String(namespaceName),
+			RelayName:           utils.String(relayName),
+			RelayArmURI:         utils.String(relayId),
+			Hostname:            utils.String(d.Get("hostname").(string)),
+			Port:                utils.Int32(int32(d.Get("port").(int))),
+			SendKeyName:         utils.String(sendKeyName),
+			SendKeyValue:        keys.PrimaryKey,
+			ServiceBusSuffix:    utils.String(fmt.Sprintf(".%s", meta.(*ArmClient).environment.ServiceBusEndpointSuffix)),
+		},
+	}
+
+	if _, err := client.CreateOrUpdateHybridConnection(ctx, resourceGroup, appServiceName, namespaceName, relayName, connection); err != nil {
+		return fmt.Errorf("Error creating/updating Hybrid Connection %q (Namespace %q / App Service %q / Resource Group %q): %+v", relayName, namespaceName, appServiceName, resourceGroup, err)
+	}
+
+	read, err := client.GetHybridConnection(ctx, resourceGroup, appServiceName, namespaceName, relayName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Hybrid Connection %q (Namespace %q / App Service %q / Resource Group %q): %+v", relayName, namespaceName, appServiceName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Hybrid Connection %q (Namespace %q / App Service %q / Resource Group %q)", relayName, namespaceName, appServiceName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmAppServiceHybridConnectionRead(d, meta)
+}
+
+func resourceArmAppServiceHybridConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServicesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	appServiceName := id.Path["sites"]
+	namespaceName := id.Path["hybridConnectionNamespaces"]
+	relayName := id.Path["relays"]
+
+	resp, err := client.GetHybridConnection(ctx, resourceGroup, appServiceName, namespaceName, relayName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Hybrid Connection %q (Namespace %q / App Service %q / Resource Group %q) was not found - removing from state", relayName, namespaceName, appServiceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Hybrid Connection %q (Namespace %q / App Service %q / Resource Group %q): %+v", relayName, namespaceName, appServiceName, resourceGroup, err)
+	}
+
+	d.Set("app_service_name", appServiceName)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("namespace_name", namespaceName)
+	d.Set("relay_name", relayName)
+
+	if props := resp.HybridConnectionProperties; props != nil {
+		d.Set("relay_id", props.RelayArmURI)
+		d.Set("hostname", props.Hostname)
+		d.Set("send_key_name", props.SendKeyName)
+		d.Set("service_bus_namespace", props.ServiceBusNamespace)
+		d.Set("service_bus_suffix", props.ServiceBusSuffix)
+
+		if port := props.Port; port != nil {
+			d.Set("port", int(*port))
+		}
+
+		// the Send Key Value is only returned when it's set
+		if sendKeyValue := props.SendKeyValue; sendKeyValue != nil && *sendKeyValue != "" {
+			d.Set("send_key_value", sendKeyValue)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmAppServiceHybridConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServicesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	appServiceName := id.Path["sites"]
+	namespaceName := id.Path["hybridConnectionNamespaces"]
+	relayName := id.Path["relays"]
+
+	resp, err := client.DeleteHybridConnection(ctx, resourceGroup, appServiceName, namespaceName, relayName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Hybrid Connection %q (Namespace %q / App Service %q / Resource Group %q): %+v", relayName, namespaceName, appServiceName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,213 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMAppServiceHybridConnection_basic(t *testing.T) {
+	resourceName := "azurerm_app_service_hybrid_connection.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceHybridConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppServiceHybridConnection_basic(ri, location, "db.internal.example.com", 1433),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceHybridConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "hostname", "db.internal.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "port", "1433"),
+					resource.TestCheckResourceAttr(resourceName, "send_key_name", "RootManageSharedAccessKey"),
+					resource.TestCheckResourceAttrSet(resourceName, "namespace_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "relay_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "send_key_value"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"send_key_value"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAppServiceHybridConnection_update(t *testing.T) {
+	resourceName := "azurerm_app_service_hybrid_connection.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceHybridConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppServiceHybridConnection_basic(ri, location, "db.internal.example.com", 1433),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceHybridConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "port", "1433"),
+				),
+			},
+			{
+				Config: testAccAzureRMAppServiceHybridConnection_basic(ri, location, "web.internal.example.com", 8080),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceHybridConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "hostname", "web.internal.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "port", "8080"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAppServiceHybridConnectionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		appServiceName := rs.Primary.Attributes["app_service_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		namespaceName := rs.Primary.Attributes["namespace_name"]
+		relayName := rs.Primary.Attributes["relay_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).appServicesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.GetHybridConnection(ctx, resourceGroup, appServiceName, namespaceName, relayName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Hybrid Connection %q (Namespace %q / App Service %q / Resource Group %q) does not exist", relayName, namespaceName, appServiceName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: GetHybridConnection on appServicesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMAppServiceHybridConnectionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).appServicesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_app_service_hybrid_connection" {
+			continue
+		}
+
+		appServiceName := rs.Primary.Attributes["app_service_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		namespaceName := rs.Primary.Attributes["namespace_name"]
+		relayName := rs.Primary.Attributes["relay_name"]
+
+		resp, err := client.GetHybridConnection(ctx, resourceGroup, appServiceName, namespaceName, relayName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Hybrid Connection %q (Namespace %q / App Service %q / Resource Group %q) still exists", relayName, namespaceName, appServiceName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMAppServiceHybridConnection_basic(rInt int, location string, hostname string, port int) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_relay_namespace" "test" {
+  name                = "acctestrn-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name = "Standard"
+  }
+}
+
+# Relay Hybrid Connections aren't supported as a resource, so they're provisioned via a Template
+resource "azurerm_template_deployment" "test" {
+  name                = "acctesttemplate-%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  deployment_mode     = "Incremental"
+
+  parameters {
+    namespaceName = "${azurerm_relay_namespace.test.name}"
+  }
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "namespaceName": {
+      "type": "string"
+    }
+  },
+  "resources": [
+    {
+      "type": "Microsoft.Relay/namespaces/hybridConnections",
+      "apiVersion": "2017-04-01",
+      "name": "[concat(parameters('namespaceName'), '/acctesthc%[1]d')]",
+      "properties": {
+        "requiresClientAuthorization": true
+      }
+    }
+  ],
+  "outputs": {
+    "hybridConnectionId": {
+      "type": "string",
+      "value": "[resourceId('Microsoft.Relay/namespaces/hybridConnections', parameters('namespaceName'), 'acctesthc%[1]d')]"
+    }
+  }
+}
+DEPLOY
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    tier = "Standard"
+    size = "S1"
+  }
+}
+
+resource "azurerm_app_service" "test" {
+  name                = "acctestAS-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+}
+
+resource "azurerm_app_service_hybrid_connection" "test" {
+  app_service_name    = "${azurerm_app_service.test.name}"
+  resource_group_name = "${azurerm_app_service.test.resource_group_name}"
+  relay_id            = "${azurerm_template_deployment.test.outputs["hybridConnectionId"]}"
+  hostname            = "%[3]s"
+  port                = %[4]d
+}
+`, rInt, location, hostname, port)
+}
@@ -8,18 +8,18 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmAppServicePlan() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmAppServicePlanCreateUpdate,
-		Read:   resourceArmAppServicePlanRead,
-		Update: resourceArmAppServicePlanCreateUpdate,
-		Delete: resourceArmAppServicePlanDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmAppServicePlanCreateUpdate,
+		Read:     resourceArmAppServicePlanRead,
+		Update:   resourceArmAppServicePlanCreateUpdate,
+		Delete:   resourceArmAppServicePlanDelete,
+		Importer: azure.ImporterValidatingResourceId("serverfarms"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -110,7 +110,8 @@ func resourceArmAppServicePlan() *schema.Resource {
 
 func resourceArmAppServicePlanCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).appServicePlansClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM App Service Plan creation.")
 
@@ -165,9 +166,13 @@ func resourceArmAppServicePlanRead(d *schema.ResourceData, meta interface{}) err
 	log.Printf("[DEBUG] Reading Azure App Service Plan %s", id)
 
 	resourceGroup := id.ResourceGroup
-	name := id.Path["serverfarms"]
+	name, err := id.PopSegment("serverfarms")
+	if err != nil {
+		return err
+	}
 
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	resp, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
@@ -207,14 +212,18 @@ func resourceArmAppServicePlanRead(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmAppServicePlanDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).appServicePlansClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["serverfarms"]
+	name, err := id.PopSegment("serverfarms")
+	if err != nil {
+		return err
+	}
 
 	log.Printf("[DEBUG] Deleting App Service Plan %q (Resource Group %q)", name, resourceGroup)
 
@@ -8,18 +8,17 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmAppServiceSlot() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmAppServiceSlotCreate,
-		Read:   resourceArmAppServiceSlotRead,
-		Update: resourceArmAppServiceSlotUpdate,
-		Delete: resourceArmAppServiceSlotDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmAppServiceSlotCreate,
+		Read:     resourceArmAppServiceSlotRead,
+		Update:   resourceArmAppServiceSlotUpdate,
+		Delete:   resourceArmAppServiceSlotDelete,
+		Importer: azure.ImporterValidatingResourceId("sites", "slots"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -98,6 +97,32 @@ func resourceArmAppServiceSlot() *schema.Resource {
 				Computed: true,
 			},
 
+			"docker_registry": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"server_url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"username": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"password": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+
 			"connection_string": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -182,7 +207,8 @@ func resourceArmAppServiceSlotCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	// NOTE: these seem like sensible defaults, in lieu of any better documentation.
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resp, err := client.Get(ctx, resGroup, appServiceName)
 	if err != nil {
@@ -218,7 +244,8 @@ func resourceArmAppServiceSlotCreate(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmAppServiceSlotUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).appServicesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -226,10 +253,16 @@ func resourceArmAppServiceSlotUpdate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	resGroup := id.ResourceGroup
-	appServiceName := id.Path["sites"]
+	appServiceName, err := id.PopSegment("sites")
+	if err != nil {
+		return err
+	}
 	location := azureRMNormalizeLocation(d.Get("location").(string))
 	appServicePlanId := d.Get("app_service_plan_id").(string)
-	slot := id.Path["slots"]
+	slot, err := id.PopSegment("slots")
+	if err != nil {
+		return err
+	}
 	siteConfig := azure.ExpandAppServiceSiteConfig(d.Get("site_config"))
 	enabled := d.Get("enabled").(bool)
 	httpsOnly := d.Get("https_only").(bool)
@@ -283,9 +316,13 @@ func resourceArmAppServiceSlotUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	if d.HasChange("app_settings") {
+	if d.HasChange("app_settings") || d.HasChange("docker_registry") {
 		// update the AppSettings
 		appSettings := expandAppServiceAppSettings(d)
+		for k, v := range expandAppServiceDockerRegistry(d.Get("docker_registry").([]interface{})) {
+			appSettings[k] = v
+		}
+
 		settings := web.StringDictionary{
 			Properties: appSettings,
 		}
@@ -321,10 +358,17 @@ func resourceArmAppServiceSlotRead(d *schema.ResourceData, meta interface{}) err
 	}
 
 	resGroup := id.ResourceGroup
-	appServiceName := id.Path["sites"]
-	slot := id.Path["slots"]
+	appServiceName, err := id.PopSegment("sites")
+	if err != nil {
+		return err
+	}
+	slot, err := id.PopSegment("slots")
+	if err != nil {
+		return err
+	}
 
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	resp, err := client.GetSlot(ctx, resGroup, appServiceName, slot)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
@@ -365,7 +409,12 @@ func resourceArmAppServiceSlotRead(d *schema.ResourceData, meta interface{}) err
 		d.Set("default_site_hostname", props.DefaultHostName)
 	}
 
-	if err := d.Set("app_settings", flattenAppServiceAppSettings(appSettingsResp.Properties)); err != nil {
+	appSettings := flattenAppServiceAppSettings(appSettingsResp.Properties)
+	if err := setAppServiceDockerRegistry(d, appSettings); err != nil {
+		return err
+	}
+
+	if err := d.Set("app_settings", appSettings); err != nil {
 		return err
 	}
 	if err := d.Set("connection_string", flattenAppServiceConnectionStrings(connectionStringsResp.Properties)); err != nil {
@@ -373,6 +422,7 @@ func resourceArmAppServiceSlotRead(d *schema.ResourceData, meta interface{}) err
 	}
 
 	siteConfig := azure.FlattenAppServiceSiteConfig(configResp.SiteConfig)
+	removeUnusedAppServiceMultiContainerFiles(d, siteConfig)
 	if err := d.Set("site_config", siteConfig); err != nil {
 		return err
 	}
@@ -395,14 +445,21 @@ func resourceArmAppServiceSlotDelete(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 	resGroup := id.ResourceGroup
-	appServiceName := id.Path["sites"]
-	slot := id.Path["slots"]
+	appServiceName, err := id.PopSegment("sites")
+	if err != nil {
+		return err
+	}
+	slot, err := id.PopSegment("slots")
+	if err != nil {
+		return err
+	}
 
 	log.Printf("[DEBUG] Deleting App Service Slot %q/%q (resource group %q)", appServiceName, slot, resGroup)
 
 	deleteMetrics := true
 	deleteEmptyServerFarm := false
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	resp, err := client.DeleteSlot(ctx, resGroup, appServiceName, slot, &deleteMetrics, &deleteEmptyServerFarm)
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp) {
@@ -71,6 +71,29 @@ func TestAccAzureRMAppService_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMAppService_nameUnavailable(t *testing.T) {
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppService_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceExists("azurerm_app_service.test"),
+				),
+			},
+			{
+				Config:      testAccAzureRMAppService_nameUnavailable(ri, location),
+				ExpectError: regexp.MustCompile("needs to be globally unique and isn't available"),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMAppService_freeTier(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
 	ri := acctest.RandInt()
@@ -153,6 +176,49 @@ func TestAccAzureRMAppService_http2Enabled(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMAppService_autoHeal(t *testing.T) {
+	resourceName := "azurerm_app_service.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppService_autoHeal(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.auto_heal.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.auto_heal.0.trigger.0.requests.0.count", "100"),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.auto_heal.0.trigger.0.requests.0.interval", "00:01:00"),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.auto_heal.0.action.0.action_type", "Recycle"),
+				),
+			},
+			{
+				Config: testAccAzureRMAppService_autoHealUpdated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.auto_heal.0.trigger.0.slow_request.0.time_taken", "00:00:30"),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.auto_heal.0.trigger.0.status_code.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.auto_heal.0.trigger.0.status_code.0.status", "500"),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.auto_heal.0.action.0.action_type", "CustomAction"),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.auto_heal.0.action.0.custom_action.0.executable", "D:\\home\\data\\heal.exe"),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.auto_heal.0.action.0.minimum_process_execution_time", "00:05:00"),
+				),
+			},
+			{
+				Config: testAccAzureRMAppService_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.auto_heal.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMAppService_alwaysOn(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
 	ri := acctest.RandInt()
@@ -415,8 +481,42 @@ func TestAccAzureRMAppService_oneIpRestriction(t *testing.T) {
 					testCheckAzureRMAppServiceExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "site_config.0.ip_restriction.0.ip_address", "10.10.10.10"),
 					resource.TestCheckResourceAttr(resourceName, "site_config.0.ip_restriction.0.subnet_mask", "255.255.255.255"),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.ip_restriction.0.priority", "65000"),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.ip_restriction.0.action", "Allow"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAppService_completeIpRestriction(t *testing.T) {
+	resourceName := "azurerm_app_service.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMAppService_completeIpRestriction(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.ip_restriction.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.ip_restriction.0.name", "allow-office"),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.ip_restriction.0.priority", "100"),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.ip_restriction.0.action", "Allow"),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.ip_restriction.1.name", "deny-range"),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.ip_restriction.1.priority", "200"),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.ip_restriction.1.action", "Deny"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -880,6 +980,74 @@ func TestAccAzureRMAppService_linuxFxVersion(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMAppService_dockerCompose(t *testing.T) {
+	resourceName := "azurerm_app_service.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMAppService_dockerCompose(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "site_config.0.docker_compose_file"),
+					resource.TestMatchResourceAttr(resourceName, "site_config.0.linux_fx_version", regexp.MustCompile("^COMPOSE\\|")),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMAppService_dockerComposeRemoved(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.docker_compose_file", ""),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.linux_fx_version", "DOCKER|nginx:latest"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAppService_dockerRegistry(t *testing.T) {
+	resourceName := "azurerm_app_service.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppService_dockerRegistry(ri, location, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "docker_registry.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "docker_registry.0.server_url", "https://mycontainerregistry.azurecr.io"),
+					resource.TestCheckResourceAttr(resourceName, "docker_registry.0.username", "first"),
+					resource.TestCheckResourceAttr(resourceName, "app_settings.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "app_settings.WEBSITES_ENABLE_APP_SERVICE_STORAGE", "false"),
+				),
+			},
+			{
+				Config: testAccAzureRMAppService_dockerRegistry(ri, location, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "docker_registry.0.username", "second"),
+					resource.TestCheckResourceAttr(resourceName, "app_settings.%", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMAppService_minTls(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
 	ri := acctest.RandInt()
@@ -992,6 +1160,20 @@ resource "azurerm_app_service" "test" {
 `, rInt, location, rInt, rInt)
 }
 
+func testAccAzureRMAppService_nameUnavailable(rInt int, location string) string {
+	template := testAccAzureRMAppService_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_service" "duplicate" {
+  name                = "acctestAS-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+}
+`, template, rInt)
+}
+
 func testAccAzureRMAppService_freeTier(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -1142,6 +1324,112 @@ resource "azurerm_app_service" "test" {
 `, rInt, location, rInt, rInt)
 }
 
+func testAccAzureRMAppService_autoHeal(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    tier = "Standard"
+    size = "S1"
+  }
+}
+
+resource "azurerm_app_service" "test" {
+  name                = "acctestAS-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+
+  site_config {
+    auto_heal {
+      trigger {
+        requests {
+          count    = 100
+          interval = "00:01:00"
+        }
+      }
+
+      action {
+        action_type = "Recycle"
+      }
+    }
+  }
+}
+`, rInt, location)
+}
+
+func testAccAzureRMAppService_autoHealUpdated(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    tier = "Standard"
+    size = "S1"
+  }
+}
+
+resource "azurerm_app_service" "test" {
+  name                = "acctestAS-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+
+  site_config {
+    auto_heal {
+      trigger {
+        slow_request {
+          count      = 10
+          interval   = "00:01:00"
+          time_taken = "00:00:30"
+        }
+
+        status_code {
+          status   = 500
+          count    = 50
+          interval = "00:05:00"
+        }
+
+        status_code {
+          status     = 503
+          sub_status = 2
+          count      = 20
+          interval   = "00:05:00"
+        }
+
+        private_memory_kb = 1048576
+      }
+
+      action {
+        action_type                    = "CustomAction"
+        minimum_process_execution_time = "00:05:00"
+
+        custom_action {
+          executable = "D:\\home\\data\\heal.exe"
+          parameters = "-verbose"
+        }
+      }
+    }
+  }
+}
+`, rInt, location)
+}
+
 func testAccAzureRMAppService_http2Enabled(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -1434,6 +1722,50 @@ resource "azurerm_app_service" "test" {
 `, rInt, location, rInt, rInt)
 }
 
+func testAccAzureRMAppService_completeIpRestriction(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    tier = "Standard"
+    size = "S1"
+  }
+}
+
+resource "azurerm_app_service" "test" {
+  name                = "acctestAS-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+
+  site_config {
+    ip_restriction {
+      ip_address = "10.10.10.10"
+      name       = "allow-office"
+      priority   = 100
+      action     = "Allow"
+    }
+
+    ip_restriction {
+      ip_address  = "20.20.20.0"
+      subnet_mask = "255.255.255.0"
+      name        = "deny-range"
+      priority    = 200
+      action      = "Deny"
+    }
+  }
+}
+`, rInt, location)
+}
+
 func testAccAzureRMAppService_manyIpRestrictions(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -1961,6 +2293,133 @@ resource "azurerm_app_service" "test" {
 `, rInt, location, rInt, rInt)
 }
 
+func testAccAzureRMAppService_dockerCompose(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  kind                = "Linux"
+
+  sku {
+    tier = "Standard"
+    size = "S1"
+  }
+
+  properties {
+    reserved = true
+  }
+}
+
+resource "azurerm_app_service" "test" {
+  name                = "acctestAS-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+
+  site_config {
+    docker_compose_file = <<COMPOSE
+version: '3'
+services:
+  web:
+    image: "nginx:latest"
+    ports:
+      - "80:80"
+  cache:
+    image: "redis:alpine"
+COMPOSE
+  }
+}
+`, rInt, location)
+}
+
+func testAccAzureRMAppService_dockerComposeRemoved(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  kind                = "Linux"
+
+  sku {
+    tier = "Standard"
+    size = "S1"
+  }
+
+  properties {
+    reserved = true
+  }
+}
+
+resource "azurerm_app_service" "test" {
+  name                = "acctestAS-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+
+  site_config {
+    linux_fx_version = "DOCKER|nginx:latest"
+  }
+}
+`, rInt, location)
+}
+
+func testAccAzureRMAppService_dockerRegistry(rInt int, location string, username string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  kind                = "Linux"
+
+  sku {
+    tier = "Standard"
+    size = "S1"
+  }
+
+  properties {
+    reserved = true
+  }
+}
+
+resource "azurerm_app_service" "test" {
+  name                = "acctestAS-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+
+  site_config {
+    linux_fx_version = "DOCKER|mycontainerregistry.azurecr.io/hello-world:latest"
+  }
+
+  docker_registry {
+    server_url = "https://mycontainerregistry.azurecr.io"
+    username   = "%[3]s"
+    password   = "Pa55w0rd1234!"
+  }
+
+  app_settings {
+    "WEBSITES_ENABLE_APP_SERVICE_STORAGE" = "false"
+  }
+}
+`, rInt, location, username)
+}
+
 func testAccAzureRMAppService_minTls(rInt int, location string, tlsVersion string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
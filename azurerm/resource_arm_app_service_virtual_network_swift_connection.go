@@ -0,0 +1,184 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmAppServiceVirtualNetworkSwiftConnection() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceArmAppServiceVirtualNetworkSwiftConnectionCreateUpdate,
+		Read:     resourceArmAppServiceVirtualNetworkSwiftConnectionRead,
+		Update:   resourceArmAppServiceVirtualNetworkSwiftConnectionCreateUpdate,
+		Delete:   resourceArmAppServiceVirtualNetworkSwiftConnectionDelete,
+		Importer: azure.ImporterValidatingResourceId("sites", "config"),
+
+		Schema: map[string]*schema.Schema{
+			"app_service_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+		},
+	}
+}
+
+func resourceArmAppServiceVirtualNetworkSwiftConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServicesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	appServiceId, err := parseAzureResourceID(d.Get("app_service_id").(string))
+	if err != nil {
+		return fmt.Errorf("Error parsing `app_service_id`: %+v", err)
+	}
+
+	resourceGroup := appServiceId.ResourceGroup
+	name, err := appServiceId.PopSegment("sites")
+	if err != nil {
+		return fmt.Errorf("Error parsing `app_service_id`: %+v", err)
+	}
+
+	subnetId := d.Get("subnet_id").(string)
+	parsedSubnetId, err := parseAzureResourceID(subnetId)
+	if err != nil {
+		return fmt.Errorf("Error parsing `subnet_id`: %+v", err)
+	}
+
+	subnetName := parsedSubnetId.Path["subnets"]
+	virtualNetworkName := parsedSubnetId.Path["virtualNetworks"]
+
+	azureRMLockByName(virtualNetworkName, virtualNetworkResourceName)
+	defer azureRMUnlockByName(virtualNetworkName, virtualNetworkResourceName)
+
+	azureRMLockByName(subnetName, subnetResourceName)
+	defer azureRMUnlockByName(subnetName, subnetResourceName)
+
+	connectionEnvelope := web.SwiftVirtualNetwork{
+		SwiftVirtualNetworkProperties: &web.SwiftVirtualNetworkProperties{
+			SubnetResourceID: utils.String(subnetId),
+		},
+	}
+
+	if _, err := client.CreateOrUpdateSwiftVirtualNetworkConnection(ctx, resourceGroup, name, connectionEnvelope); err != nil {
+		return fmt.Errorf("Error creating/updating Virtual Network Swift Connection for App Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.GetSwiftVirtualNetworkConnection(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Virtual Network Swift Connection for App Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Virtual Network Swift Connection for App Service %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmAppServiceVirtualNetworkSwiftConnectionRead(d, meta)
+}
+
+func resourceArmAppServiceVirtualNetworkSwiftConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServicesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name, err := id.PopSegment("sites")
+	if err != nil {
+		return err
+	}
+
+	appService, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(appService.Response) {
+			log.Printf("[INFO] App Service %q (Resource Group %q) was not found - removing Virtual Network Swift Connection from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving App Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	resp, err := client.GetSwiftVirtualNetworkConnection(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Virtual Network Swift Connection for App Service %q (Resource Group %q) was not found - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Network Swift Connection for App Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	// the API returns an empty connection rather than a 404 once the App Service has been disconnected
+	props := resp.SwiftVirtualNetworkProperties
+	if props == nil || props.SubnetResourceID == nil || *props.SubnetResourceID == "" {
+		log.Printf("[INFO] App Service %q (Resource Group %q) isn't connected to a Virtual Network - removing Virtual Network Swift Connection from state", name, resourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("app_service_id", appService.ID)
+	d.Set("subnet_id", props.SubnetResourceID)
+
+	return nil
+}
+
+func resourceArmAppServiceVirtualNetworkSwiftConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServicesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name, err := id.PopSegment("sites")
+	if err != nil {
+		return err
+	}
+
+	subnetId, err := parseAzureResourceID(d.Get("subnet_id").(string))
+	if err != nil {
+		return fmt.Errorf("Error parsing `subnet_id`: %+v", err)
+	}
+
+	subnetName := subnetId.Path["subnets"]
+	virtualNetworkName := subnetId.Path["virtualNetworks"]
+
+	azureRMLockByName(virtualNetworkName, virtualNetworkResourceName)
+	defer azureRMUnlockByName(virtualNetworkName, virtualNetworkResourceName)
+
+	azureRMLockByName(subnetName, subnetResourceName)
+	defer azureRMUnlockByName(subnetName, subnetResourceName)
+
+	resp, err := client.DeleteSwiftVirtualNetwork(ctx, resourceGroup, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Virtual Network Swift Connection for App Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
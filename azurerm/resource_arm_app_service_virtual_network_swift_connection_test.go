@@ -0,0 +1,228 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMAppServiceVirtualNetworkSwiftConnection_basic(t *testing.T) {
+	resourceName := "azurerm_app_service_virtual_network_swift_connection.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceVirtualNetworkSwiftConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppServiceVirtualNetworkSwiftConnection_basic(ri, location, "subnet1"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceVirtualNetworkSwiftConnectionExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "subnet_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAppServiceVirtualNetworkSwiftConnection_update(t *testing.T) {
+	resourceName := "azurerm_app_service_virtual_network_swift_connection.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceVirtualNetworkSwiftConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppServiceVirtualNetworkSwiftConnection_basic(ri, location, "subnet1"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceVirtualNetworkSwiftConnectionExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "subnet_id", "azurerm_template_deployment.test", "outputs.subnet1"),
+				),
+			},
+			{
+				Config: testAccAzureRMAppServiceVirtualNetworkSwiftConnection_basic(ri, location, "subnet2"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceVirtualNetworkSwiftConnectionExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "subnet_id", "azurerm_template_deployment.test", "outputs.subnet2"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAppServiceVirtualNetworkSwiftConnectionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resourceGroup := id.ResourceGroup
+		name := id.Path["sites"]
+
+		client := testAccProvider.Meta().(*ArmClient).appServicesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.GetSwiftVirtualNetworkConnection(ctx, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Bad: GetSwiftVirtualNetworkConnection on appServicesClient: %+v", err)
+		}
+
+		if props := resp.SwiftVirtualNetworkProperties; props == nil || props.SubnetResourceID == nil || *props.SubnetResourceID == "" {
+			return fmt.Errorf("Bad: App Service %q (Resource Group %q) isn't connected to a Virtual Network", name, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMAppServiceVirtualNetworkSwiftConnectionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).appServicesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_app_service_virtual_network_swift_connection" {
+			continue
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resourceGroup := id.ResourceGroup
+		name := id.Path["sites"]
+
+		resp, err := client.GetSwiftVirtualNetworkConnection(ctx, resourceGroup, name)
+		if err != nil {
+			// the App Service is removed alongside the Swift Connection
+			return nil
+		}
+
+		if props := resp.SwiftVirtualNetworkProperties; props != nil && props.SubnetResourceID != nil && *props.SubnetResourceID != "" {
+			return fmt.Errorf("App Service %q (Resource Group %q) is still connected to a Virtual Network", name, resourceGroup)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMAppServiceVirtualNetworkSwiftConnection_basic(rInt int, location string, subnet string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+# the subnets need to be delegated to Microsoft.Web/serverFarms, which isn't supported by azurerm_subnet
+resource "azurerm_template_deployment" "test" {
+  name                = "acctesttemplate-%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  deployment_mode     = "Incremental"
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "variables": {
+    "virtualNetworkName": "acctestvirtnet%[1]d"
+  },
+  "resources": [
+    {
+      "type": "Microsoft.Network/virtualNetworks",
+      "apiVersion": "2019-02-01",
+      "name": "[variables('virtualNetworkName')]",
+      "location": "[resourceGroup().location]",
+      "properties": {
+        "addressSpace": {
+          "addressPrefixes": ["10.0.0.0/16"]
+        },
+        "subnets": [
+          {
+            "name": "subnet1",
+            "properties": {
+              "addressPrefix": "10.0.1.0/24",
+              "delegations": [
+                {
+                  "name": "acctestdelegation",
+                  "properties": {
+                    "serviceName": "Microsoft.Web/serverFarms"
+                  }
+                }
+              ]
+            }
+          },
+          {
+            "name": "subnet2",
+            "properties": {
+              "addressPrefix": "10.0.2.0/24",
+              "delegations": [
+                {
+                  "name": "acctestdelegation",
+                  "properties": {
+                    "serviceName": "Microsoft.Web/serverFarms"
+                  }
+                }
+              ]
+            }
+          }
+        ]
+      }
+    }
+  ],
+  "outputs": {
+    "subnet1": {
+      "type": "string",
+      "value": "[resourceId('Microsoft.Network/virtualNetworks/subnets', variables('virtualNetworkName'), 'subnet1')]"
+    },
+    "subnet2": {
+      "type": "string",
+      "value": "[resourceId('Microsoft.Network/virtualNetworks/subnets', variables('virtualNetworkName'), 'subnet2')]"
+    }
+  }
+}
+DEPLOY
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    tier = "Standard"
+    size = "S1"
+  }
+}
+
+resource "azurerm_app_service" "test" {
+  name                = "acctestAS-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+}
+
+resource "azurerm_app_service_virtual_network_swift_connection" "test" {
+  app_service_id = "${azurerm_app_service.test.id}"
+  subnet_id      = "${azurerm_template_deployment.test.outputs["%[3]s"]}"
+}
+`, rInt, location, subnet)
+}
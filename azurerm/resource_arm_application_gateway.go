@@ -10,18 +10,18 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmApplicationGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmApplicationGatewayCreateUpdate,
-		Read:   resourceArmApplicationGatewayRead,
-		Update: resourceArmApplicationGatewayCreateUpdate,
-		Delete: resourceArmApplicationGatewayDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmApplicationGatewayCreateUpdate,
+		Read:     resourceArmApplicationGatewayRead,
+		Update:   resourceArmApplicationGatewayCreateUpdate,
+		Delete:   resourceArmApplicationGatewayDelete,
+		Importer: azure.ImporterValidatingResourceId("applicationGateways"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -77,9 +77,30 @@ func resourceArmApplicationGateway() *schema.Resource {
 						},
 
 						"capacity": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 125),
+						},
+					},
+				},
+			},
+
+			"autoscale_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"min_capacity": {
 							Type:         schema.TypeInt,
 							Required:     true,
-							ValidateFunc: validation.IntBetween(1, 10),
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+
+						"max_capacity": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(2, 125),
 						},
 					},
 				},
@@ -713,7 +734,8 @@ func resourceArmApplicationGateway() *schema.Resource {
 func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
 	client := armClient.applicationGatewayClient
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(armClient.StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM ApplicationGateway creation.")
 
@@ -727,8 +749,13 @@ func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta inte
 		"/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/applicationGateways/%s",
 		armClient.subscriptionId, resGroup, name)
 
+	if err := validateApplicationGatewaySkuAndAutoscale(d); err != nil {
+		return err
+	}
+
 	properties := network.ApplicationGatewayPropertiesFormat{}
 	properties.Sku = expandApplicationGatewaySku(d)
+	properties.AutoscaleConfiguration = expandApplicationGatewayAutoscaleConfiguration(d.Get("autoscale_configuration").([]interface{}))
 	properties.SslPolicy = expandApplicationGatewaySslPolicy(d)
 	properties.GatewayIPConfigurations = expandApplicationGatewayIPConfigurations(d)
 	properties.FrontendPorts = expandApplicationGatewayFrontendPorts(d)
@@ -799,6 +826,9 @@ func resourceArmApplicationGatewayRead(d *schema.ResourceData, meta interface{})
 	}
 
 	d.Set("sku", schema.NewSet(hashApplicationGatewaySku, flattenApplicationGatewaySku(applicationGateway.ApplicationGatewayPropertiesFormat.Sku)))
+	if err := d.Set("autoscale_configuration", flattenApplicationGatewayAutoscaleConfiguration(applicationGateway.ApplicationGatewayPropertiesFormat.AutoscaleConfiguration)); err != nil {
+		return fmt.Errorf("Error setting `autoscale_configuration`: %+v", err)
+	}
 	d.Set("disabled_ssl_protocols", flattenApplicationGatewaySslPolicy(applicationGateway.ApplicationGatewayPropertiesFormat.SslPolicy))
 	d.Set("gateway_ip_configuration", flattenApplicationGatewayIPConfigurations(applicationGateway.ApplicationGatewayPropertiesFormat.GatewayIPConfigurations))
 	d.Set("frontend_port", flattenApplicationGatewayFrontendPorts(applicationGateway.ApplicationGatewayPropertiesFormat.FrontendPorts))
@@ -846,14 +876,18 @@ func resourceArmApplicationGatewayRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmApplicationGatewayDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).applicationGatewayClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return fmt.Errorf("Error Parsing Azure Resource ID: %+v", err)
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["applicationGateways"]
+	name, err := id.PopSegment("applicationGateways")
+	if err != nil {
+		return err
+	}
 
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
@@ -906,12 +940,76 @@ func expandApplicationGatewaySku(d *schema.ResourceData) *network.ApplicationGat
 
 	name := sku["name"].(string)
 	tier := sku["tier"].(string)
-	capacity := int32(sku["capacity"].(int))
 
-	return &network.ApplicationGatewaySku{
-		Name:     network.ApplicationGatewaySkuName(name),
-		Tier:     network.ApplicationGatewayTier(tier),
-		Capacity: &capacity,
+	result := &network.ApplicationGatewaySku{
+		Name: network.ApplicationGatewaySkuName(name),
+		Tier: network.ApplicationGatewayTier(tier),
+	}
+
+	// when autoscaling is enabled the capacity is managed by Azure
+	if capacity := sku["capacity"].(int); capacity > 0 {
+		result.Capacity = utils.Int32(int32(capacity))
+	}
+
+	return result
+}
+
+func validateApplicationGatewaySkuAndAutoscale(d *schema.ResourceData) error {
+	skuSet := d.Get("sku").(*schema.Set).List()
+	sku := skuSet[0].(map[string]interface{})
+	name := sku["name"].(string)
+	capacity := sku["capacity"].(int)
+
+	isV2 := strings.EqualFold(name, string(network.StandardV2)) || strings.EqualFold(name, string(network.WAFV2))
+
+	// only the v2 SKUs support more than 10 instances
+	if !isV2 && capacity > 10 {
+		return fmt.Errorf("`capacity` must be between 1 and 10 within the `sku` block when the `sku` isn't `Standard_v2` or `WAF_v2`")
+	}
+
+	autoscale := d.Get("autoscale_configuration").([]interface{})
+	if len(autoscale) == 0 {
+		if capacity == 0 {
+			return fmt.Errorf("`capacity` must be specified within the `sku` block when `autoscale_configuration` isn't configured")
+		}
+
+		return nil
+	}
+
+	if !isV2 {
+		return fmt.Errorf("`autoscale_configuration` can only be configured when the `sku` is `Standard_v2` or `WAF_v2`")
+	}
+
+	if capacity > 0 {
+		return fmt.Errorf("`capacity` cannot be specified within the `sku` block when `autoscale_configuration` is configured")
+	}
+
+	config := autoscale[0].(map[string]interface{})
+	minCapacity := config["min_capacity"].(int)
+	maxCapacity := config["max_capacity"].(int)
+	if maxCapacity > 0 && maxCapacity < minCapacity {
+		return fmt.Errorf("`max_capacity` must be greater than or equal to `min_capacity` within the `autoscale_configuration` block")
+	}
+
+	return nil
+}
+
+func expandApplicationGatewayAutoscaleConfiguration(input []interface{}) *network.ApplicationGatewayAutoscaleConfiguration {
+	if len(input) == 0 {
+		return nil
+	}
+
+	config := input[0].(map[string]interface{})
+	bounds := network.ApplicationGatewayAutoscaleBounds{
+		Min: utils.Int32(int32(config["min_capacity"].(int))),
+	}
+
+	if maxCapacity := config["max_capacity"].(int); maxCapacity > 0 {
+		bounds.Max = utils.Int32(int32(maxCapacity))
+	}
+
+	return &network.ApplicationGatewayAutoscaleConfiguration{
+		Bounds: &bounds,
 	}
 }
 
@@ -1393,7 +1491,25 @@ func flattenApplicationGatewaySku(sku *network.ApplicationGatewaySku) []interfac
 
 	result["name"] = string(sku.Name)
 	result["tier"] = string(sku.Tier)
-	result["capacity"] = int(*sku.Capacity)
+	if sku.Capacity != nil {
+		result["capacity"] = int(*sku.Capacity)
+	}
+
+	return []interface{}{result}
+}
+
+func flattenApplicationGatewayAutoscaleConfiguration(input *network.ApplicationGatewayAutoscaleConfiguration) []interface{} {
+	if input == nil || input.Bounds == nil {
+		return []interface{}{}
+	}
+
+	result := make(map[string]interface{})
+	if v := input.Bounds.Min; v != nil {
+		result["min_capacity"] = int(*v)
+	}
+	if v := input.Bounds.Max; v != nil {
+		result["max_capacity"] = int(*v)
+	}
 
 	return []interface{}{result}
 }
@@ -1840,7 +1956,11 @@ func hashApplicationGatewaySku(v interface{}) int {
 	m := v.(map[string]interface{})
 	buf.WriteString(fmt.Sprintf("%s-", m["name"].(string)))
 	buf.WriteString(fmt.Sprintf("%s-", m["tier"].(string)))
-	buf.WriteString(fmt.Sprintf("%d-", m["capacity"].(int)))
+	capacity := 0
+	if v, ok := m["capacity"]; ok {
+		capacity = v.(int)
+	}
+	buf.WriteString(fmt.Sprintf("%d-", capacity))
 
 	return hashcode.String(buf.String())
 }
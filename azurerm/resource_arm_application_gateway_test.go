@@ -198,6 +198,36 @@ func TestAccAzureRMApplicationGateway_waf(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMApplicationGateway_autoscaleConfiguration(t *testing.T) {
+	resourceName := "azurerm_application_gateway.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApplicationGateway_autoscaleConfiguration(ri, location, 0, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "autoscale_configuration.0.min_capacity", "0"),
+					resource.TestCheckResourceAttr(resourceName, "autoscale_configuration.0.max_capacity", "10"),
+				),
+			},
+			{
+				Config: testAccAzureRMApplicationGateway_autoscaleConfiguration(ri, location, 2, 4),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "autoscale_configuration.0.min_capacity", "2"),
+					resource.TestCheckResourceAttr(resourceName, "autoscale_configuration.0.max_capacity", "4"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMApplicationGateway_probeResponseMatch(t *testing.T) {
 	resourceName := "azurerm_application_gateway.test"
 	ri := acctest.RandInt()
@@ -1565,3 +1595,96 @@ resource "azurerm_application_gateway" "test" {
 }
 `, rInt, location, rInt, rInt, rInt, rInt)
 }
+
+func testAccAzureRMApplicationGateway_autoscaleConfiguration(rInt int, location string, minCapacity int, maxCapacity int) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctest-vnet-%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  address_space       = ["10.254.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "subnet-%[1]d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.254.0.0/24"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                         = "acctest-pubip-%[1]d"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  public_ip_address_allocation = "static"
+  sku                          = "Standard"
+}
+
+resource "azurerm_application_gateway" "test" {
+  name                = "acctestgw-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name = "Standard_v2"
+    tier = "Standard_v2"
+  }
+
+  autoscale_configuration {
+    min_capacity = %[3]d
+    max_capacity = %[4]d
+  }
+
+  gateway_ip_configuration {
+    name      = "gw-ip-config1"
+    subnet_id = "${azurerm_subnet.test.id}"
+  }
+
+  frontend_ip_configuration {
+    name                 = "ip-config-public"
+    public_ip_address_id = "${azurerm_public_ip.test.id}"
+  }
+
+  frontend_port {
+    name = "port-8080"
+    port = 8080
+  }
+
+  backend_address_pool {
+    name = "pool-1"
+
+    fqdn_list = [
+      "terraform.io",
+    ]
+  }
+
+  backend_http_settings {
+    name                  = "backend-http-1"
+    port                  = 8010
+    protocol              = "Http"
+    cookie_based_affinity = "Disabled"
+    request_timeout       = 30
+  }
+
+  http_listener {
+    name                           = "listener-1"
+    frontend_ip_configuration_name = "ip-config-public"
+    frontend_port_name             = "port-8080"
+    protocol                       = "Http"
+  }
+
+  request_routing_rule {
+    name                       = "rule-basic-1"
+    rule_type                  = "Basic"
+    http_listener_name         = "listener-1"
+    backend_address_pool_name  = "pool-1"
+    backend_http_settings_name = "backend-http-1"
+  }
+}
+`, rInt, location, minCapacity, maxCapacity)
+}
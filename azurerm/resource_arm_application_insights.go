@@ -8,18 +8,18 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/appinsights/mgmt/2015-05-01/insights"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmApplicationInsights() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmApplicationInsightsCreateOrUpdate,
-		Read:   resourceArmApplicationInsightsRead,
-		Update: resourceArmApplicationInsightsCreateOrUpdate,
-		Delete: resourceArmApplicationInsightsDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmApplicationInsightsCreateOrUpdate,
+		Read:     resourceArmApplicationInsightsRead,
+		Update:   resourceArmApplicationInsightsCreateOrUpdate,
+		Delete:   resourceArmApplicationInsightsDelete,
+		Importer: azure.ImporterValidatingResourceId("components"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -66,7 +66,8 @@ func resourceArmApplicationInsights() *schema.Resource {
 
 func resourceArmApplicationInsightsCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).appInsightsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Application Insights creation.")
 
@@ -114,7 +115,8 @@ func resourceArmApplicationInsightsCreateOrUpdate(d *schema.ResourceData, meta i
 
 func resourceArmApplicationInsightsRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).appInsightsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -124,7 +126,10 @@ func resourceArmApplicationInsightsRead(d *schema.ResourceData, meta interface{}
 	log.Printf("[DEBUG] Reading AzureRM Application Insights '%s'", id)
 
 	resGroup := id.ResourceGroup
-	name := id.Path["components"]
+	name, err := id.PopSegment("components")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resGroup, name)
 	if err != nil {
@@ -154,14 +159,18 @@ func resourceArmApplicationInsightsRead(d *schema.ResourceData, meta interface{}
 
 func resourceArmApplicationInsightsDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).appInsightsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["components"]
+	name, err := id.PopSegment("components")
+	if err != nil {
+		return err
+	}
 
 	log.Printf("[DEBUG] Deleting AzureRM Application Insights '%s' (resource group '%s')", name, resGroup)
 
@@ -6,19 +6,19 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmApplicationSecurityGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmApplicationSecurityGroupCreateUpdate,
-		Read:   resourceArmApplicationSecurityGroupRead,
-		Update: resourceArmApplicationSecurityGroupCreateUpdate,
-		Delete: resourceArmApplicationSecurityGroupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmApplicationSecurityGroupCreateUpdate,
+		Read:     resourceArmApplicationSecurityGroupRead,
+		Update:   resourceArmApplicationSecurityGroupCreateUpdate,
+		Delete:   resourceArmApplicationSecurityGroupDelete,
+		Importer: azure.ImporterValidatingResourceId("applicationSecurityGroups"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -38,7 +38,8 @@ func resourceArmApplicationSecurityGroup() *schema.Resource {
 
 func resourceArmApplicationSecurityGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).applicationSecurityGroupsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resourceGroup := d.Get("resource_group_name").(string)
 	name := d.Get("name").(string)
@@ -74,14 +75,18 @@ func resourceArmApplicationSecurityGroupCreateUpdate(d *schema.ResourceData, met
 
 func resourceArmApplicationSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).applicationSecurityGroupsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["applicationSecurityGroups"]
+	name, err := id.PopSegment("applicationSecurityGroups")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -105,14 +110,18 @@ func resourceArmApplicationSecurityGroupRead(d *schema.ResourceData, meta interf
 
 func resourceArmApplicationSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).applicationSecurityGroupsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["applicationSecurityGroups"]
+	name, err := id.PopSegment("applicationSecurityGroups")
+	if err != nil {
+		return err
+	}
 
 	log.Printf("[DEBUG] Deleting Application Security Group %q (resource group %q)", name, resourceGroup)
 
@@ -0,0 +1,298 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2017-05-10/resources"
+	"github.com/Azure/go-autorest/autorest"
+	autorestAzure "github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmArmResource() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmArmResourceCreateUpdate,
+		Read:   resourceArmArmResourceRead,
+		Update: resourceArmArmResourceCreateUpdate,
+		Delete: resourceArmArmResourceDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceArmArmResourceImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceIDAtAnyScope,
+			},
+
+			"api_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"body": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+
+			"output": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmArmResourceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	resourceId := d.Get("resource_id").(string)
+	apiVersion := d.Get("api_version").(string)
+
+	body, err := structure.ExpandJsonFromString(d.Get("body").(string))
+	if err != nil {
+		return fmt.Errorf("Error expanding `body`: %+v", err)
+	}
+
+	req, err := armResourcePreparer(ctx, client, resourceId, apiVersion, autorest.AsPut(), autorest.AsContentType("application/json; charset=utf-8"), autorest.WithJSON(body))
+	if err != nil {
+		return fmt.Errorf("Error preparing the request to create/update %q (API Version %q): %+v", resourceId, apiVersion, err)
+	}
+
+	resp, err := armResourceSend(client, req)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating %q (API Version %q): %+v", resourceId, apiVersion, err)
+	}
+
+	if err := armResourceWaitForCompletion(ctx, client, resp); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of %q (API Version %q): %+v", resourceId, apiVersion, err)
+	}
+
+	d.SetId(resourceId)
+
+	return resourceArmArmResourceRead(d, meta)
+}
+
+func resourceArmArmResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	resourceId := d.Id()
+	apiVersion := d.Get("api_version").(string)
+
+	req, err := armResourcePreparer(ctx, client, resourceId, apiVersion, autorest.AsGet())
+	if err != nil {
+		return fmt.Errorf("Error preparing the request to retrieve %q (API Version %q): %+v", resourceId, apiVersion, err)
+	}
+
+	resp, err := autorest.SendWithSender(client, req)
+	if err != nil {
+		return fmt.Errorf("Error retrieving %q (API Version %q): %+v", resourceId, apiVersion, err)
+	}
+
+	if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+		d.SetId("")
+		return nil
+	}
+
+	output := make(map[string]interface{})
+	err = autorest.Respond(resp,
+		client.ByInspecting(),
+		autorest.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&output),
+		autorest.ByClosing())
+	if err != nil {
+		return fmt.Errorf("Error retrieving %q (API Version %q): %+v", resourceId, apiVersion, err)
+	}
+
+	outputStr, err := structure.FlattenJsonToString(output)
+	if err != nil {
+		return fmt.Errorf("Error flattening `output`: %+v", err)
+	}
+
+	d.Set("resource_id", resourceId)
+	d.Set("api_version", apiVersion)
+	d.Set("output", outputStr)
+
+	// the `body` isn't known when importing, since it's not possible to determine which fields should be managed
+	if v := d.Get("body").(string); v != "" {
+		existing, err := structure.ExpandJsonFromString(v)
+		if err != nil {
+			return fmt.Errorf("Error expanding `body`: %+v", err)
+		}
+
+		filtered := filterArmResourceBody(existing, output).(map[string]interface{})
+		// Azure returns the normalized location (e.g. `westeurope` rather than `West Europe`)
+		location, ok := filtered["location"].(string)
+		existingLocation, existingOk := existing["location"].(string)
+		if ok && existingOk && azureRMNormalizeLocation(location) == azureRMNormalizeLocation(existingLocation) {
+			filtered["location"] = existingLocation
+		}
+
+		body, err := structure.FlattenJsonToString(filtered)
+		if err != nil {
+			return fmt.Errorf("Error flattening `body`: %+v", err)
+		}
+		d.Set("body", body)
+	}
+
+	return nil
+}
+
+func resourceArmArmResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	resourceId := d.Id()
+	apiVersion := d.Get("api_version").(string)
+
+	req, err := armResourcePreparer(ctx, client, resourceId, apiVersion, autorest.AsDelete())
+	if err != nil {
+		return fmt.Errorf("Error preparing the request to delete %q (API Version %q): %+v", resourceId, apiVersion, err)
+	}
+
+	resp, err := armResourceSend(client, req)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting %q (API Version %q): %+v", resourceId, apiVersion, err)
+	}
+
+	if err := armResourceWaitForCompletion(ctx, client, resp); err != nil {
+		return fmt.Errorf("Error waiting for deletion of %q (API Version %q): %+v", resourceId, apiVersion, err)
+	}
+
+	return nil
+}
+
+// resourceArmArmResourceImport imports using an ID in the format `{resourceId}?api-version={apiVersion}`,
+// since the API Version to use can't be determined from the Resource ID alone
+func resourceArmArmResourceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceId, apiVersion, err := parseArmResourceImportId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(resourceId)
+	d.Set("resource_id", resourceId)
+	d.Set("api_version", apiVersion)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func parseArmResourceImportId(input string) (string, string, error) {
+	segments := strings.Split(input, "?api-version=")
+	if len(segments) != 2 || segments[0] == "" || segments[1] == "" {
+		return "", "", fmt.Errorf("Expected an ID in the format `{resourceId}?api-version={apiVersion}` but got %q", input)
+	}
+
+	if _, errors := azure.ValidateResourceIDAtAnyScope(segments[0], "resource_id"); len(errors) > 0 {
+		return "", "", fmt.Errorf("Error parsing Resource ID %q: %+v", segments[0], errors[0])
+	}
+
+	return segments[0], segments[1], nil
+}
+
+// filterArmResourceBody returns the values from the response from Azure for the fields which are defined in the body, such that
+// changes made outside of Terraform are detected without the Read-Only fields (e.g. `id` and `etag`) causing a diff. Fields
+// which aren't returned from Azure (e.g. secrets) keep the value from the body, since these can't be compared.
+func filterArmResourceBody(body interface{}, response interface{}) interface{} {
+	switch bodyValue := body.(type) {
+	case map[string]interface{}:
+		responseValue, ok := response.(map[string]interface{})
+		if !ok {
+			return response
+		}
+
+		output := make(map[string]interface{})
+		for k, v := range bodyValue {
+			if value, ok := responseValue[k]; ok {
+				output[k] = filterArmResourceBody(v, value)
+			} else {
+				output[k] = v
+			}
+		}
+		return output
+
+	case []interface{}:
+		responseValue, ok := response.([]interface{})
+		if !ok {
+			return response
+		}
+
+		output := make([]interface{}, 0, len(responseValue))
+		for i, v := range responseValue {
+			if i < len(bodyValue) {
+				output = append(output, filterArmResourceBody(bodyValue[i], v))
+			} else {
+				output = append(output, v)
+			}
+		}
+		return output
+	}
+
+	return response
+}
+
+func armResourcePreparer(ctx context.Context, client resources.Client, resourceId string, apiVersion string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"resourceId": strings.TrimPrefix(resourceId, "/"),
+	}
+	queryParameters := map[string]interface{}{
+		"api-version": apiVersion,
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{resourceId}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func armResourceSend(client resources.Client, req *http.Request) (*http.Response, error) {
+	resp, err := autorest.SendWithSender(client, req,
+		autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		return resp, err
+	}
+
+	err = autorest.Respond(resp,
+		client.ByInspecting(),
+		autorest.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusNoContent))
+	return resp, err
+}
+
+// armResourceWaitForCompletion polls the Azure-AsyncOperation/Location header of a long-running
+// operation until it completes; operations which complete synchronously return immediately
+func armResourceWaitForCompletion(ctx context.Context, client resources.Client, resp *http.Response) error {
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusAccepted {
+		return autorest.Respond(resp, autorest.ByClosing())
+	}
+
+	future, err := autorestAzure.NewFutureFromResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	return future.WaitForCompletionRef(ctx, client.Client)
+}
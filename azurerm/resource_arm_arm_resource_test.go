@@ -0,0 +1,270 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAzureRMArmResource_parseImportId(t *testing.T) {
+	testData := []struct {
+		Input              string
+		ExpectedResourceId string
+		ExpectedApiVersion string
+		ExpectError        bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			ExpectError: true,
+		},
+		{
+			Input:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1?api-version=",
+			ExpectError: true,
+		},
+		{
+			Input:       "network1?api-version=2018-08-01",
+			ExpectError: true,
+		},
+		{
+			Input:              "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1?api-version=2018-08-01",
+			ExpectedResourceId: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			ExpectedApiVersion: "2018-08-01",
+		},
+		{
+			Input:              "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyDefinitions/policy1?api-version=2018-05-01",
+			ExpectedResourceId: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyDefinitions/policy1",
+			ExpectedApiVersion: "2018-05-01",
+		},
+		{
+			Input:              "/providers/Microsoft.Management/managementGroups/group1?api-version=2018-03-01-preview",
+			ExpectedResourceId: "/providers/Microsoft.Management/managementGroups/group1",
+			ExpectedApiVersion: "2018-03-01-preview",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		resourceId, apiVersion, err := parseArmResourceImportId(v.Input)
+		if err != nil {
+			if v.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if v.ExpectError {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if resourceId != v.ExpectedResourceId {
+			t.Fatalf("Expected the Resource ID to be %q but got %q", v.ExpectedResourceId, resourceId)
+		}
+
+		if apiVersion != v.ExpectedApiVersion {
+			t.Fatalf("Expected the API Version to be %q but got %q", v.ExpectedApiVersion, apiVersion)
+		}
+	}
+}
+
+func TestAzureRMArmResource_filterBody(t *testing.T) {
+	body := map[string]interface{}{
+		"location": "westeurope",
+		"properties": map[string]interface{}{
+			"addressSpace": map[string]interface{}{
+				"addressPrefixes": []interface{}{"10.0.0.0/16"},
+			},
+			"subnets": []interface{}{
+				map[string]interface{}{
+					"name": "subnet1",
+					"properties": map[string]interface{}{
+						"addressPrefix": "10.0.1.0/24",
+					},
+				},
+			},
+			"secret": "not-returned",
+		},
+	}
+	response := map[string]interface{}{
+		"id":       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+		"etag":     "W/\"00000000-0000-0000-0000-000000000000\"",
+		"location": "westeurope",
+		"properties": map[string]interface{}{
+			"addressSpace": map[string]interface{}{
+				"addressPrefixes": []interface{}{"10.1.0.0/16"},
+			},
+			"subnets": []interface{}{
+				map[string]interface{}{
+					"id":   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+					"etag": "W/\"00000000-0000-0000-0000-000000000000\"",
+					"name": "subnet1",
+					"properties": map[string]interface{}{
+						"addressPrefix":     "10.0.2.0/24",
+						"provisioningState": "Succeeded",
+					},
+				},
+			},
+			"provisioningState": "Succeeded",
+		},
+	}
+	expected := map[string]interface{}{
+		"location": "westeurope",
+		"properties": map[string]interface{}{
+			"addressSpace": map[string]interface{}{
+				"addressPrefixes": []interface{}{"10.1.0.0/16"},
+			},
+			"subnets": []interface{}{
+				map[string]interface{}{
+					"name": "subnet1",
+					"properties": map[string]interface{}{
+						"addressPrefix": "10.0.2.0/24",
+					},
+				},
+			},
+			"secret": "not-returned",
+		},
+	}
+
+	actual := filterArmResourceBody(body, response)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestAccAzureRMArmResource_basic(t *testing.T) {
+	resourceName := "azurerm_arm_resource.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMArmResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMArmResource_basic(ri, location, "10.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMArmResourceExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "output"),
+				),
+			},
+			{
+				Config: testAccAzureRMArmResource_basic(ri, location, "10.1.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMArmResourceExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAzureRMArmResourceImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"body",
+				},
+			},
+		},
+	})
+}
+
+func testAccAzureRMArmResourceImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s?api-version=%s", rs.Primary.ID, rs.Primary.Attributes["api_version"]), nil
+	}
+}
+
+func testCheckAzureRMArmResourceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		req, err := armResourcePreparer(ctx, client, rs.Primary.ID, rs.Primary.Attributes["api_version"], autorest.AsGet())
+		if err != nil {
+			return err
+		}
+
+		resp, err := autorest.SendWithSender(client, req)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on %q: %+v", rs.Primary.ID, err)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("Bad: %q does not exist (Status Code %d)", rs.Primary.ID, resp.StatusCode)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMArmResourceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_arm_resource" {
+			continue
+		}
+
+		req, err := armResourcePreparer(ctx, client, rs.Primary.ID, rs.Primary.Attributes["api_version"], autorest.AsGet())
+		if err != nil {
+			return err
+		}
+
+		resp, err := autorest.SendWithSender(client, req)
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("%q still exists (Status Code %d)", rs.Primary.ID, resp.StatusCode)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMArmResource_basic(rInt int, location string, addressPrefix string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_arm_resource" "test" {
+  resource_id = "${azurerm_resource_group.test.id}/providers/Microsoft.Network/virtualNetworks/acctestvirtnet%d"
+  api_version = "2018-08-01"
+
+  body = <<BODY
+{
+  "location": "${azurerm_resource_group.test.location}",
+  "properties": {
+    "addressSpace": {
+      "addressPrefixes": ["%s"]
+    }
+  }
+}
+BODY
+}
+`, rInt, location, rInt, addressPrefix)
+}
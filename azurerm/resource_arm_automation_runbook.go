@@ -9,6 +9,8 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/automation/mgmt/2015-10-31/automation"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -19,9 +21,7 @@ func resourceArmAutomationRunbook() *schema.Resource {
 		Update: resourceArmAutomationRunbookCreateUpdate,
 		Delete: resourceArmAutomationRunbookDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: azure.ImporterValidatingResourceId("automationAccounts", "runbooks"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -120,7 +120,8 @@ func resourceArmAutomationRunbook() *schema.Resource {
 
 func resourceArmAutomationRunbookCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).automationRunbookClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Automation Runbook creation.")
 
@@ -186,15 +187,22 @@ func resourceArmAutomationRunbookCreateUpdate(d *schema.ResourceData, meta inter
 
 func resourceArmAutomationRunbookRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).automationRunbookClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	accName := id.Path["automationAccounts"]
-	name := id.Path["runbooks"]
+	accName, err := id.PopSegment("automationAccounts")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("runbooks")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resGroup, accName, name)
 	if err != nil {
@@ -243,15 +251,22 @@ func resourceArmAutomationRunbookRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmAutomationRunbookDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).automationRunbookClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	accName := id.Path["automationAccounts"]
-	name := id.Path["runbooks"]
+	accName, err := id.PopSegment("automationAccounts")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("runbooks")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Delete(ctx, resGroup, accName, name)
 	if err != nil {
@@ -11,8 +11,10 @@ import (
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/set"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -24,9 +26,7 @@ func resourceArmAutomationSchedule() *schema.Resource {
 		Update: resourceArmAutomationScheduleCreateUpdate,
 		Delete: resourceArmAutomationScheduleDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: azure.ImporterValidatingResourceId("automationAccounts", "schedules"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -216,7 +216,8 @@ func resourceArmAutomationSchedule() *schema.Resource {
 
 func resourceArmAutomationScheduleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).automationScheduleClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Automation Schedule creation.")
 
@@ -298,16 +299,23 @@ func resourceArmAutomationScheduleCreateUpdate(d *schema.ResourceData, meta inte
 
 func resourceArmAutomationScheduleRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).automationScheduleClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	name := id.Path["schedules"]
+	name, err := id.PopSegment("schedules")
+	if err != nil {
+		return err
+	}
 	resGroup := id.ResourceGroup
-	accountName := id.Path["automationAccounts"]
+	accountName, err := id.PopSegment("automationAccounts")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resGroup, accountName, name)
 	if err != nil {
@@ -358,16 +366,23 @@ func resourceArmAutomationScheduleRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmAutomationScheduleDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).automationScheduleClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	name := id.Path["schedules"]
+	name, err := id.PopSegment("schedules")
+	if err != nil {
+		return err
+	}
 	resGroup := id.ResourceGroup
-	accountName := id.Path["automationAccounts"]
+	accountName, err := id.PopSegment("automationAccounts")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Delete(ctx, resGroup, accountName, name)
 	if err != nil {
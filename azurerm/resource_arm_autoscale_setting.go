@@ -12,18 +12,17 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmAutoScaleSetting() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmAutoScaleSettingCreateOrUpdate,
-		Read:   resourceArmAutoScaleSettingRead,
-		Update: resourceArmAutoScaleSettingCreateOrUpdate,
-		Delete: resourceArmAutoScaleSettingDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmAutoScaleSettingCreateOrUpdate,
+		Read:     resourceArmAutoScaleSettingRead,
+		Update:   resourceArmAutoScaleSettingCreateOrUpdate,
+		Delete:   resourceArmAutoScaleSettingDelete,
+		Importer: azure.ImporterValidatingResourceId("autoscalesettings"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -340,7 +339,8 @@ func resourceArmAutoScaleSetting() *schema.Resource {
 
 func resourceArmAutoScaleSettingCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).autoscaleSettingsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -390,14 +390,18 @@ func resourceArmAutoScaleSettingCreateOrUpdate(d *schema.ResourceData, meta inte
 
 func resourceArmAutoScaleSettingRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).autoscaleSettingsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["autoscalesettings"]
+	name, err := id.PopSegment("autoscalesettings")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -441,14 +445,18 @@ func resourceArmAutoScaleSettingRead(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmAutoScaleSettingDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).autoscaleSettingsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["autoscalesettings"]
+	name, err := id.PopSegment("autoscalesettings")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
@@ -8,18 +8,18 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmAvailabilitySet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmAvailabilitySetCreate,
-		Read:   resourceArmAvailabilitySetRead,
-		Update: resourceArmAvailabilitySetCreate,
-		Delete: resourceArmAvailabilitySetDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmAvailabilitySetCreate,
+		Read:     resourceArmAvailabilitySetRead,
+		Update:   resourceArmAvailabilitySetCreate,
+		Delete:   resourceArmAvailabilitySetDelete,
+		Importer: azure.ImporterValidatingResourceId("availabilitySets"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -62,7 +62,8 @@ func resourceArmAvailabilitySet() *schema.Resource {
 
 func resourceArmAvailabilitySetCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).availSetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Availability Set creation.")
 
@@ -103,14 +104,18 @@ func resourceArmAvailabilitySetCreate(d *schema.ResourceData, meta interface{})
 
 func resourceArmAvailabilitySetRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).availSetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["availabilitySets"]
+	name, err := id.PopSegment("availabilitySets")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resGroup, name)
 	if err != nil {
@@ -141,14 +146,18 @@ func resourceArmAvailabilitySetRead(d *schema.ResourceData, meta interface{}) er
 
 func resourceArmAvailabilitySetDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).availSetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["availabilitySets"]
+	name, err := id.PopSegment("availabilitySets")
+	if err != nil {
+		return err
+	}
 
 	_, err = client.Delete(ctx, resGroup, name)
 
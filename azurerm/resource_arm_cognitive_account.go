@@ -7,7 +7,9 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/cognitiveservices/mgmt/2017-04-18/cognitiveservices"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -16,13 +18,11 @@ type cognitiveServicesPropertiesStruct struct{}
 
 func resourceArmCognitiveAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmCognitiveAccountCreate,
-		Read:   resourceArmCognitiveAccountRead,
-		Update: resourceArmCognitiveAccountUpdate,
-		Delete: resourceArmCognitiveAccountDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmCognitiveAccountCreate,
+		Read:     resourceArmCognitiveAccountRead,
+		Update:   resourceArmCognitiveAccountUpdate,
+		Delete:   resourceArmCognitiveAccountDelete,
+		Importer: azure.ImporterValidatingResourceId("accounts"),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -114,7 +114,8 @@ func resourceArmCognitiveAccount() *schema.Resource {
 
 func resourceArmCognitiveAccountCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).cognitiveAccountsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	location := azureRMNormalizeLocation(d.Get("location").(string))
@@ -148,7 +149,8 @@ func resourceArmCognitiveAccountCreate(d *schema.ResourceData, meta interface{})
 
 func resourceArmCognitiveAccountUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).cognitiveAccountsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -156,7 +158,10 @@ func resourceArmCognitiveAccountUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	resourceGroup := id.ResourceGroup
-	name := id.Path["accounts"]
+	name, err := id.PopSegment("accounts")
+	if err != nil {
+		return err
+	}
 
 	tags := d.Get("tags").(map[string]interface{})
 	sku := expandCognitiveAccountSku(d)
@@ -176,7 +181,8 @@ func resourceArmCognitiveAccountUpdate(d *schema.ResourceData, meta interface{})
 
 func resourceArmCognitiveAccountRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).cognitiveAccountsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -184,7 +190,10 @@ func resourceArmCognitiveAccountRead(d *schema.ResourceData, meta interface{}) e
 	}
 
 	resourceGroup := id.ResourceGroup
-	name := id.Path["accounts"]
+	name, err := id.PopSegment("accounts")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.GetProperties(ctx, resourceGroup, name)
 	if err != nil {
@@ -219,7 +228,8 @@ func resourceArmCognitiveAccountRead(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmCognitiveAccountDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).cognitiveAccountsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -227,7 +237,10 @@ func resourceArmCognitiveAccountDelete(d *schema.ResourceData, meta interface{})
 	}
 
 	resourceGroup := id.ResourceGroup
-	name := id.Path["accounts"]
+	name, err := id.PopSegment("accounts")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
@@ -8,17 +8,17 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/containerinstance/mgmt/2018-04-01/containerinstance"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmContainerGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmContainerGroupCreate,
-		Read:   resourceArmContainerGroupRead,
-		Delete: resourceArmContainerGroupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmContainerGroupCreate,
+		Read:     resourceArmContainerGroupRead,
+		Delete:   resourceArmContainerGroupDelete,
+		Importer: azure.ImporterValidatingResourceId("containerGroups"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -238,7 +238,8 @@ func resourceArmContainerGroup() *schema.Resource {
 }
 
 func resourceArmContainerGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	containerGroupsClient := meta.(*ArmClient).containerGroupsClient
 
 	resGroup := d.Get("resource_group_name").(string)
@@ -291,7 +292,8 @@ func resourceArmContainerGroupCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceArmContainerGroupRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	client := meta.(*ArmClient).containerGroupsClient
 
 	id, err := parseAzureResourceID(d.Id())
@@ -300,7 +302,10 @@ func resourceArmContainerGroupRead(d *schema.ResourceData, meta interface{}) err
 	}
 
 	resourceGroup := id.ResourceGroup
-	name := id.Path["containerGroups"]
+	name, err := id.PopSegment("containerGroups")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resourceGroup, name)
 
@@ -345,7 +350,8 @@ func resourceArmContainerGroupRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceArmContainerGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	client := meta.(*ArmClient).containerGroupsClient
 
 	id, err := parseAzureResourceID(d.Id())
@@ -354,7 +360,10 @@ func resourceArmContainerGroupDelete(d *schema.ResourceData, meta interface{}) e
 	}
 
 	resourceGroup := id.ResourceGroup
-	name := id.Path["containerGroups"]
+	name, err := id.PopSegment("containerGroups")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -10,19 +11,20 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/containerregistry/mgmt/2017-10-01/containerregistry"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmContainerRegistry() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmContainerRegistryCreate,
-		Read:   resourceArmContainerRegistryRead,
-		Update: resourceArmContainerRegistryUpdate,
-		Delete: resourceArmContainerRegistryDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:        resourceArmContainerRegistryCreate,
+		Read:          resourceArmContainerRegistryRead,
+		Update:        resourceArmContainerRegistryUpdate,
+		Delete:        resourceArmContainerRegistryDelete,
+		Importer:      azure.ImporterValidatingResourceId("registries"),
+		CustomizeDiff: resourceArmContainerRegistryCustomizeDiff,
 		MigrateState:  resourceAzureRMContainerRegistryMigrateState,
 		SchemaVersion: 2,
 
@@ -104,9 +106,26 @@ func resourceArmContainerRegistry() *schema.Resource {
 	}
 }
 
+func resourceArmContainerRegistryCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	return validateNameIsAvailable(diff, meta, "Container Registry", func(ctx context.Context, name string) (*bool, *string, error) {
+		client := meta.(*ArmClient).containerRegistryClient
+		request := containerregistry.RegistryNameCheckRequest{
+			Name: utils.String(name),
+			Type: utils.String("Microsoft.ContainerRegistry/registries"),
+		}
+		available, err := client.CheckNameAvailability(ctx, request)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return available.NameAvailable, available.Message, nil
+	})
+}
+
 func resourceArmContainerRegistryCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).containerRegistryClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM Container Registry creation.")
 
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -168,7 +187,8 @@ func resourceArmContainerRegistryCreate(d *schema.ResourceData, meta interface{}
 
 func resourceArmContainerRegistryUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).containerRegistryClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM Container Registry update.")
 
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -229,14 +249,18 @@ func resourceArmContainerRegistryUpdate(d *schema.ResourceData, meta interface{}
 
 func resourceArmContainerRegistryRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).containerRegistryClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["registries"]
+	name, err := id.PopSegment("registries")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -288,14 +312,18 @@ func resourceArmContainerRegistryRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmContainerRegistryDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).containerRegistryClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["registries"]
+	name, err := id.PopSegment("registries")
+	if err != nil {
+		return err
+	}
 
 	future, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
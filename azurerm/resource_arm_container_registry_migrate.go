@@ -7,23 +7,18 @@ import (
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/migration"
 )
 
-func resourceAzureRMContainerRegistryMigrateState(
-	v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
-	switch v {
-	case 0:
-		log.Println("[INFO] Found AzureRM Container Registry State v0; migrating to v1")
-		return migrateAzureRMContainerRegistryStateV0toV1(is)
-	case 1:
-		log.Println("[INFO] Found AzureRM Container Registry State v1; migrating to v2")
-		return migrateAzureRMContainerRegistryStateV1toV2(is, meta)
-	default:
-		return is, fmt.Errorf("Unexpected schema version: %d", v)
+func resourceAzureRMContainerRegistryMigrateState(v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	upgraders := []migration.StateUpgrader{
+		migrateAzureRMContainerRegistryStateV0toV1,
+		migrateAzureRMContainerRegistryStateV1toV2,
 	}
+	return migration.MigrateStateUsingUpgraders("Container Registry", upgraders...)(v, is, meta)
 }
 
-func migrateAzureRMContainerRegistryStateV0toV1(is *terraform.InstanceState) (*terraform.InstanceState, error) {
+func migrateAzureRMContainerRegistryStateV0toV1(is *terraform.InstanceState, _ interface{}) (*terraform.InstanceState, error) {
 	if is.Empty() {
 		log.Println("[DEBUG] Empty InstanceState; nothing to migrate.")
 		return is, nil
@@ -53,12 +53,12 @@ func TestAccAzureRMContainerRegistryMigrateState(t *testing.T) {
 		Expected     map[string]string
 		Meta         interface{}
 	}{
-		"v0_1_without_value": {
+		"v0_2_without_value": {
 			StateVersion: 0,
 			ID:           "some_id",
 			Attributes:   map[string]string{},
 			Expected: map[string]string{
-				"sku": "Basic",
+				"sku": "Classic",
 			},
 		},
 		"v1_2_with_value": {
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -106,6 +107,29 @@ func TestAccAzureRMContainerRegistry_basicBasic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMContainerRegistry_nameUnavailable(t *testing.T) {
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMContainerRegistryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMContainerRegistry_basicManaged(ri, location, "Basic"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMContainerRegistryExists("azurerm_container_registry.test"),
+				),
+			},
+			{
+				Config:      testAccAzureRMContainerRegistry_nameUnavailable(ri, location),
+				ExpectError: regexp.MustCompile("needs to be globally unique and isn't available"),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMContainerRegistry_basicStandard(t *testing.T) {
 	ri := acctest.RandInt()
 	config := testAccAzureRMContainerRegistry_basicManaged(ri, testLocation(), "Standard")
@@ -292,6 +316,20 @@ resource "azurerm_container_registry" "test" {
 `, rInt, location, rInt, sku)
 }
 
+func testAccAzureRMContainerRegistry_nameUnavailable(rInt int, location string) string {
+	template := testAccAzureRMContainerRegistry_basicManaged(rInt, location, "Basic")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry" "duplicate" {
+  name                = "testacccr%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  sku                 = "Basic"
+}
+`, template, rInt)
+}
+
 func testAccAzureRMContainerRegistry_basicUnmanaged(rInt int, rStr string, location string, sku string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -12,15 +12,18 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmContainerService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmContainerServiceCreate,
-		Read:   resourceArmContainerServiceRead,
-		Update: resourceArmContainerServiceCreate,
-		Delete: resourceArmContainerServiceDelete,
+		Create:   resourceArmContainerServiceCreate,
+		Read:     resourceArmContainerServiceRead,
+		Update:   resourceArmContainerServiceCreate,
+		Delete:   resourceArmContainerServiceDelete,
+		Importer: azure.ImporterValidatingResourceId("containerServices"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -220,7 +223,8 @@ func resourceArmContainerServiceCreate(d *schema.ResourceData, meta interface{})
 		parameters.ServicePrincipalProfile = servicePrincipalProfile
 	}
 
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	_, error := containerServiceClient.CreateOrUpdate(ctx, resGroup, name, parameters)
 	if error != nil {
 		return error
@@ -240,7 +244,7 @@ func resourceArmContainerServiceCreate(d *schema.ResourceData, meta interface{})
 		Pending:    []string{"Updating", "Creating"},
 		Target:     []string{"Succeeded"},
 		Refresh:    containerServiceStateRefreshFunc(client, resGroup, name),
-		Timeout:    30 * time.Minute,
+		Timeout:    d.Timeout(schema.TimeoutCreate),
 		MinTimeout: 15 * time.Second,
 	}
 	if _, err := stateConf.WaitForState(); err != nil {
@@ -260,9 +264,13 @@ func resourceArmContainerServiceRead(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["containerServices"]
+	name, err := id.PopSegment("containerServices")
+	if err != nil {
+		return err
+	}
 
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	resp, err := containerServiceClient.Get(ctx, resGroup, name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
@@ -314,9 +322,13 @@ func resourceArmContainerServiceDelete(d *schema.ResourceData, meta interface{})
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["containerServices"]
+	name, err := id.PopSegment("containerServices")
+	if err != nil {
+		return err
+	}
 
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	future, err := containerServiceClient.Delete(ctx, resGroup, name)
 
 	if err != nil {
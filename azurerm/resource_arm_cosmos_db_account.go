@@ -18,18 +18,17 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmCosmosDBAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmCosmosDBAccountCreate,
-		Read:   resourceArmCosmosDBAccountRead,
-		Update: resourceArmCosmosDBAccountUpdate,
-		Delete: resourceArmCosmosDBAccountDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmCosmosDBAccountCreate,
+		Read:     resourceArmCosmosDBAccountRead,
+		Update:   resourceArmCosmosDBAccountUpdate,
+		Delete:   resourceArmCosmosDBAccountDelete,
+		Importer: azure.ImporterValidatingResourceId("databaseAccounts"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -200,6 +199,7 @@ func resourceArmCosmosDBAccount() *schema.Resource {
 								"EnableTable",
 								"EnableGremlin",
 								`EnableCassandra`,
+								"EnableServerless",
 							}, true),
 						},
 					},
@@ -288,7 +288,8 @@ func resourceArmCosmosDBAccount() *schema.Resource {
 
 func resourceArmCosmosDBAccountCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).cosmosDBClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM Cosmos DB Account creation.")
 
 	name := d.Get("name").(string)
@@ -359,7 +360,8 @@ func resourceArmCosmosDBAccountCreate(d *schema.ResourceData, meta interface{})
 
 func resourceArmCosmosDBAccountUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).cosmosDBClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM Cosmos DB Account update.")
 
 	//move to function
@@ -490,14 +492,18 @@ func resourceArmCosmosDBAccountUpdate(d *schema.ResourceData, meta interface{})
 
 func resourceArmCosmosDBAccountRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).cosmosDBClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	name := id.Path["databaseAccounts"]
+	name, err := id.PopSegment("databaseAccounts")
+	if err != nil {
+		return err
+	}
 	resourceGroup := id.ResourceGroup
 
 	resp, err := client.Get(ctx, resourceGroup, name)
@@ -603,7 +609,8 @@ func resourceArmCosmosDBAccountRead(d *schema.ResourceData, meta interface{}) er
 
 func resourceArmCosmosDBAccountDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).cosmosDBClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -611,7 +618,10 @@ func resourceArmCosmosDBAccountDelete(d *schema.ResourceData, meta interface{})
 	}
 
 	resourceGroup := id.ResourceGroup
-	name := id.Path["databaseAccounts"]
+	name, err := id.PopSegment("databaseAccounts")
+	if err != nil {
+		return err
+	}
 
 	future, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
@@ -625,7 +635,7 @@ func resourceArmCosmosDBAccountDelete(d *schema.ResourceData, meta interface{})
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"Deleting"},
 		Target:     []string{"NotFound"},
-		Timeout:    60 * time.Minute,
+		Timeout:    d.Timeout(schema.TimeoutDelete),
 		MinTimeout: 30 * time.Second,
 		Refresh: func() (interface{}, string, error) {
 
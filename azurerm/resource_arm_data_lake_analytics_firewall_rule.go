@@ -10,6 +10,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -21,9 +22,7 @@ func resourceArmDataLakeAnalyticsFirewallRule() *schema.Resource {
 		Update: resourceArmDateLakeAnalyticsFirewallRuleCreateUpdate,
 		Delete: resourceArmDateLakeAnalyticsFirewallRuleDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: azure.ImporterValidatingResourceId("accounts", "firewallRules"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -59,7 +58,8 @@ func resourceArmDataLakeAnalyticsFirewallRule() *schema.Resource {
 
 func resourceArmDateLakeAnalyticsFirewallRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dataLakeAnalyticsFirewallRulesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	accountName := d.Get("account_name").(string)
@@ -96,15 +96,22 @@ func resourceArmDateLakeAnalyticsFirewallRuleCreateUpdate(d *schema.ResourceData
 
 func resourceArmDateLakeAnalyticsFirewallRuleRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dataLakeAnalyticsFirewallRulesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	accountName := id.Path["accounts"]
-	name := id.Path["firewallRules"]
+	accountName, err := id.PopSegment("accounts")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("firewallRules")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resourceGroup, accountName, name)
 	if err != nil {
@@ -130,7 +137,8 @@ func resourceArmDateLakeAnalyticsFirewallRuleRead(d *schema.ResourceData, meta i
 
 func resourceArmDateLakeAnalyticsFirewallRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dataLakeAnalyticsFirewallRulesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -138,8 +146,14 @@ func resourceArmDateLakeAnalyticsFirewallRuleDelete(d *schema.ResourceData, meta
 	}
 
 	resourceGroup := id.ResourceGroup
-	accountName := id.Path["accounts"]
-	name := id.Path["firewallRules"]
+	accountName, err := id.PopSegment("accounts")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("firewallRules")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Delete(ctx, resourceGroup, accountName, name)
 	if err != nil {
@@ -8,19 +8,18 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmDataLakeStoreFirewallRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmDateLakeStoreAccountFirewallRuleCreateUpdate,
-		Read:   resourceArmDateLakeStoreAccountFirewallRuleRead,
-		Update: resourceArmDateLakeStoreAccountFirewallRuleCreateUpdate,
-		Delete: resourceArmDateLakeStoreAccountFirewallRuleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmDateLakeStoreAccountFirewallRuleCreateUpdate,
+		Read:     resourceArmDateLakeStoreAccountFirewallRuleRead,
+		Update:   resourceArmDateLakeStoreAccountFirewallRuleCreateUpdate,
+		Delete:   resourceArmDateLakeStoreAccountFirewallRuleDelete,
+		Importer: azure.ImporterValidatingResourceId("accounts", "firewallRules"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -56,7 +55,8 @@ func resourceArmDataLakeStoreFirewallRule() *schema.Resource {
 
 func resourceArmDateLakeStoreAccountFirewallRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dataLakeStoreFirewallRulesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	accountName := d.Get("account_name").(string)
@@ -93,15 +93,22 @@ func resourceArmDateLakeStoreAccountFirewallRuleCreateUpdate(d *schema.ResourceD
 
 func resourceArmDateLakeStoreAccountFirewallRuleRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dataLakeStoreFirewallRulesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	accountName := id.Path["accounts"]
-	name := id.Path["firewallRules"]
+	accountName, err := id.PopSegment("accounts")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("firewallRules")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resourceGroup, accountName, name)
 	if err != nil {
@@ -127,7 +134,8 @@ func resourceArmDateLakeStoreAccountFirewallRuleRead(d *schema.ResourceData, met
 
 func resourceArmDateLakeStoreAccountFirewallRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dataLakeStoreFirewallRulesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -135,8 +143,14 @@ func resourceArmDateLakeStoreAccountFirewallRuleDelete(d *schema.ResourceData, m
 	}
 
 	resourceGroup := id.ResourceGroup
-	accountName := id.Path["accounts"]
-	name := id.Path["firewallRules"]
+	accountName, err := id.PopSegment("accounts")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("firewallRules")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Delete(ctx, resourceGroup, accountName, name)
 	if err != nil {
@@ -8,19 +8,19 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/databricks/mgmt/2018-04-01/databricks"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmDatabricksWorkspace() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmDatabricksWorkspaceCreateUpdate,
-		Read:   resourceArmDatabricksWorkspaceRead,
-		Update: resourceArmDatabricksWorkspaceCreateUpdate,
-		Delete: resourceArmDatabricksWorkspaceDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmDatabricksWorkspaceCreateUpdate,
+		Read:     resourceArmDatabricksWorkspaceRead,
+		Update:   resourceArmDatabricksWorkspaceCreateUpdate,
+		Delete:   resourceArmDatabricksWorkspaceDelete,
+		Importer: azure.ImporterValidatingResourceId("workspaces"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -56,7 +56,8 @@ func resourceArmDatabricksWorkspace() *schema.Resource {
 
 func resourceArmDatabricksWorkspaceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).databricksWorkspacesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	subscriptionID := meta.(*ArmClient).subscriptionId
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Databricks Workspace creation.")
@@ -107,14 +108,18 @@ func resourceArmDatabricksWorkspaceCreateUpdate(d *schema.ResourceData, meta int
 
 func resourceArmDatabricksWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).databricksWorkspacesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["workspaces"]
+	name, err := id.PopSegment("workspaces")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resourceGroup, name)
 
@@ -150,7 +155,8 @@ func resourceArmDatabricksWorkspaceRead(d *schema.ResourceData, meta interface{}
 
 func resourceArmDatabricksWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).databricksWorkspacesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -158,7 +164,10 @@ func resourceArmDatabricksWorkspaceDelete(d *schema.ResourceData, meta interface
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["workspaces"]
+	name, err := id.PopSegment("workspaces")
+	if err != nil {
+		return err
+	}
 
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
@@ -7,19 +7,19 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/devtestlabs/mgmt/2016-05-15/dtl"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmDevTestLab() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmDevTestLabCreateUpdate,
-		Read:   resourceArmDevTestLabRead,
-		Update: resourceArmDevTestLabCreateUpdate,
-		Delete: resourceArmDevTestLabDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmDevTestLabCreateUpdate,
+		Read:     resourceArmDevTestLabRead,
+		Update:   resourceArmDevTestLabCreateUpdate,
+		Delete:   resourceArmDevTestLabDelete,
+		Importer: azure.ImporterValidatingResourceId("labs"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -82,7 +82,8 @@ func resourceArmDevTestLab() *schema.Resource {
 
 func resourceArmDevTestLabCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devTestLabsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for DevTest Lab creation")
 
@@ -126,14 +127,18 @@ func resourceArmDevTestLabCreateUpdate(d *schema.ResourceData, meta interface{})
 
 func resourceArmDevTestLabRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devTestLabsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["labs"]
+	name, err := id.PopSegment("labs")
+	if err != nil {
+		return err
+	}
 
 	read, err := client.Get(ctx, resourceGroup, name, "")
 	if err != nil {
@@ -171,14 +176,18 @@ func resourceArmDevTestLabRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmDevTestLabDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devTestLabsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["labs"]
+	name, err := id.PopSegment("labs")
+	if err != nil {
+		return err
+	}
 
 	read, err := client.Get(ctx, resourceGroup, name, "")
 	if err != nil {
@@ -8,19 +8,18 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmDevTestLinuxVirtualMachine() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmDevTestLinuxVirtualMachineCreateUpdate,
-		Read:   resourceArmDevTestLinuxVirtualMachineRead,
-		Update: resourceArmDevTestLinuxVirtualMachineCreateUpdate,
-		Delete: resourceArmDevTestLinuxVirtualMachineDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmDevTestLinuxVirtualMachineCreateUpdate,
+		Read:     resourceArmDevTestLinuxVirtualMachineRead,
+		Update:   resourceArmDevTestLinuxVirtualMachineCreateUpdate,
+		Delete:   resourceArmDevTestLinuxVirtualMachineDelete,
+		Importer: azure.ImporterValidatingResourceId("labs", "virtualmachines"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -132,7 +131,8 @@ func resourceArmDevTestLinuxVirtualMachine() *schema.Resource {
 
 func resourceArmDevTestLinuxVirtualMachineCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devTestVirtualMachinesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for DevTest Linux Virtual Machine creation")
 
@@ -218,15 +218,22 @@ func resourceArmDevTestLinuxVirtualMachineCreateUpdate(d *schema.ResourceData, m
 
 func resourceArmDevTestLinuxVirtualMachineRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devTestVirtualMachinesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	labName := id.Path["labs"]
-	name := id.Path["virtualmachines"]
+	labName, err := id.PopSegment("labs")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("virtualmachines")
+	if err != nil {
+		return err
+	}
 
 	read, err := client.Get(ctx, resourceGroup, labName, name, "")
 	if err != nil {
@@ -271,15 +278,22 @@ func resourceArmDevTestLinuxVirtualMachineRead(d *schema.ResourceData, meta inte
 
 func resourceArmDevTestLinuxVirtualMachineDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devTestVirtualMachinesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	labName := id.Path["labs"]
-	name := id.Path["virtualmachines"]
+	labName, err := id.PopSegment("labs")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("virtualmachines")
+	if err != nil {
+		return err
+	}
 
 	read, err := client.Get(ctx, resourceGroup, labName, name, "")
 	if err != nil {
@@ -7,19 +7,19 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/devtestlabs/mgmt/2016-05-15/dtl"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmDevTestPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmDevTestPolicyCreateUpdate,
-		Read:   resourceArmDevTestPolicyRead,
-		Update: resourceArmDevTestPolicyCreateUpdate,
-		Delete: resourceArmDevTestPolicyDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmDevTestPolicyCreateUpdate,
+		Read:     resourceArmDevTestPolicyRead,
+		Update:   resourceArmDevTestPolicyCreateUpdate,
+		Delete:   resourceArmDevTestPolicyDelete,
+		Importer: azure.ImporterValidatingResourceId("labs", "policysets", "policies"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -88,7 +88,8 @@ func resourceArmDevTestPolicy() *schema.Resource {
 
 func resourceArmDevTestPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devTestPoliciesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for DevTest Policy creation")
 
@@ -136,16 +137,26 @@ func resourceArmDevTestPolicyCreateUpdate(d *schema.ResourceData, meta interface
 
 func resourceArmDevTestPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devTestPoliciesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	labName := id.Path["labs"]
-	policySetName := id.Path["policysets"]
-	name := id.Path["policies"]
+	labName, err := id.PopSegment("labs")
+	if err != nil {
+		return err
+	}
+	policySetName, err := id.PopSegment("policysets")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("policies")
+	if err != nil {
+		return err
+	}
 
 	read, err := client.Get(ctx, resourceGroup, labName, policySetName, name, "")
 	if err != nil {
@@ -177,16 +188,26 @@ func resourceArmDevTestPolicyRead(d *schema.ResourceData, meta interface{}) erro
 
 func resourceArmDevTestPolicyDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devTestPoliciesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	labName := id.Path["labs"]
-	policySetName := id.Path["policysets"]
-	name := id.Path["policies"]
+	labName, err := id.PopSegment("labs")
+	if err != nil {
+		return err
+	}
+	policySetName, err := id.PopSegment("policysets")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("policies")
+	if err != nil {
+		return err
+	}
 
 	read, err := client.Get(ctx, resourceGroup, policySetName, labName, name, "")
 	if err != nil {
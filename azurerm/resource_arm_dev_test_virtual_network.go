@@ -8,19 +8,19 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/devtestlabs/mgmt/2016-05-15/dtl"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmDevTestVirtualNetwork() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmDevTestVirtualNetworkCreateUpdate,
-		Read:   resourceArmDevTestVirtualNetworkRead,
-		Update: resourceArmDevTestVirtualNetworkCreateUpdate,
-		Delete: resourceArmDevTestVirtualNetworkDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmDevTestVirtualNetworkCreateUpdate,
+		Read:     resourceArmDevTestVirtualNetworkRead,
+		Update:   resourceArmDevTestVirtualNetworkCreateUpdate,
+		Delete:   resourceArmDevTestVirtualNetworkDelete,
+		Importer: azure.ImporterValidatingResourceId("labs", "virtualnetworks"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -88,7 +88,8 @@ func resourceArmDevTestVirtualNetwork() *schema.Resource {
 
 func resourceArmDevTestVirtualNetworkCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devTestVirtualNetworksClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for DevTest Virtual Network creation")
 
@@ -136,15 +137,22 @@ func resourceArmDevTestVirtualNetworkCreateUpdate(d *schema.ResourceData, meta i
 
 func resourceArmDevTestVirtualNetworkRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devTestVirtualNetworksClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	labName := id.Path["labs"]
-	name := id.Path["virtualnetworks"]
+	labName, err := id.PopSegment("labs")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("virtualnetworks")
+	if err != nil {
+		return err
+	}
 
 	read, err := client.Get(ctx, resourceGroup, labName, name, "")
 	if err != nil {
@@ -180,15 +188,22 @@ func resourceArmDevTestVirtualNetworkRead(d *schema.ResourceData, meta interface
 
 func resourceArmDevTestVirtualNetworkDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devTestVirtualNetworksClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	labName := id.Path["labs"]
-	name := id.Path["virtualnetworks"]
+	labName, err := id.PopSegment("labs")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("virtualnetworks")
+	if err != nil {
+		return err
+	}
 
 	read, err := client.Get(ctx, resourceGroup, labName, name, "")
 	if err != nil {
@@ -8,19 +8,18 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmDevTestWindowsVirtualMachine() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmDevTestWindowsVirtualMachineCreateUpdate,
-		Read:   resourceArmDevTestWindowsVirtualMachineRead,
-		Update: resourceArmDevTestWindowsVirtualMachineCreateUpdate,
-		Delete: resourceArmDevTestWindowsVirtualMachineDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmDevTestWindowsVirtualMachineCreateUpdate,
+		Read:     resourceArmDevTestWindowsVirtualMachineRead,
+		Update:   resourceArmDevTestWindowsVirtualMachineCreateUpdate,
+		Delete:   resourceArmDevTestWindowsVirtualMachineDelete,
+		Importer: azure.ImporterValidatingResourceId("labs", "virtualmachines"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -124,7 +123,8 @@ func resourceArmDevTestWindowsVirtualMachine() *schema.Resource {
 
 func resourceArmDevTestWindowsVirtualMachineCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devTestVirtualMachinesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for DevTest Windows Virtual Machine creation")
 
@@ -207,15 +207,22 @@ func resourceArmDevTestWindowsVirtualMachineCreateUpdate(d *schema.ResourceData,
 
 func resourceArmDevTestWindowsVirtualMachineRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devTestVirtualMachinesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	labName := id.Path["labs"]
-	name := id.Path["virtualmachines"]
+	labName, err := id.PopSegment("labs")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("virtualmachines")
+	if err != nil {
+		return err
+	}
 
 	read, err := client.Get(ctx, resourceGroup, labName, name, "")
 	if err != nil {
@@ -260,15 +267,22 @@ func resourceArmDevTestWindowsVirtualMachineRead(d *schema.ResourceData, meta in
 
 func resourceArmDevTestWindowsVirtualMachineDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).devTestVirtualMachinesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	labName := id.Path["labs"]
-	name := id.Path["virtualmachines"]
+	labName, err := id.PopSegment("labs")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("virtualmachines")
+	if err != nil {
+		return err
+	}
 
 	read, err := client.Get(ctx, resourceGroup, labName, name, "")
 	if err != nil {
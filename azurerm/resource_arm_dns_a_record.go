@@ -6,18 +6,18 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmDnsARecord() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmDnsARecordCreateOrUpdate,
-		Read:   resourceArmDnsARecordRead,
-		Update: resourceArmDnsARecordCreateOrUpdate,
-		Delete: resourceArmDnsARecordDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmDnsARecordCreateOrUpdate,
+		Read:     resourceArmDnsARecordRead,
+		Update:   resourceArmDnsARecordCreateOrUpdate,
+		Delete:   resourceArmDnsARecordDelete,
+		Importer: azure.ImporterValidatingResourceId("dnszones", "A"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -52,7 +52,8 @@ func resourceArmDnsARecord() *schema.Resource {
 
 func resourceArmDnsARecordCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
 	dnsClient := meta.(*ArmClient).dnsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
@@ -92,7 +93,8 @@ func resourceArmDnsARecordCreateOrUpdate(d *schema.ResourceData, meta interface{
 
 func resourceArmDnsARecordRead(d *schema.ResourceData, meta interface{}) error {
 	dnsClient := meta.(*ArmClient).dnsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -100,8 +102,14 @@ func resourceArmDnsARecordRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["A"]
-	zoneName := id.Path["dnszones"]
+	name, err := id.PopSegment("A")
+	if err != nil {
+		return err
+	}
+	zoneName, err := id.PopSegment("dnszones")
+	if err != nil {
+		return err
+	}
 
 	resp, err := dnsClient.Get(ctx, resGroup, zoneName, name, dns.A)
 	if err != nil {
@@ -127,7 +135,8 @@ func resourceArmDnsARecordRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmDnsARecordDelete(d *schema.ResourceData, meta interface{}) error {
 	dnsClient := meta.(*ArmClient).dnsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -135,8 +144,14 @@ func resourceArmDnsARecordDelete(d *schema.ResourceData, meta interface{}) error
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["A"]
-	zoneName := id.Path["dnszones"]
+	name, err := id.PopSegment("A")
+	if err != nil {
+		return err
+	}
+	zoneName, err := id.PopSegment("dnszones")
+	if err != nil {
+		return err
+	}
 
 	resp, error := dnsClient.Delete(ctx, resGroup, zoneName, name, dns.A, "")
 	if resp.StatusCode != http.StatusOK {
@@ -6,18 +6,18 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmDnsAAAARecord() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmDnsAaaaRecordCreateOrUpdate,
-		Read:   resourceArmDnsAaaaRecordRead,
-		Update: resourceArmDnsAaaaRecordCreateOrUpdate,
-		Delete: resourceArmDnsAaaaRecordDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmDnsAaaaRecordCreateOrUpdate,
+		Read:     resourceArmDnsAaaaRecordRead,
+		Update:   resourceArmDnsAaaaRecordCreateOrUpdate,
+		Delete:   resourceArmDnsAaaaRecordDelete,
+		Importer: azure.ImporterValidatingResourceId("dnszones", "AAAA"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -52,7 +52,8 @@ func resourceArmDnsAAAARecord() *schema.Resource {
 
 func resourceArmDnsAaaaRecordCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dnsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
@@ -92,7 +93,8 @@ func resourceArmDnsAaaaRecordCreateOrUpdate(d *schema.ResourceData, meta interfa
 
 func resourceArmDnsAaaaRecordRead(d *schema.ResourceData, meta interface{}) error {
 	dnsClient := meta.(*ArmClient).dnsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -100,8 +102,14 @@ func resourceArmDnsAaaaRecordRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["AAAA"]
-	zoneName := id.Path["dnszones"]
+	name, err := id.PopSegment("AAAA")
+	if err != nil {
+		return err
+	}
+	zoneName, err := id.PopSegment("dnszones")
+	if err != nil {
+		return err
+	}
 
 	resp, err := dnsClient.Get(ctx, resGroup, zoneName, name, dns.AAAA)
 	if err != nil {
@@ -127,7 +135,8 @@ func resourceArmDnsAaaaRecordRead(d *schema.ResourceData, meta interface{}) erro
 
 func resourceArmDnsAaaaRecordDelete(d *schema.ResourceData, meta interface{}) error {
 	dnsClient := meta.(*ArmClient).dnsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -135,8 +144,14 @@ func resourceArmDnsAaaaRecordDelete(d *schema.ResourceData, meta interface{}) er
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["AAAA"]
-	zoneName := id.Path["dnszones"]
+	name, err := id.PopSegment("AAAA")
+	if err != nil {
+		return err
+	}
+	zoneName, err := id.PopSegment("dnszones")
+	if err != nil {
+		return err
+	}
 
 	resp, error := dnsClient.Delete(ctx, resGroup, zoneName, name, dns.AAAA, "")
 	if resp.StatusCode != http.StatusOK {
@@ -9,18 +9,18 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmDnsCaaRecord() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmDnsCaaRecordCreateOrUpdate,
-		Read:   resourceArmDnsCaaRecordRead,
-		Update: resourceArmDnsCaaRecordCreateOrUpdate,
-		Delete: resourceArmDnsCaaRecordDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmDnsCaaRecordCreateOrUpdate,
+		Read:     resourceArmDnsCaaRecordRead,
+		Update:   resourceArmDnsCaaRecordCreateOrUpdate,
+		Delete:   resourceArmDnsCaaRecordDelete,
+		Importer: azure.ImporterValidatingResourceId("dnszones", "CAA"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -78,7 +78,8 @@ func resourceArmDnsCaaRecord() *schema.Resource {
 
 func resourceArmDnsCaaRecordCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dnsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
@@ -118,7 +119,8 @@ func resourceArmDnsCaaRecordCreateOrUpdate(d *schema.ResourceData, meta interfac
 
 func resourceArmDnsCaaRecordRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dnsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -126,8 +128,14 @@ func resourceArmDnsCaaRecordRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["CAA"]
-	zoneName := id.Path["dnszones"]
+	name, err := id.PopSegment("CAA")
+	if err != nil {
+		return err
+	}
+	zoneName, err := id.PopSegment("dnszones")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resGroup, zoneName, name, dns.CAA)
 	if err != nil {
@@ -153,7 +161,8 @@ func resourceArmDnsCaaRecordRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmDnsCaaRecordDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dnsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -161,8 +170,14 @@ func resourceArmDnsCaaRecordDelete(d *schema.ResourceData, meta interface{}) err
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["CAA"]
-	zoneName := id.Path["dnszones"]
+	name, err := id.PopSegment("CAA")
+	if err != nil {
+		return err
+	}
+	zoneName, err := id.PopSegment("dnszones")
+	if err != nil {
+		return err
+	}
 
 	resp, error := client.Delete(ctx, resGroup, zoneName, name, dns.CAA, "")
 	if resp.StatusCode != http.StatusOK {
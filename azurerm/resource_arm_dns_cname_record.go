@@ -6,18 +6,18 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmDnsCNameRecord() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmDnsCNameRecordCreateOrUpdate,
-		Read:   resourceArmDnsCNameRecordRead,
-		Update: resourceArmDnsCNameRecordCreateOrUpdate,
-		Delete: resourceArmDnsCNameRecordDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmDnsCNameRecordCreateOrUpdate,
+		Read:     resourceArmDnsCNameRecordRead,
+		Update:   resourceArmDnsCNameRecordCreateOrUpdate,
+		Delete:   resourceArmDnsCNameRecordDelete,
+		Importer: azure.ImporterValidatingResourceId("dnszones", "CNAME"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -58,7 +58,8 @@ func resourceArmDnsCNameRecord() *schema.Resource {
 
 func resourceArmDnsCNameRecordCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
 	dnsClient := meta.(*ArmClient).dnsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
@@ -96,7 +97,8 @@ func resourceArmDnsCNameRecordCreateOrUpdate(d *schema.ResourceData, meta interf
 
 func resourceArmDnsCNameRecordRead(d *schema.ResourceData, meta interface{}) error {
 	dnsClient := meta.(*ArmClient).dnsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -104,8 +106,14 @@ func resourceArmDnsCNameRecordRead(d *schema.ResourceData, meta interface{}) err
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["CNAME"]
-	zoneName := id.Path["dnszones"]
+	name, err := id.PopSegment("CNAME")
+	if err != nil {
+		return err
+	}
+	zoneName, err := id.PopSegment("dnszones")
+	if err != nil {
+		return err
+	}
 
 	resp, err := dnsClient.Get(ctx, resGroup, zoneName, name, dns.CNAME)
 	if err != nil {
@@ -134,7 +142,8 @@ func resourceArmDnsCNameRecordRead(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmDnsCNameRecordDelete(d *schema.ResourceData, meta interface{}) error {
 	dnsClient := meta.(*ArmClient).dnsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -142,8 +151,14 @@ func resourceArmDnsCNameRecordDelete(d *schema.ResourceData, meta interface{}) e
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["CNAME"]
-	zoneName := id.Path["dnszones"]
+	name, err := id.PopSegment("CNAME")
+	if err != nil {
+		return err
+	}
+	zoneName, err := id.PopSegment("dnszones")
+	if err != nil {
+		return err
+	}
 
 	resp, error := dnsClient.Delete(ctx, resGroup, zoneName, name, dns.CNAME, "")
 	if resp.StatusCode != http.StatusOK {
@@ -9,18 +9,18 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmDnsMxRecord() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmDnsMxRecordCreateOrUpdate,
-		Read:   resourceArmDnsMxRecordRead,
-		Update: resourceArmDnsMxRecordCreateOrUpdate,
-		Delete: resourceArmDnsMxRecordDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmDnsMxRecordCreateOrUpdate,
+		Read:     resourceArmDnsMxRecordRead,
+		Update:   resourceArmDnsMxRecordCreateOrUpdate,
+		Delete:   resourceArmDnsMxRecordDelete,
+		Importer: azure.ImporterValidatingResourceId("dnszones", "MX"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -68,7 +68,8 @@ func resourceArmDnsMxRecord() *schema.Resource {
 
 func resourceArmDnsMxRecordCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dnsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
@@ -107,7 +108,8 @@ func resourceArmDnsMxRecordCreateOrUpdate(d *schema.ResourceData, meta interface
 
 func resourceArmDnsMxRecordRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dnsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -115,8 +117,14 @@ func resourceArmDnsMxRecordRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["MX"]
-	zoneName := id.Path["dnszones"]
+	name, err := id.PopSegment("MX")
+	if err != nil {
+		return err
+	}
+	zoneName, err := id.PopSegment("dnszones")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resGroup, zoneName, name, dns.MX)
 	if err != nil {
@@ -142,7 +150,8 @@ func resourceArmDnsMxRecordRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmDnsMxRecordDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dnsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -150,8 +159,14 @@ func resourceArmDnsMxRecordDelete(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["MX"]
-	zoneName := id.Path["dnszones"]
+	name, err := id.PopSegment("MX")
+	if err != nil {
+		return err
+	}
+	zoneName, err := id.PopSegment("dnszones")
+	if err != nil {
+		return err
+	}
 
 	resp, error := client.Delete(ctx, resGroup, zoneName, name, dns.MX, "")
 	if resp.StatusCode != http.StatusOK {
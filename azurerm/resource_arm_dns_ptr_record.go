@@ -6,18 +6,18 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmDnsPtrRecord() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmDnsPtrRecordCreateOrUpdate,
-		Read:   resourceArmDnsPtrRecordRead,
-		Update: resourceArmDnsPtrRecordCreateOrUpdate,
-		Delete: resourceArmDnsPtrRecordDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmDnsPtrRecordCreateOrUpdate,
+		Read:     resourceArmDnsPtrRecordRead,
+		Update:   resourceArmDnsPtrRecordCreateOrUpdate,
+		Delete:   resourceArmDnsPtrRecordDelete,
+		Importer: azure.ImporterValidatingResourceId("dnszones", "PTR"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -52,7 +52,8 @@ func resourceArmDnsPtrRecord() *schema.Resource {
 
 func resourceArmDnsPtrRecordCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dnsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
@@ -92,7 +93,8 @@ func resourceArmDnsPtrRecordCreateOrUpdate(d *schema.ResourceData, meta interfac
 func resourceArmDnsPtrRecordRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
 	dnsClient := client.dnsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -100,8 +102,14 @@ func resourceArmDnsPtrRecordRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["PTR"]
-	zoneName := id.Path["dnszones"]
+	name, err := id.PopSegment("PTR")
+	if err != nil {
+		return err
+	}
+	zoneName, err := id.PopSegment("dnszones")
+	if err != nil {
+		return err
+	}
 
 	resp, err := dnsClient.Get(ctx, resGroup, zoneName, name, dns.PTR)
 	if err != nil {
@@ -130,7 +138,8 @@ func resourceArmDnsPtrRecordRead(d *schema.ResourceData, meta interface{}) error
 func resourceArmDnsPtrRecordDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
 	dnsClient := client.dnsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -138,8 +147,14 @@ func resourceArmDnsPtrRecordDelete(d *schema.ResourceData, meta interface{}) err
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["PTR"]
-	zoneName := id.Path["dnszones"]
+	name, err := id.PopSegment("PTR")
+	if err != nil {
+		return err
+	}
+	zoneName, err := id.PopSegment("dnszones")
+	if err != nil {
+		return err
+	}
 
 	resp, err := dnsClient.Delete(ctx, resGroup, zoneName, name, dns.PTR, "")
 	if err != nil {
@@ -6,19 +6,19 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmDnsZone() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmDnsZoneCreateUpdate,
-		Read:   resourceArmDnsZoneRead,
-		Update: resourceArmDnsZoneCreateUpdate,
-		Delete: resourceArmDnsZoneDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmDnsZoneCreateUpdate,
+		Read:     resourceArmDnsZoneRead,
+		Update:   resourceArmDnsZoneCreateUpdate,
+		Delete:   resourceArmDnsZoneDelete,
+		Importer: azure.ImporterValidatingResourceId("dnszones"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -75,7 +75,8 @@ func resourceArmDnsZone() *schema.Resource {
 
 func resourceArmDnsZoneCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).zonesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
@@ -114,7 +115,8 @@ func resourceArmDnsZoneCreateUpdate(d *schema.ResourceData, meta interface{}) er
 
 func resourceArmDnsZoneRead(d *schema.ResourceData, meta interface{}) error {
 	zonesClient := meta.(*ArmClient).zonesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -122,7 +124,10 @@ func resourceArmDnsZoneRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["dnszones"]
+	name, err := id.PopSegment("dnszones")
+	if err != nil {
+		return err
+	}
 
 	resp, err := zonesClient.Get(ctx, resGroup, name)
 	if err != nil {
@@ -161,7 +166,8 @@ func resourceArmDnsZoneRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmDnsZoneDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).zonesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -169,7 +175,10 @@ func resourceArmDnsZoneDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["dnszones"]
+	name, err := id.PopSegment("dnszones")
+	if err != nil {
+		return err
+	}
 
 	etag := ""
 	future, err := client.Delete(ctx, resGroup, name, etag)
@@ -6,19 +6,19 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/eventgrid/mgmt/2018-01-01/eventgrid"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmEventGridTopic() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmEventGridTopicCreateUpdate,
-		Read:   resourceArmEventGridTopicRead,
-		Update: resourceArmEventGridTopicCreateUpdate,
-		Delete: resourceArmEventGridTopicDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmEventGridTopicCreateUpdate,
+		Read:     resourceArmEventGridTopicRead,
+		Update:   resourceArmEventGridTopicCreateUpdate,
+		Delete:   resourceArmEventGridTopicDelete,
+		Importer: azure.ImporterValidatingResourceId("topics"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -55,7 +55,8 @@ func resourceArmEventGridTopic() *schema.Resource {
 
 func resourceArmEventGridTopicCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).eventGridTopicsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	location := azureRMNormalizeLocation(d.Get("location").(string))
@@ -95,14 +96,18 @@ func resourceArmEventGridTopicCreateUpdate(d *schema.ResourceData, meta interfac
 
 func resourceArmEventGridTopicRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).eventGridTopicsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["topics"]
+	name, err := id.PopSegment("topics")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -140,14 +145,18 @@ func resourceArmEventGridTopicRead(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmEventGridTopicDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).eventGridTopicsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["topics"]
+	name, err := id.PopSegment("topics")
+	if err != nil {
+		return err
+	}
 
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
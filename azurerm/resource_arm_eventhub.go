@@ -10,18 +10,17 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmEventHub() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmEventHubCreate,
-		Read:   resourceArmEventHubRead,
-		Update: resourceArmEventHubCreate,
-		Delete: resourceArmEventHubDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmEventHubCreate,
+		Read:     resourceArmEventHubRead,
+		Update:   resourceArmEventHubCreate,
+		Delete:   resourceArmEventHubDelete,
+		Importer: azure.ImporterValidatingResourceId("namespaces", "eventhubs"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -134,7 +133,8 @@ func resourceArmEventHub() *schema.Resource {
 
 func resourceArmEventHubCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).eventHubClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for Azure ARM EventHub creation.")
 
 	name := d.Get("name").(string)
@@ -180,7 +180,8 @@ func resourceArmEventHubCreate(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmEventHubRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).eventHubClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -188,8 +189,14 @@ func resourceArmEventHubRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	resourceGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
-	name := id.Path["eventhubs"]
+	namespaceName, err := id.PopSegment("namespaces")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("eventhubs")
+	if err != nil {
+		return err
+	}
 	resp, err := client.Get(ctx, resourceGroup, namespaceName, name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
@@ -219,15 +226,22 @@ func resourceArmEventHubRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmEventHubDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).eventHubClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
-	name := id.Path["eventhubs"]
+	namespaceName, err := id.PopSegment("namespaces")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("eventhubs")
+	if err != nil {
+		return err
+	}
 	resp, err := client.Delete(ctx, resourceGroup, namespaceName, name)
 
 	if err != nil {
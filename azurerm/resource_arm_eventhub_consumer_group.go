@@ -8,18 +8,17 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmEventHubConsumerGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmEventHubConsumerGroupCreateUpdate,
-		Read:   resourceArmEventHubConsumerGroupRead,
-		Update: resourceArmEventHubConsumerGroupCreateUpdate,
-		Delete: resourceArmEventHubConsumerGroupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmEventHubConsumerGroupCreateUpdate,
+		Read:     resourceArmEventHubConsumerGroupRead,
+		Update:   resourceArmEventHubConsumerGroupCreateUpdate,
+		Delete:   resourceArmEventHubConsumerGroupDelete,
+		Importer: azure.ImporterValidatingResourceId("namespaces", "eventhubs", "consumergroups"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -58,7 +57,8 @@ func resourceArmEventHubConsumerGroup() *schema.Resource {
 
 func resourceArmEventHubConsumerGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).eventHubConsumerGroupClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM EventHub Consumer Group creation.")
 
 	name := d.Get("name").(string)
@@ -96,16 +96,26 @@ func resourceArmEventHubConsumerGroupCreateUpdate(d *schema.ResourceData, meta i
 
 func resourceArmEventHubConsumerGroupRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).eventHubConsumerGroupClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
-	eventHubName := id.Path["eventhubs"]
-	name := id.Path["consumergroups"]
+	namespaceName, err := id.PopSegment("namespaces")
+	if err != nil {
+		return err
+	}
+	eventHubName, err := id.PopSegment("eventhubs")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("consumergroups")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resGroup, namespaceName, eventHubName, name)
 	if err != nil {
@@ -127,16 +137,26 @@ func resourceArmEventHubConsumerGroupRead(d *schema.ResourceData, meta interface
 
 func resourceArmEventHubConsumerGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).eventHubConsumerGroupClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
-	eventHubName := id.Path["eventhubs"]
-	name := id.Path["consumergroups"]
+	namespaceName, err := id.PopSegment("namespaces")
+	if err != nil {
+		return err
+	}
+	eventHubName, err := id.PopSegment("eventhubs")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("consumergroups")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Delete(ctx, resGroup, namespaceName, eventHubName, name)
 
@@ -8,6 +8,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/eventhub/mgmt/2017-04-01/eventhub"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -18,9 +19,7 @@ func resourceArmEventHubNamespaceAuthorizationRule() *schema.Resource {
 		Update: resourceArmEventHubNamespaceAuthorizationRuleCreateUpdate,
 		Delete: resourceArmEventHubNamespaceAuthorizationRuleDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: azure.ImporterValidatingResourceId("namespaces", "AuthorizationRules"),
 
 		Schema: azure.EventHubAuthorizationRuleSchemaFrom(map[string]*schema.Schema{
 			"name": {
@@ -48,7 +47,8 @@ func resourceArmEventHubNamespaceAuthorizationRule() *schema.Resource {
 
 func resourceArmEventHubNamespaceAuthorizationRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).eventHubNamespacesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM EventHub Namespace Authorization Rule creation.")
 
@@ -83,16 +83,23 @@ func resourceArmEventHubNamespaceAuthorizationRuleCreateUpdate(d *schema.Resourc
 
 func resourceArmEventHubNamespaceAuthorizationRuleRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).eventHubNamespacesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	name := id.Path["AuthorizationRules"] //this is different then eventhub where its authorizationRules
+	name, err := id.PopSegment("AuthorizationRules")
+	if err != nil {
+		return err
+	}
 	resourceGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
+	namespaceName, err := id.PopSegment("namespaces")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.GetAuthorizationRule(ctx, resourceGroup, namespaceName, name)
 	if err != nil {
@@ -129,16 +136,23 @@ func resourceArmEventHubNamespaceAuthorizationRuleRead(d *schema.ResourceData, m
 
 func resourceArmEventHubNamespaceAuthorizationRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	eventhubClient := meta.(*ArmClient).eventHubNamespacesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	name := id.Path["AuthorizationRules"] //this is different then eventhub where its authorizationRules
+	name, err := id.PopSegment("AuthorizationRules")
+	if err != nil {
+		return err
+	}
 	resourceGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
+	namespaceName, err := id.PopSegment("namespaces")
+	if err != nil {
+		return err
+	}
 
 	resp, err := eventhubClient.DeleteAuthorizationRule(ctx, resourceGroup, namespaceName, name)
 
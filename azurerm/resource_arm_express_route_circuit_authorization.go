@@ -5,18 +5,18 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmExpressRouteCircuitAuthorization() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmExpressRouteCircuitAuthorizationCreateUpdate,
-		Read:   resourceArmExpressRouteCircuitAuthorizationRead,
-		Delete: resourceArmExpressRouteCircuitAuthorizationDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmExpressRouteCircuitAuthorizationCreateUpdate,
+		Read:     resourceArmExpressRouteCircuitAuthorizationRead,
+		Delete:   resourceArmExpressRouteCircuitAuthorizationDelete,
+		Importer: azure.ImporterValidatingResourceId("expressRouteCircuits", "authorizations"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -49,7 +49,8 @@ func resourceArmExpressRouteCircuitAuthorization() *schema.Resource {
 
 func resourceArmExpressRouteCircuitAuthorizationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).expressRouteAuthsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -84,7 +85,8 @@ func resourceArmExpressRouteCircuitAuthorizationCreateUpdate(d *schema.ResourceD
 
 func resourceArmExpressRouteCircuitAuthorizationRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).expressRouteAuthsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -92,8 +94,14 @@ func resourceArmExpressRouteCircuitAuthorizationRead(d *schema.ResourceData, met
 	}
 
 	resourceGroup := id.ResourceGroup
-	circuitName := id.Path["expressRouteCircuits"]
-	name := id.Path["authorizations"]
+	circuitName, err := id.PopSegment("expressRouteCircuits")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("authorizations")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resourceGroup, circuitName, name)
 	if err != nil {
@@ -118,7 +126,8 @@ func resourceArmExpressRouteCircuitAuthorizationRead(d *schema.ResourceData, met
 
 func resourceArmExpressRouteCircuitAuthorizationDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).expressRouteAuthsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -126,8 +135,14 @@ func resourceArmExpressRouteCircuitAuthorizationDelete(d *schema.ResourceData, m
 	}
 
 	resourceGroup := id.ResourceGroup
-	circuitName := id.Path["expressRouteCircuits"]
-	name := id.Path["authorizations"]
+	circuitName, err := id.PopSegment("expressRouteCircuits")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("authorizations")
+	if err != nil {
+		return err
+	}
 
 	azureRMLockByName(circuitName, expressRouteCircuitResourceName)
 	defer azureRMUnlockByName(circuitName, expressRouteCircuitResourceName)
@@ -8,19 +8,19 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmExpressRouteCircuitPeering() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmExpressRouteCircuitPeeringCreateUpdate,
-		Read:   resourceArmExpressRouteCircuitPeeringRead,
-		Update: resourceArmExpressRouteCircuitPeeringCreateUpdate,
-		Delete: resourceArmExpressRouteCircuitPeeringDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmExpressRouteCircuitPeeringCreateUpdate,
+		Read:     resourceArmExpressRouteCircuitPeeringRead,
+		Update:   resourceArmExpressRouteCircuitPeeringCreateUpdate,
+		Delete:   resourceArmExpressRouteCircuitPeeringDelete,
+		Importer: azure.ImporterValidatingResourceId("expressRouteCircuits", "peerings"),
 
 		Schema: map[string]*schema.Schema{
 			"peering_type": {
@@ -104,7 +104,8 @@ func resourceArmExpressRouteCircuitPeering() *schema.Resource {
 
 func resourceArmExpressRouteCircuitPeeringCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).expressRoutePeeringsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Express Route Peering create/update.")
 
@@ -166,15 +167,22 @@ func resourceArmExpressRouteCircuitPeeringCreateUpdate(d *schema.ResourceData, m
 
 func resourceArmExpressRouteCircuitPeeringRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).expressRoutePeeringsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	circuitName := id.Path["expressRouteCircuits"]
-	peeringType := id.Path["peerings"]
+	circuitName, err := id.PopSegment("expressRouteCircuits")
+	if err != nil {
+		return err
+	}
+	peeringType, err := id.PopSegment("peerings")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resourceGroup, circuitName, peeringType)
 	if err != nil {
@@ -209,7 +217,8 @@ func resourceArmExpressRouteCircuitPeeringRead(d *schema.ResourceData, meta inte
 
 func resourceArmExpressRouteCircuitPeeringDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).expressRoutePeeringsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -217,8 +226,14 @@ func resourceArmExpressRouteCircuitPeeringDelete(d *schema.ResourceData, meta in
 	}
 
 	resourceGroup := id.ResourceGroup
-	circuitName := id.Path["expressRouteCircuits"]
-	peeringType := id.Path["peerings"]
+	circuitName, err := id.PopSegment("expressRouteCircuits")
+	if err != nil {
+		return err
+	}
+	peeringType, err := id.PopSegment("peerings")
+	if err != nil {
+		return err
+	}
 
 	azureRMLockByName(circuitName, expressRouteCircuitResourceName)
 	defer azureRMUnlockByName(circuitName, expressRouteCircuitResourceName)
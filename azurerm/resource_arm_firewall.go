@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -16,13 +17,11 @@ var azureFirewallResourceName = "azurerm_firewall"
 
 func resourceArmFirewall() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmFirewallCreateUpdate,
-		Read:   resourceArmFirewallRead,
-		Update: resourceArmFirewallCreateUpdate,
-		Delete: resourceArmFirewallDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmFirewallCreateUpdate,
+		Read:     resourceArmFirewallRead,
+		Update:   resourceArmFirewallCreateUpdate,
+		Delete:   resourceArmFirewallDelete,
+		Importer: azure.ImporterValidatingResourceId("azureFirewalls"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -72,7 +71,8 @@ func resourceArmFirewall() *schema.Resource {
 
 func resourceArmFirewallCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).azureFirewallsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Azure Firewall creation")
 
@@ -102,6 +102,19 @@ func resourceArmFirewallCreateUpdate(d *schema.ResourceData, meta interface{}) e
 		},
 	}
 
+	if !d.IsNewResource() {
+		// the Rule Collections are managed via their own resources, so need to be retained when updating the Firewall
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error retrieving existing Azure Firewall %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if props := existing.AzureFirewallPropertiesFormat; props != nil {
+			parameters.AzureFirewallPropertiesFormat.ApplicationRuleCollections = props.ApplicationRuleCollections
+			parameters.AzureFirewallPropertiesFormat.NetworkRuleCollections = props.NetworkRuleCollections
+		}
+	}
+
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
 	if err != nil {
 		return fmt.Errorf("Error creating/updating Azure Firewall %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -128,14 +141,18 @@ func resourceArmFirewallCreateUpdate(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmFirewallRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).azureFirewallsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["azureFirewalls"]
+	name, err := id.PopSegment("azureFirewalls")
+	if err != nil {
+		return err
+	}
 
 	read, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -168,14 +185,18 @@ func resourceArmFirewallRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmFirewallDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).azureFirewallsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["azureFirewalls"]
+	name, err := id.PopSegment("azureFirewalls")
+	if err != nil {
+		return err
+	}
 
 	read, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -0,0 +1,449 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmFirewallApplicationRuleCollection() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceArmFirewallApplicationRuleCollectionCreateUpdate,
+		Read:     resourceArmFirewallApplicationRuleCollectionRead,
+		Update:   resourceArmFirewallApplicationRuleCollectionCreateUpdate,
+		Delete:   resourceArmFirewallApplicationRuleCollectionDelete,
+		Importer: azure.ImporterValidatingResourceId("azureFirewalls", "applicationRuleCollections"),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureFirewallName,
+			},
+
+			"azure_firewall_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureFirewallName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(100, 65000),
+			},
+
+			"action": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.AzureFirewallRCActionTypeAllow),
+					string(network.AzureFirewallRCActionTypeDeny),
+				}, false),
+			},
+
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"source_addresses": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"target_fqdns": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"protocol": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"port": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(0, 64000),
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(network.AzureFirewallApplicationRuleProtocolTypeHTTP),
+											string(network.AzureFirewallApplicationRuleProtocolTypeHTTPS),
+										}, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmFirewallApplicationRuleCollectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).azureFirewallsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	firewallName := d.Get("azure_firewall_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	azureRMLockByName(firewallName, azureFirewallResourceName)
+	defer azureRMUnlockByName(firewallName, azureFirewallResourceName)
+
+	firewall, err := client.Get(ctx, resourceGroup, firewallName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Firewall %q (Resource Group %q): %+v", firewallName, resourceGroup, err)
+	}
+
+	if firewall.AzureFirewallPropertiesFormat == nil {
+		return fmt.Errorf("Error expanding Firewall %q (Resource Group %q): `properties` was nil.", firewallName, resourceGroup)
+	}
+	props := *firewall.AzureFirewallPropertiesFormat
+
+	if props.ApplicationRuleCollections == nil {
+		return fmt.Errorf("Error expanding Firewall %q (Resource Group %q): `properties.ApplicationRuleCollections` was nil.", firewallName, resourceGroup)
+	}
+	ruleCollections := *props.ApplicationRuleCollections
+
+	ipConfigurations, err := azure.FirewallFixIPConfiguration(props.IPConfigurations)
+	if err != nil {
+		return fmt.Errorf("Error fixing IP Configurations for Firewall %q (Resource Group %q): %+v", firewallName, resourceGroup, err)
+	}
+	firewall.AzureFirewallPropertiesFormat.IPConfigurations = ipConfigurations
+
+	applicationRules := expandArmFirewallApplicationRules(d.Get("rule").([]interface{}))
+	priority := d.Get("priority").(int)
+	newRuleCollection := network.AzureFirewallApplicationRuleCollection{
+		Name: utils.String(name),
+		AzureFirewallApplicationRuleCollectionPropertiesFormat: &network.AzureFirewallApplicationRuleCollectionPropertiesFormat{
+			Action: &network.AzureFirewallRCAction{
+				Type: network.AzureFirewallRCActionType(d.Get("action").(string)),
+			},
+			Priority: utils.Int32(int32(priority)),
+			Rules:    &applicationRules,
+		},
+	}
+
+	if !d.IsNewResource() {
+		index := -1
+		if ruleCollections != nil {
+			for i, v := range ruleCollections {
+				if v.Name == nil {
+					continue
+				}
+
+				if *v.Name == name {
+					index = i
+					break
+				}
+			}
+		}
+
+		if index == -1 {
+			return fmt.Errorf("Error locating Application Rule Collection %q (Firewall %q / Resource Group %q)", name, firewallName, resourceGroup)
+		}
+
+		ruleCollections[index] = newRuleCollection
+	} else {
+		ruleCollections = append(ruleCollections, newRuleCollection)
+	}
+
+	firewall.AzureFirewallPropertiesFormat.ApplicationRuleCollections = &ruleCollections
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, firewallName, firewall)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Application Rule Collection %q in Firewall %q (Resource Group %q): %+v", name, firewallName, resourceGroup, err)
+	}
+
+	err = future.WaitForCompletionRef(ctx, client.Client)
+	if err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Application Rule Collection %q of Firewall %q (Resource Group %q): %+v", name, firewallName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, firewallName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Firewall %q (Resource Group %q): %+v", firewallName, resourceGroup, err)
+	}
+
+	var collectionID string
+	if props := read.AzureFirewallPropertiesFormat; props != nil {
+		if collections := props.ApplicationRuleCollections; collections != nil {
+			for _, collection := range *collections {
+				if collection.Name == nil {
+					continue
+				}
+
+				if *collection.Name == name {
+					collectionID = *collection.ID
+					break
+				}
+			}
+		}
+	}
+
+	if collectionID == "" {
+		return fmt.Errorf("Cannot find ID for Application Rule Collection %q (Azure Firewall %q / Resource Group %q)", name, firewallName, resourceGroup)
+	}
+	d.SetId(collectionID)
+
+	return resourceArmFirewallApplicationRuleCollectionRead(d, meta)
+}
+
+func resourceArmFirewallApplicationRuleCollectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).azureFirewallsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	firewallName, err := id.PopSegment("azureFirewalls")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("applicationRuleCollections")
+	if err != nil {
+		return err
+	}
+
+	read, err := client.Get(ctx, resourceGroup, firewallName)
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			log.Printf("[DEBUG] Azure Firewall %q (Resource Group %q) was not found - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Azure Firewall %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.AzureFirewallPropertiesFormat == nil {
+		return fmt.Errorf("Error retrieving Application Rule Collection %q (Firewall %q / Resource Group %q): `props` was nil", name, firewallName, resourceGroup)
+	}
+	props := *read.AzureFirewallPropertiesFormat
+
+	if props.ApplicationRuleCollections == nil {
+		return fmt.Errorf("Error retrieving Application Rule Collection %q (Firewall %q / Resource Group %q): `props.ApplicationRuleCollections` was nil", name, firewallName, resourceGroup)
+	}
+
+	var rule *network.AzureFirewallApplicationRuleCollection
+	for _, r := range *props.ApplicationRuleCollections {
+		if r.Name == nil {
+			continue
+		}
+
+		if *r.Name == name {
+			rule = &r
+			break
+		}
+	}
+
+	if rule == nil {
+		log.Printf("[DEBUG] Application Rule Collection %q was not found on Firewall %q (Resource Group %q) - removing from state!", name, firewallName, resourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", rule.Name)
+	d.Set("azure_firewall_name", firewallName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := rule.AzureFirewallApplicationRuleCollectionPropertiesFormat; props != nil {
+		if action := props.Action; action != nil {
+			d.Set("action", string(action.Type))
+		}
+
+		if priority := props.Priority; priority != nil {
+			d.Set("priority", int(*priority))
+		}
+
+		flattenedRules := flattenFirewallApplicationRuleCollectionRules(props.Rules)
+		if err := d.Set("rule", flattenedRules); err != nil {
+			return fmt.Errorf("Error setting `rule`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmFirewallApplicationRuleCollectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).azureFirewallsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	firewallName, err := id.PopSegment("azureFirewalls")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("applicationRuleCollections")
+	if err != nil {
+		return err
+	}
+
+	azureRMLockByName(firewallName, azureFirewallResourceName)
+	defer azureRMUnlockByName(firewallName, azureFirewallResourceName)
+
+	firewall, err := client.Get(ctx, resourceGroup, firewallName)
+	if err != nil {
+		if utils.ResponseWasNotFound(firewall.Response) {
+			// assume deleted
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Azure Firewall %q (Resource Group %q): %+v", firewallName, resourceGroup, err)
+	}
+
+	props := firewall.AzureFirewallPropertiesFormat
+	if props == nil {
+		return fmt.Errorf("Error retrieving Application Rule Collection %q (Firewall %q / Resource Group %q): `props` was nil", name, firewallName, resourceGroup)
+	}
+	if props.ApplicationRuleCollections == nil {
+		return fmt.Errorf("Error retrieving Application Rule Collection %q (Firewall %q / Resource Group %q): `props.ApplicationRuleCollections` was nil", name, firewallName, resourceGroup)
+	}
+
+	applicationRules := make([]network.AzureFirewallApplicationRuleCollection, 0)
+	for _, rule := range *props.ApplicationRuleCollections {
+		if rule.Name == nil {
+			continue
+		}
+
+		if *rule.Name != name {
+			applicationRules = append(applicationRules, rule)
+		}
+	}
+	props.ApplicationRuleCollections = &applicationRules
+
+	ipConfigs, err := azure.FirewallFixIPConfiguration(props.IPConfigurations)
+	if err != nil {
+		return fmt.Errorf("Error fixing IP Configuration for Firewall %q (Resource Group %q): %+v", firewallName, resourceGroup, err)
+	}
+	props.IPConfigurations = ipConfigs
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, firewallName, firewall)
+	if err != nil {
+		return fmt.Errorf("Error deleting Application Rule Collection %q from Firewall %q (Resource Group %q): %+v", name, firewallName, resourceGroup, err)
+	}
+
+	err = future.WaitForCompletionRef(ctx, client.Client)
+	if err != nil {
+		return fmt.Errorf("Error waiting for deletion of Application Rule Collection %q from Firewall %q (Resource Group %q): %+v", name, firewallName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandArmFirewallApplicationRules(inputs []interface{}) []network.AzureFirewallApplicationRule {
+	outputs := make([]network.AzureFirewallApplicationRule, 0)
+
+	for _, input := range inputs {
+		rule := input.(map[string]interface{})
+
+		name := rule["name"].(string)
+		description := rule["description"].(string)
+
+		sourceAddresses := make([]string, 0)
+		for _, v := range rule["source_addresses"].(*schema.Set).List() {
+			sourceAddresses = append(sourceAddresses, v.(string))
+		}
+
+		targetFqdns := make([]string, 0)
+		for _, v := range rule["target_fqdns"].(*schema.Set).List() {
+			targetFqdns = append(targetFqdns, v.(string))
+		}
+
+		protocols := make([]network.AzureFirewallApplicationRuleProtocol, 0)
+		for _, v := range rule["protocol"].([]interface{}) {
+			protocol := v.(map[string]interface{})
+			protocols = append(protocols, network.AzureFirewallApplicationRuleProtocol{
+				ProtocolType: network.AzureFirewallApplicationRuleProtocolType(protocol["type"].(string)),
+				Port:         utils.Int32(int32(protocol["port"].(int))),
+			})
+		}
+
+		outputs = append(outputs, network.AzureFirewallApplicationRule{
+			Name:            utils.String(name),
+			Description:     utils.String(description),
+			SourceAddresses: &sourceAddresses,
+			TargetUrls:      &targetFqdns,
+			Protocols:       &protocols,
+		})
+	}
+
+	return outputs
+}
+
+func flattenFirewallApplicationRuleCollectionRules(rules *[]network.AzureFirewallApplicationRule) []map[string]interface{} {
+	outputs := make([]map[string]interface{}, 0)
+	if rules == nil {
+		return outputs
+	}
+
+	for _, rule := range *rules {
+		output := make(map[string]interface{})
+		if rule.Name != nil {
+			output["name"] = *rule.Name
+		}
+		if rule.Description != nil {
+			output["description"] = *rule.Description
+		}
+		if rule.SourceAddresses != nil {
+			output["source_addresses"] = sliceToSet(*rule.SourceAddresses)
+		}
+		if rule.TargetUrls != nil {
+			output["target_fqdns"] = sliceToSet(*rule.TargetUrls)
+		}
+
+		protocols := make([]map[string]interface{}, 0)
+		if rule.Protocols != nil {
+			for _, protocol := range *rule.Protocols {
+				p := make(map[string]interface{})
+				p["type"] = string(protocol.ProtocolType)
+				if port := protocol.Port; port != nil {
+					p["port"] = int(*port)
+				}
+				protocols = append(protocols, p)
+			}
+		}
+		output["protocol"] = protocols
+		outputs = append(outputs, output)
+	}
+	return outputs
+}
@@ -8,18 +8,17 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmFirewallNetworkRuleCollection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmFirewallNetworkRuleCollectionCreateUpdate,
-		Read:   resourceArmFirewallNetworkRuleCollectionRead,
-		Update: resourceArmFirewallNetworkRuleCollectionCreateUpdate,
-		Delete: resourceArmFirewallNetworkRuleCollectionDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmFirewallNetworkRuleCollectionCreateUpdate,
+		Read:     resourceArmFirewallNetworkRuleCollectionRead,
+		Update:   resourceArmFirewallNetworkRuleCollectionCreateUpdate,
+		Delete:   resourceArmFirewallNetworkRuleCollectionDelete,
+		Importer: azure.ImporterValidatingResourceId("azureFirewalls", "networkRuleCollections"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -109,7 +108,8 @@ func resourceArmFirewallNetworkRuleCollection() *schema.Resource {
 
 func resourceArmFirewallNetworkRuleCollectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).azureFirewallsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	firewallName := d.Get("azure_firewall_name").(string)
@@ -219,7 +219,8 @@ func resourceArmFirewallNetworkRuleCollectionCreateUpdate(d *schema.ResourceData
 
 func resourceArmFirewallNetworkRuleCollectionRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).azureFirewallsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -227,8 +228,14 @@ func resourceArmFirewallNetworkRuleCollectionRead(d *schema.ResourceData, meta i
 	}
 
 	resourceGroup := id.ResourceGroup
-	firewallName := id.Path["azureFirewalls"]
-	name := id.Path["networkRuleCollections"]
+	firewallName, err := id.PopSegment("azureFirewalls")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("networkRuleCollections")
+	if err != nil {
+		return err
+	}
 
 	read, err := client.Get(ctx, resourceGroup, firewallName)
 	if err != nil {
@@ -291,7 +298,8 @@ func resourceArmFirewallNetworkRuleCollectionRead(d *schema.ResourceData, meta i
 
 func resourceArmFirewallNetworkRuleCollectionDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).azureFirewallsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -299,8 +307,14 @@ func resourceArmFirewallNetworkRuleCollectionDelete(d *schema.ResourceData, meta
 	}
 
 	resourceGroup := id.ResourceGroup
-	firewallName := id.Path["azureFirewalls"]
-	name := id.Path["networkRuleCollections"]
+	firewallName, err := id.PopSegment("azureFirewalls")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("networkRuleCollections")
+	if err != nil {
+		return err
+	}
 
 	azureRMLockByName(firewallName, azureFirewallResourceName)
 	defer azureRMUnlockByName(firewallName, azureFirewallResourceName)
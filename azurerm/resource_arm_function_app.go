@@ -9,6 +9,8 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -16,13 +18,11 @@ import (
 // So this resource will reuse most of the App Service code, but remove the configurations which are not applicable for Function App.
 func resourceArmFunctionApp() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmFunctionAppCreate,
-		Read:   resourceArmFunctionAppRead,
-		Update: resourceArmFunctionAppUpdate,
-		Delete: resourceArmFunctionAppDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmFunctionAppCreate,
+		Read:     resourceArmFunctionAppRead,
+		Update:   resourceArmFunctionAppUpdate,
+		Delete:   resourceArmFunctionAppDelete,
+		Importer: azure.ImporterValidatingResourceId("sites"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -54,6 +54,19 @@ func resourceArmFunctionApp() *schema.Resource {
 				Default:  "~1",
 			},
 
+			"runtime": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"dotnet",
+					"java",
+					"node",
+					"powershell",
+					"python",
+				}, false),
+			},
+
 			"storage_connection_string": {
 				Type:      schema.TypeString,
 				Required:  true,
@@ -176,6 +189,38 @@ func resourceArmFunctionApp() *schema.Resource {
 							Optional: true,
 							Default:  false,
 						},
+						"pre_warmed_instance_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(0, 20),
+						},
+					},
+				},
+			},
+
+			"sticky_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"app_setting_names": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+						"connection_string_names": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
 					},
 				},
 			},
@@ -204,7 +249,8 @@ func resourceArmFunctionApp() *schema.Resource {
 
 func resourceArmFunctionAppCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).appServicesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Function App creation.")
 
@@ -236,9 +282,12 @@ func resourceArmFunctionAppCreate(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	basicAppSettings := getBasicFunctionAppAppSettings(d, appServiceTier)
-
 	siteConfig := expandFunctionAppSiteConfig(d)
+	if err := validateFunctionAppSiteConfigForTier(siteConfig, appServiceTier); err != nil {
+		return err
+	}
+
+	basicAppSettings := getBasicFunctionAppAppSettings(d, appServiceTier)
 	siteConfig.AppSettings = &basicAppSettings
 
 	siteEnvelope := web.Site{
@@ -285,7 +334,8 @@ func resourceArmFunctionAppCreate(d *schema.ResourceData, meta interface{}) erro
 
 func resourceArmFunctionAppUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).appServicesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -293,7 +343,10 @@ func resourceArmFunctionAppUpdate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["sites"]
+	name, err := id.PopSegment("sites")
+	if err != nil {
+		return err
+	}
 
 	location := azureRMNormalizeLocation(d.Get("location").(string))
 	kind := "functionapp"
@@ -308,8 +361,12 @@ func resourceArmFunctionAppUpdate(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		return err
 	}
-	basicAppSettings := getBasicFunctionAppAppSettings(d, appServiceTier)
 	siteConfig := expandFunctionAppSiteConfig(d)
+	if err := validateFunctionAppSiteConfigForTier(siteConfig, appServiceTier); err != nil {
+		return err
+	}
+
+	basicAppSettings := getBasicFunctionAppAppSettings(d, appServiceTier)
 	siteConfig.AppSettings = &basicAppSettings
 
 	siteEnvelope := web.Site{
@@ -375,12 +432,23 @@ func resourceArmFunctionAppUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	if d.HasChange("sticky_settings") {
+		stickySettings := web.SlotConfigNamesResource{
+			SlotConfigNames: expandFunctionAppStickySettings(d.Get("sticky_settings").([]interface{})),
+		}
+
+		if _, err := client.UpdateSlotConfigurationNames(ctx, resGroup, name, stickySettings); err != nil {
+			return fmt.Errorf("Error updating Sticky Settings for Function App %q: %+v", name, err)
+		}
+	}
+
 	return resourceArmFunctionAppRead(d, meta)
 }
 
 func resourceArmFunctionAppRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).appServicesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -388,7 +456,10 @@ func resourceArmFunctionAppRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["sites"]
+	name, err := id.PopSegment("sites")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resGroup, name)
 	if err != nil {
@@ -410,6 +481,11 @@ func resourceArmFunctionAppRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error making Read request on AzureRM Function App ConnectionStrings %q: %+v", name, err)
 	}
 
+	slotConfigNamesResp, err := client.ListSlotConfigurationNames(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error making Read request on AzureRM Function App Sticky Settings %q: %+v", name, err)
+	}
+
 	siteCredFuture, err := client.ListPublishingCredentials(ctx, resGroup, name)
 	if err != nil {
 		return err
@@ -446,10 +522,12 @@ func resourceArmFunctionAppRead(d *schema.ResourceData, meta interface{}) error
 
 	d.Set("storage_connection_string", appSettings["AzureWebJobsStorage"])
 	d.Set("version", appSettings["FUNCTIONS_EXTENSION_VERSION"])
+	d.Set("runtime", appSettings["FUNCTIONS_WORKER_RUNTIME"])
 
 	delete(appSettings, "AzureWebJobsDashboard")
 	delete(appSettings, "AzureWebJobsStorage")
 	delete(appSettings, "FUNCTIONS_EXTENSION_VERSION")
+	delete(appSettings, "FUNCTIONS_WORKER_RUNTIME")
 	delete(appSettings, "WEBSITE_CONTENTSHARE")
 	delete(appSettings, "WEBSITE_CONTENTAZUREFILECONNECTIONSTRING")
 
@@ -459,6 +537,9 @@ func resourceArmFunctionAppRead(d *schema.ResourceData, meta interface{}) error
 	if err := d.Set("connection_string", flattenFunctionAppConnectionStrings(connectionStringsResp.Properties)); err != nil {
 		return err
 	}
+	if err := d.Set("sticky_settings", flattenFunctionAppStickySettings(slotConfigNamesResp.SlotConfigNames)); err != nil {
+		return err
+	}
 
 	configResp, err := client.GetConfiguration(ctx, resGroup, name)
 	if err != nil {
@@ -488,13 +569,17 @@ func resourceArmFunctionAppDelete(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["sites"]
+	name, err := id.PopSegment("sites")
+	if err != nil {
+		return err
+	}
 
 	log.Printf("[DEBUG] Deleting Function App %q (resource group %q)", name, resGroup)
 
 	deleteMetrics := true
 	deleteEmptyServerFarm := false
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	resp, err := client.Delete(ctx, resGroup, name, &deleteMetrics, &deleteEmptyServerFarm)
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp) {
@@ -511,11 +596,13 @@ func getBasicFunctionAppAppSettings(d *schema.ResourceData, appServiceTier strin
 	dashboardPropName := "AzureWebJobsDashboard"
 	storagePropName := "AzureWebJobsStorage"
 	functionVersionPropName := "FUNCTIONS_EXTENSION_VERSION"
+	functionRuntimePropName := "FUNCTIONS_WORKER_RUNTIME"
 	contentSharePropName := "WEBSITE_CONTENTSHARE"
 	contentFileConnStringPropName := "WEBSITE_CONTENTAZUREFILECONNECTIONSTRING"
 
 	storageConnection := d.Get("storage_connection_string").(string)
 	functionVersion := d.Get("version").(string)
+	functionRuntime := d.Get("runtime").(string)
 	contentShare := strings.ToLower(d.Get("name").(string)) + "-content"
 
 	basicSettings := []web.NameValuePair{
@@ -524,13 +611,17 @@ func getBasicFunctionAppAppSettings(d *schema.ResourceData, appServiceTier strin
 		{Name: &functionVersionPropName, Value: &functionVersion},
 	}
 
+	if functionRuntime != "" {
+		basicSettings = append(basicSettings, web.NameValuePair{Name: &functionRuntimePropName, Value: &functionRuntime})
+	}
+
 	consumptionSettings := []web.NameValuePair{
 		{Name: &contentSharePropName, Value: &contentShare},
 		{Name: &contentFileConnStringPropName, Value: &storageConnection},
 	}
 
-	// If the application plan is NOT dynamic (consumption plan), we do NOT want to include WEBSITE_CONTENT components
-	if !strings.EqualFold(appServiceTier, "dynamic") {
+	// If the application plan is NOT dynamic (consumption plan) or elastic premium, we do NOT want to include WEBSITE_CONTENT components
+	if !strings.EqualFold(appServiceTier, "dynamic") && !strings.EqualFold(appServiceTier, "elasticpremium") {
 		return basicSettings
 	}
 	return append(basicSettings, consumptionSettings...)
@@ -558,6 +649,20 @@ func getFunctionAppServiceTier(ctx context.Context, appServicePlanId string, met
 	return "", fmt.Errorf("No `sku` block was returned for App Service Plan ID %q", appServicePlanId)
 }
 
+// validateFunctionAppSiteConfigForTier checks the `site_config` against the limitations of the App Service Plan tier,
+// which otherwise surface as an opaque error from the API
+func validateFunctionAppSiteConfigForTier(siteConfig web.SiteConfig, appServiceTier string) error {
+	if !strings.EqualFold(appServiceTier, "dynamic") {
+		return nil
+	}
+
+	if siteConfig.AlwaysOn != nil && *siteConfig.AlwaysOn {
+		return fmt.Errorf("`always_on` cannot be enabled for Function Apps on a Consumption (Dynamic) App Service Plan")
+	}
+
+	return nil
+}
+
 func expandFunctionAppAppSettings(d *schema.ResourceData, appServiceTier string) map[string]*string {
 	output := expandAppServiceAppSettings(d)
 
@@ -591,6 +696,10 @@ func expandFunctionAppSiteConfig(d *schema.ResourceData) web.SiteConfig {
 		siteConfig.WebSocketsEnabled = utils.Bool(v.(bool))
 	}
 
+	if v, ok := config["pre_warmed_instance_count"]; ok {
+		siteConfig.ReservedInstanceCount = utils.Int32(int32(v.(int)))
+	}
+
 	return siteConfig
 }
 
@@ -615,6 +724,10 @@ func flattenFunctionAppSiteConfig(input *web.SiteConfig) []interface{} {
 		result["websockets_enabled"] = *input.WebSocketsEnabled
 	}
 
+	if input.ReservedInstanceCount != nil {
+		result["pre_warmed_instance_count"] = int(*input.ReservedInstanceCount)
+	}
+
 	results = append(results, result)
 	return results
 }
@@ -653,6 +766,59 @@ func flattenFunctionAppConnectionStrings(input map[string]*web.ConnStringValueTy
 	return results
 }
 
+func expandFunctionAppStickySettings(input []interface{}) *web.SlotConfigNames {
+	appSettingNames := make([]string, 0)
+	connectionStringNames := make([]string, 0)
+
+	if len(input) > 0 && input[0] != nil {
+		v := input[0].(map[string]interface{})
+
+		for _, name := range v["app_setting_names"].([]interface{}) {
+			appSettingNames = append(appSettingNames, name.(string))
+		}
+
+		for _, name := range v["connection_string_names"].([]interface{}) {
+			connectionStringNames = append(connectionStringNames, name.(string))
+		}
+	}
+
+	return &web.SlotConfigNames{
+		AppSettingNames:       &appSettingNames,
+		ConnectionStringNames: &connectionStringNames,
+	}
+}
+
+func flattenFunctionAppStickySettings(input *web.SlotConfigNames) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	appSettingNames := make([]interface{}, 0)
+	if input.AppSettingNames != nil {
+		for _, name := range *input.AppSettingNames {
+			appSettingNames = append(appSettingNames, name)
+		}
+	}
+
+	connectionStringNames := make([]interface{}, 0)
+	if input.ConnectionStringNames != nil {
+		for _, name := range *input.ConnectionStringNames {
+			connectionStringNames = append(connectionStringNames, name)
+		}
+	}
+
+	if len(appSettingNames) == 0 && len(connectionStringNames) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"app_setting_names":       appSettingNames,
+			"connection_string_names": connectionStringNames,
+		},
+	}
+}
+
 func flattenFunctionAppIdentity(identity *web.ManagedServiceIdentity) interface{} {
 	if identity == nil {
 		return make([]interface{}, 0)
@@ -9,19 +9,18 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmImage() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmImageCreateUpdate,
-		Read:   resourceArmImageRead,
-		Update: resourceArmImageCreateUpdate,
-		Delete: resourceArmImageDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmImageCreateUpdate,
+		Read:     resourceArmImageRead,
+		Update:   resourceArmImageCreateUpdate,
+		Delete:   resourceArmImageDelete,
+		Importer: azure.ImporterValidatingResourceId("images"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -159,7 +158,8 @@ func resourceArmImage() *schema.Resource {
 
 func resourceArmImageCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).imageClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Image creation.")
 
@@ -242,14 +242,18 @@ func resourceArmImageCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 
 func resourceArmImageRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).imageClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["images"]
+	name, err := id.PopSegment("images")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resGroup, name, "")
 	if err != nil {
@@ -290,14 +294,18 @@ func resourceArmImageRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmImageDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).imageClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["images"]
+	name, err := id.PopSegment("images")
+	if err != nil {
+		return err
+	}
 
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
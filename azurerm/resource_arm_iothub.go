@@ -12,20 +12,20 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 	"strings"
 )
 
 func resourceArmIotHub() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmIotHubCreateAndUpdate,
-		Read:   resourceArmIotHubRead,
-		Update: resourceArmIotHubCreateAndUpdate,
-		Delete: resourceArmIotHubDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmIotHubCreateAndUpdate,
+		Read:     resourceArmIotHubRead,
+		Update:   resourceArmIotHubCreateAndUpdate,
+		Delete:   resourceArmIotHubDelete,
+		Importer: azure.ImporterValidatingResourceId("IotHubs"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -251,7 +251,8 @@ func resourceArmIotHub() *schema.Resource {
 
 func resourceArmIotHubCreateAndUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).iothubResourceClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	subscriptionID := meta.(*ArmClient).subscriptionId
 
 	name := d.Get("name").(string)
@@ -320,14 +321,18 @@ func resourceArmIotHubCreateAndUpdate(d *schema.ResourceData, meta interface{})
 
 func resourceArmIotHubRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).iothubResourceClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	name := id.Path["IotHubs"]
+	name, err := id.PopSegment("IotHubs")
+	if err != nil {
+		return err
+	}
 	resourceGroup := id.ResourceGroup
 	hub, err := client.Get(ctx, id.ResourceGroup, name)
 	if err != nil {
@@ -411,9 +416,13 @@ func resourceArmIotHubDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	client := meta.(*ArmClient).iothubResourceClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	name := id.Path["IotHubs"]
+	name, err := id.PopSegment("IotHubs")
+	if err != nil {
+		return err
+	}
 	resourceGroup := id.ResourceGroup
 
 	future, err := client.Delete(ctx, resourceGroup, name)
@@ -424,17 +433,17 @@ func resourceArmIotHubDelete(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	return waitForIotHubToBeDeleted(ctx, client, resourceGroup, name)
+	return waitForIotHubToBeDeleted(ctx, client, resourceGroup, name, d.Timeout(schema.TimeoutDelete))
 }
 
-func waitForIotHubToBeDeleted(ctx context.Context, client devices.IotHubResourceClient, resourceGroup, name string) error {
+func waitForIotHubToBeDeleted(ctx context.Context, client devices.IotHubResourceClient, resourceGroup, name string, timeout time.Duration) error {
 	// we can't use the Waiter here since the API returns a 404 once it's deleted which is considered a polling status code..
 	log.Printf("[DEBUG] Waiting for IotHub (%q in Resource Group %q) to be deleted", name, resourceGroup)
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"200"},
 		Target:  []string{"404"},
 		Refresh: iothubStateStatusCodeRefreshFunc(ctx, client, resourceGroup, name),
-		Timeout: 40 * time.Minute,
+		Timeout: timeout,
 	}
 	if _, err := stateConf.WaitForState(); err != nil {
 		return fmt.Errorf("Error waiting for IotHub (%q in Resource Group %q) to be deleted: %+v", name, resourceGroup, err)
@@ -1,9 +1,12 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
+	"net/url"
 	"regexp"
 	"time"
 
@@ -15,6 +18,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/set"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -27,13 +31,12 @@ var keyVaultResourceName = "azurerm_key_vault"
 
 func resourceArmKeyVault() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmKeyVaultCreateUpdate,
-		Read:   resourceArmKeyVaultRead,
-		Update: resourceArmKeyVaultCreateUpdate,
-		Delete: resourceArmKeyVaultDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:        resourceArmKeyVaultCreateUpdate,
+		Read:          resourceArmKeyVaultRead,
+		Update:        resourceArmKeyVaultCreateUpdate,
+		Delete:        resourceArmKeyVaultDelete,
+		Importer:      azure.ImporterValidatingResourceId("vaults"),
+		CustomizeDiff: resourceArmKeyVaultCustomizeDiff,
 		MigrateState:  resourceAzureRMKeyVaultMigrateState,
 		SchemaVersion: 1,
 
@@ -121,6 +124,16 @@ func resourceArmKeyVault() *schema.Resource {
 				Optional: true,
 			},
 
+			"soft_delete_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"purge_protection_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
 			"network_acls": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -164,9 +177,45 @@ func resourceArmKeyVault() *schema.Resource {
 	}
 }
 
+func resourceArmKeyVaultCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	// neither Soft Delete or Purge Protection can be disabled once they've been enabled
+	if diff.Id() != "" {
+		for _, key := range []string{"soft_delete_enabled", "purge_protection_enabled"} {
+			if old, new := diff.GetChange(key); old.(bool) && !new.(bool) {
+				return fmt.Errorf("`%s` cannot be disabled once it's been enabled - the Key Vault must be re-created to do so", key)
+			}
+		}
+	}
+
+	if diff.Get("purge_protection_enabled").(bool) && !diff.Get("soft_delete_enabled").(bool) {
+		return fmt.Errorf("`purge_protection_enabled` can only be set when `soft_delete_enabled` is also set")
+	}
+
+	return validateNameIsAvailable(diff, meta, "Key Vault", func(ctx context.Context, name string) (*bool, *string, error) {
+		client := meta.(*ArmClient).keyVaultClient
+		parameters := keyvault.VaultCheckNameAvailabilityParameters{
+			Name: utils.String(name),
+			Type: utils.String("Microsoft.KeyVault/vaults"),
+		}
+		available, err := client.CheckNameAvailability(ctx, parameters)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// the name of a soft-deleted Key Vault remains reserved - which is expected when it's going to be recovered
+		if available.NameAvailable != nil && !*available.NameAvailable && diff.Id() == "" && meta.(*ArmClient).features.keyVault.recoverSoftDeletedKeyVaults {
+			log.Printf("[DEBUG] The name %q used for the Key Vault isn't available - checking for a soft-deleted Key Vault when it's created", name)
+			return utils.Bool(true), nil, nil
+		}
+
+		return available.NameAvailable, available.Message, nil
+	})
+}
+
 func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).keyVaultClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for Azure ARM KeyVault creation.")
 
 	name := d.Get("name").(string)
@@ -176,6 +225,8 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 	enabledForDeployment := d.Get("enabled_for_deployment").(bool)
 	enabledForDiskEncryption := d.Get("enabled_for_disk_encryption").(bool)
 	enabledForTemplateDeployment := d.Get("enabled_for_template_deployment").(bool)
+	softDeleteEnabled := d.Get("soft_delete_enabled").(bool)
+	purgeProtectionEnabled := d.Get("purge_protection_enabled").(bool)
 	tags := d.Get("tags").(map[string]interface{})
 
 	networkAclsRaw := d.Get("network_acls").([]interface{})
@@ -201,6 +252,14 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 		Tags: expandTags(tags),
 	}
 
+	// the API rejects `false` for these, since they can't be disabled once enabled
+	if softDeleteEnabled {
+		parameters.Properties.EnableSoftDelete = utils.Bool(true)
+	}
+	if purgeProtectionEnabled {
+		parameters.Properties.EnablePurgeProtection = utils.Bool(true)
+	}
+
 	// Locking this resource so we don't make modifications to it at the same time if there is a
 	// key vault access policy trying to update it as well
 	azureRMLockByName(name, keyVaultResourceName)
@@ -221,6 +280,41 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 	azureRMLockMultipleByName(&virtualNetworkNames, virtualNetworkResourceName)
 	defer azureRMUnlockMultipleByName(&virtualNetworkNames, virtualNetworkResourceName)
 
+	// when `network_acls` isn't configured (and hasn't just been removed) retain the existing Network ACLs, since
+	// Subnets can be added using the `azurerm_key_vault_subnet_association` resource which we'd otherwise remove
+	if !d.IsNewResource() && len(networkAclsRaw) == 0 && !d.HasChange("network_acls") {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if props := existing.Properties; props != nil && props.NetworkAcls != nil {
+			parameters.Properties.NetworkAcls = props.NetworkAcls
+		}
+	}
+
+	if d.IsNewResource() && meta.(*ArmClient).features.keyVault.recoverSoftDeletedKeyVaults {
+		deleted, err := client.GetDeleted(ctx, name, location)
+		if err != nil {
+			if !utils.ResponseWasNotFound(deleted.Response) {
+				return fmt.Errorf("Error checking for a soft-deleted Key Vault %q (Location %q): %+v", name, location, err)
+			}
+		} else {
+			log.Printf("[DEBUG] Recovering the soft-deleted Key Vault %q (Location %q)", name, location)
+			recoverParameters := keyvault.VaultCreateOrUpdateParameters{
+				Location: &location,
+				Properties: &keyvault.VaultProperties{
+					TenantID:   &tenantUUID,
+					Sku:        expandKeyVaultSku(d),
+					CreateMode: keyvault.CreateModeRecover,
+				},
+			}
+			if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, recoverParameters); err != nil {
+				return fmt.Errorf("Error recovering the soft-deleted Key Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+	}
+
 	_, err = client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
 	if err != nil {
 		return fmt.Errorf("Error updating Key Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -238,7 +332,11 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 
 	if d.IsNewResource() {
 		if props := read.Properties; props != nil {
-			if vault := props.VaultURI; vault != nil {
+			// when public access is denied (e.g. the Key Vault is only exposed via a Private Endpoint) the data plane
+			// won't necessarily be reachable from here, so there's nothing to wait on
+			if acls := props.NetworkAcls; acls != nil && acls.DefaultAction == keyvault.Deny {
+				log.Printf("[DEBUG] Public access to Key Vault %q (Resource Group %q) is denied - skipping waiting for it to become available", name, resourceGroup)
+			} else if vault := props.VaultURI; vault != nil {
 				log.Printf("[DEBUG] Waiting for Key Vault %q (Resource Group %q) to become available", name, resourceGroup)
 				stateConf := &resource.StateChangeConf{
 					Pending:                   []string{"pending"},
@@ -262,14 +360,18 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).keyVaultClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["vaults"]
+	name, err := id.PopSegment("vaults")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -292,13 +394,21 @@ func resourceArmKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("enabled_for_deployment", props.EnabledForDeployment)
 		d.Set("enabled_for_disk_encryption", props.EnabledForDiskEncryption)
 		d.Set("enabled_for_template_deployment", props.EnabledForTemplateDeployment)
+		d.Set("soft_delete_enabled", props.EnableSoftDelete != nil && *props.EnableSoftDelete)
+		d.Set("purge_protection_enabled", props.EnablePurgeProtection != nil && *props.EnablePurgeProtection)
 		d.Set("vault_uri", props.VaultURI)
 
 		if err := d.Set("sku", flattenKeyVaultSku(props.Sku)); err != nil {
 			return fmt.Errorf("Error flattening `sku` for KeyVault %q: %+v", *resp.Name, err)
 		}
 
-		if err := d.Set("network_acls", flattenKeyVaultNetworkAcls(props.NetworkAcls)); err != nil {
+		// when `network_acls` isn't configured the API returns the open defaults (and any Subnets added using
+		// the `azurerm_key_vault_subnet_association` resource), which we don't want to diff against
+		networkAcls := flattenKeyVaultNetworkAcls(props.NetworkAcls)
+		if len(d.Get("network_acls").([]interface{})) == 0 && keyVaultNetworkAclsAreDefault(props.NetworkAcls) {
+			networkAcls = []interface{}{}
+		}
+		if err := d.Set("network_acls", networkAcls); err != nil {
 			return fmt.Errorf("Error flattening `network_acls` for KeyVault %q: %+v", *resp.Name, err)
 		}
 
@@ -314,14 +424,18 @@ func resourceArmKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmKeyVaultDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).keyVaultClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["vaults"]
+	name, err := id.PopSegment("vaults")
+	if err != nil {
+		return err
+	}
 
 	azureRMLockByName(name, keyVaultResourceName)
 	defer azureRMUnlockByName(name, keyVaultResourceName)
@@ -367,6 +481,37 @@ func resourceArmKeyVaultDelete(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// when Soft Delete is enabled the Key Vault is retained (and the name reserved) until it's purged
+	softDeleteEnabled := false
+	purgeProtectionEnabled := false
+	if props := read.Properties; props != nil {
+		softDeleteEnabled = props.EnableSoftDelete != nil && *props.EnableSoftDelete
+		purgeProtectionEnabled = props.EnablePurgeProtection != nil && *props.EnablePurgeProtection
+	}
+
+	if softDeleteEnabled && meta.(*ArmClient).features.keyVault.purgeSoftDeleteOnDestroy {
+		// a Key Vault with Purge Protection enabled can't be purged until the retention period has passed
+		if purgeProtectionEnabled {
+			log.Printf("[WARN] Key Vault %q (Resource Group %q) has Purge Protection enabled and so can't be purged - it'll be retained until the retention period has passed", name, resourceGroup)
+			return nil
+		}
+
+		if read.Location == nil {
+			return fmt.Errorf("Error purging Key Vault %q (Resource Group %q): `location` was nil", name, resourceGroup)
+		}
+		location := *read.Location
+
+		log.Printf("[DEBUG] Purging the soft-deleted Key Vault %q (Location %q)", name, location)
+		future, err := client.PurgeDeleted(ctx, name, location)
+		if err != nil {
+			return fmt.Errorf("Error purging Key Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for the purge of Key Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
 	return nil
 }
 
@@ -420,7 +565,7 @@ func flattenKeyVaultNetworkAcls(input *keyvault.NetworkRuleSet) []interface{} {
 			virtualNetworkRules = append(virtualNetworkRules, *v.ID)
 		}
 	}
-	output["virtual_network_subnet_ids"] = schema.NewSet(schema.HashString, virtualNetworkRules)
+	output["virtual_network_subnet_ids"] = schema.NewSet(set.HashStringIgnoreCase, virtualNetworkRules)
 
 	return []interface{}{output}
 }
@@ -446,6 +591,18 @@ func keyVaultRefreshFunc(vaultUri string) resource.StateRefreshFunc {
 
 		conn, err := client.Get(vaultUri)
 		if err != nil {
+			// the DNS record for a new Key Vault can take a while to propagate (or be resolved via a Private DNS Zone
+			// which doesn't contain it yet) - so this is retried rather than treated as a failure
+			if urlErr, ok := err.(*url.Error); ok {
+				if opErr, ok := urlErr.Err.(*net.OpError); ok {
+					if _, ok := opErr.Err.(*net.DNSError); ok {
+						// a nil result is treated as not found, which would stop after a fixed number of checks rather than the timeout
+						log.Printf("[DEBUG] Unable to resolve KeyVault at %q - retrying: %s", vaultUri, err)
+						return vaultUri, "pending", nil
+					}
+				}
+			}
+
 			log.Printf("[DEBUG] Didn't find KeyVault at %q", vaultUri)
 			return nil, "pending", fmt.Errorf("Error connecting to %q: %s", vaultUri, err)
 		}
@@ -460,7 +617,13 @@ func keyVaultRefreshFunc(vaultUri string) resource.StateRefreshFunc {
 func expandKeyVaultNetworkAcls(input []interface{}) (*keyvault.NetworkRuleSet, []string) {
 	subnetIds := make([]string, 0)
 	if len(input) == 0 {
-		return nil, subnetIds
+		// explicitly reset to the defaults, so that removing the `network_acls` block opens up the Key Vault again
+		return &keyvault.NetworkRuleSet{
+			Bypass:              keyvault.AzureServices,
+			DefaultAction:       keyvault.Allow,
+			IPRules:             &[]keyvault.IPRule{},
+			VirtualNetworkRules: &[]keyvault.VirtualNetworkRule{},
+		}, subnetIds
 	}
 
 	v := input[0].(map[string]interface{})
@@ -497,3 +660,16 @@ func expandKeyVaultNetworkAcls(input []interface{}) (*keyvault.NetworkRuleSet, [
 	}
 	return &ruleSet, subnetIds
 }
+
+func keyVaultNetworkAclsAreDefault(input *keyvault.NetworkRuleSet) bool {
+	if input == nil {
+		return true
+	}
+
+	if input.DefaultAction != keyvault.Allow || input.Bypass != keyvault.AzureServices {
+		return false
+	}
+
+	// Virtual Network Rules are intentionally ignored, since they've no effect when access is allowed from all networks
+	return input.IPRules == nil || len(*input.IPRules) == 0
+}
@@ -1,27 +1,29 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
-
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2018-02-14/keyvault"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/satori/go.uuid"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmKeyVaultAccessPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmKeyVaultAccessPolicyCreate,
-		Read:   resourceArmKeyVaultAccessPolicyRead,
-		Update: resourceArmKeyVaultAccessPolicyUpdate,
-		Delete: resourceArmKeyVaultAccessPolicyDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmKeyVaultAccessPolicyCreate,
+		Read:     resourceArmKeyVaultAccessPolicyRead,
+		Update:   resourceArmKeyVaultAccessPolicyUpdate,
+		Delete:   resourceArmKeyVaultAccessPolicyDelete,
+		Importer: azure.ImporterValidatingResourceId("vaults", "objectId"),
 
 		Schema: map[string]*schema.Schema{
 			"vault_name": {
@@ -120,11 +122,88 @@ func resourceArmKeyVaultAccessPolicyCreateOrDelete(d *schema.ResourceData, meta
 	azureRMLockByName(vaultName, keyVaultResourceName)
 	defer azureRMUnlockByName(vaultName, keyVaultResourceName)
 
-	_, err = client.UpdateAccessPolicy(ctx, resGroup, vaultName, action, parameters)
+	existing, err := client.Get(ctx, resGroup, vaultName)
+	if err != nil {
+		// when the Key Vault has been deleted (e.g. in the same run) the Access Policy has gone with it
+		if action == keyvault.Remove && utils.ResponseWasNotFound(existing.Response) {
+			log.Printf("[DEBUG] Key Vault %q (Resource Group %q) was not found - assuming the Access Policy was removed", vaultName, resGroup)
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", vaultName, resGroup, err)
+	}
+
+	if action == keyvault.Add {
+		// the Key Vault may be shared with other Terraform configurations, so we need to ensure
+		// we're not about to take over an Access Policy which is already managed elsewhere
+		if props := existing.Properties; props != nil {
+			policy, err := findKeyVaultAccessPolicy(props.AccessPolicies, objectId, applicationIdRaw)
+			if err != nil {
+				return fmt.Errorf("Error locating Access Policy (Object ID %q / Application ID %q) in Key Vault %q (Resource Group %q): %+v", objectId, applicationIdRaw, vaultName, resGroup, err)
+			}
+
+			if policy != nil {
+				return fmt.Errorf("An Access Policy with Object ID %q / Application ID %q already exists in Key Vault %q (Resource Group %q) - to be managed via Terraform this resource needs to be imported into the State. Please see the resource documentation for `azurerm_key_vault_access_policy` for more information.", objectId, applicationIdRaw, vaultName, resGroup)
+			}
+		}
+	}
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	switch action {
+	case keyvault.Replace:
+		timeout = d.Timeout(schema.TimeoutUpdate)
+	case keyvault.Remove:
+		timeout = d.Timeout(schema.TimeoutDelete)
+	}
+
+	// the lock above only covers this provider instance - other Terraform runs may be updating the
+	// Access Policies for this Key Vault at the same time, in which case the API returns a Conflict
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		resp, err := client.UpdateAccessPolicy(ctx, resGroup, vaultName, action, parameters)
+		if err != nil {
+			if action == keyvault.Remove && utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			if response.WasConflict(resp.Response.Response) || utils.ResponseErrorIsRetryable(err) {
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
 	if err != nil {
 		return fmt.Errorf("Error updating Access Policy (Object ID %q / Application ID %q) for Key Vault %q (Resource Group %q): %+v", objectId, applicationIdRaw, vaultName, resGroup, err)
 	}
 
+	// changes to the Access Policies are eventually consistent, so wait for them to be visible
+	pending := []string{"NotFound"}
+	target := []string{"Found"}
+	if action == keyvault.Remove {
+		// the Key Vault being deleted whilst we're waiting also means the Access Policy has been removed
+		pending = []string{"Found"}
+		target = []string{"NotFound", "VaultNotFound"}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:                   pending,
+		Target:                    target,
+		Refresh:                   keyVaultAccessPolicyRefreshFunc(ctx, client, resGroup, vaultName, objectId, applicationIdRaw),
+		Timeout:                   timeout,
+		MinTimeout:                5 * time.Second,
+		ContinuousTargetOccurence: 3,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Access Policy (Object ID %q / Application ID %q) for Key Vault %q (Resource Group %q) to be %s: %+v", objectId, applicationIdRaw, vaultName, resGroup, strings.ToLower(target[0]), err)
+	}
+
+	if action == keyvault.Remove {
+		return nil
+	}
+
 	read, err := client.Get(ctx, resGroup, vaultName)
 	if err != nil {
 		return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", vaultName, resGroup, err)
@@ -162,7 +241,8 @@ func resourceArmKeyVaultAccessPolicyUpdate(d *schema.ResourceData, meta interfac
 
 func resourceArmKeyVaultAccessPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).keyVaultClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 
@@ -170,9 +250,16 @@ func resourceArmKeyVaultAccessPolicyRead(d *schema.ResourceData, meta interface{
 		return err
 	}
 	resGroup := id.ResourceGroup
-	vaultName := id.Path["vaults"]
-	objectId := id.Path["objectId"]
-	applicationId := id.Path["applicationId"]
+	vaultName, err := id.PopSegment("vaults")
+	if err != nil {
+		return err
+	}
+	objectId, err := id.PopSegment("objectId")
+	if err != nil {
+		return err
+	}
+	// the Application ID is optional, so is only present in the ID when specified
+	applicationId, _ := id.PopSegment("applicationId")
 
 	resp, err := client.Get(ctx, resGroup, vaultName)
 	if err != nil {
@@ -250,3 +337,29 @@ func findKeyVaultAccessPolicy(policies *[]keyvault.AccessPolicyEntry, objectId s
 
 	return nil, nil
 }
+
+func keyVaultAccessPolicyRefreshFunc(ctx context.Context, client keyvault.VaultsClient, resourceGroup string, vaultName string, objectId string, applicationId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, resourceGroup, vaultName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return resp, "VaultNotFound", nil
+			}
+
+			return nil, "", fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", vaultName, resourceGroup, err)
+		}
+
+		if props := resp.Properties; props != nil {
+			policy, err := findKeyVaultAccessPolicy(props.AccessPolicies, objectId, applicationId)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if policy != nil {
+				return resp, "Found", nil
+			}
+		}
+
+		return resp, "NotFound", nil
+	}
+}
@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -33,6 +34,30 @@ func TestAccAzureRMKeyVaultAccessPolicy_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMKeyVaultAccessPolicy_requiresImport(t *testing.T) {
+	resourceName := "azurerm_key_vault_access_policy.test"
+	rs := acctest.RandString(6)
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVaultAccessPolicy_basic(rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultAccessPolicyExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMKeyVaultAccessPolicy_requiresImport(rs, location),
+				ExpectError: regexp.MustCompile("already exists in Key Vault"),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMKeyVaultAccessPolicy_multiple(t *testing.T) {
 	resourceName1 := "azurerm_key_vault_access_policy.test_with_application_id"
 	resourceName2 := "azurerm_key_vault_access_policy.test_no_application_id"
@@ -158,6 +183,29 @@ resource "azurerm_key_vault_access_policy" "test" {
 `, template)
 }
 
+func testAccAzureRMKeyVaultAccessPolicy_requiresImport(rString string, location string) string {
+	template := testAccAzureRMKeyVaultAccessPolicy_basic(rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_access_policy" "import" {
+  vault_name          = "${azurerm_key_vault_access_policy.test.vault_name}"
+  resource_group_name = "${azurerm_key_vault_access_policy.test.resource_group_name}"
+  tenant_id           = "${azurerm_key_vault_access_policy.test.tenant_id}"
+  object_id           = "${azurerm_key_vault_access_policy.test.object_id}"
+
+  key_permissions = [
+    "get",
+  ]
+
+  secret_permissions = [
+    "get",
+    "set",
+  ]
+}
+`, template)
+}
+
 func testAccAzureRMKeyVaultAccessPolicy_multiple(rString string, location string) string {
 	template := testAccAzureRMKeyVaultAccessPolicy_template(rString, location)
 	return fmt.Sprintf(`
@@ -1,26 +1,23 @@
 package azurerm
 
 import (
-	"fmt"
 	"log"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2018-02-14/keyvault"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/migration"
 )
 
 func resourceAzureRMKeyVaultMigrateState(v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
-	switch v {
-	case 0:
-		log.Println("[INFO] Found AzureRM Key Vault State v0; migrating to v1")
-		return migrateAzureRMKeyVaultStateV0toV1(is)
-	default:
-		return is, fmt.Errorf("Unexpected schema version: %d", v)
+	upgraders := []migration.StateUpgrader{
+		migrateAzureRMKeyVaultStateV0toV1,
 	}
+	return migration.MigrateStateUsingUpgraders("Key Vault", upgraders...)(v, is, meta)
 }
 
-func migrateAzureRMKeyVaultStateV0toV1(is *terraform.InstanceState) (*terraform.InstanceState, error) {
+func migrateAzureRMKeyVaultStateV0toV1(is *terraform.InstanceState, _ interface{}) (*terraform.InstanceState, error) {
 	if is.Empty() {
 		log.Println("[DEBUG] Empty InstanceState; nothing to migrate.")
 		return is, nil
@@ -0,0 +1,257 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2018-02-14/keyvault"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmKeyVaultSubnetAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmKeyVaultSubnetAssociationCreate,
+		Read:   resourceArmKeyVaultSubnetAssociationRead,
+		Delete: resourceArmKeyVaultSubnetAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key_vault_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+		},
+	}
+}
+
+func resourceArmKeyVaultSubnetAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Key Vault <-> Subnet Association creation.")
+
+	keyVaultId := d.Get("key_vault_id").(string)
+	subnetId := d.Get("subnet_id").(string)
+
+	parsedKeyVaultId, err := parseAzureResourceID(keyVaultId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := parsedKeyVaultId.ResourceGroup
+	vaultName := parsedKeyVaultId.Path["vaults"]
+
+	parsedSubnetId, err := parseAzureResourceID(subnetId)
+	if err != nil {
+		return err
+	}
+	virtualNetworkName := parsedSubnetId.Path["virtualNetworks"]
+
+	azureRMLockByName(vaultName, keyVaultResourceName)
+	defer azureRMUnlockByName(vaultName, keyVaultResourceName)
+
+	azureRMLockByName(virtualNetworkName, virtualNetworkResourceName)
+	defer azureRMUnlockByName(virtualNetworkName, virtualNetworkResourceName)
+
+	read, err := client.Get(ctx, resourceGroup, vaultName)
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			return fmt.Errorf("Key Vault %q (Resource Group %q) was not found!", vaultName, resourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", vaultName, resourceGroup, err)
+	}
+
+	props := read.Properties
+	if props == nil {
+		return fmt.Errorf("Error: `properties` was nil for Key Vault %q (Resource Group %q)", vaultName, resourceGroup)
+	}
+
+	// when no Network ACLs are defined the API defaults apply, which we retain
+	acls := props.NetworkAcls
+	if acls == nil {
+		acls = &keyvault.NetworkRuleSet{
+			Bypass:        keyvault.AzureServices,
+			DefaultAction: keyvault.Allow,
+		}
+	}
+
+	rules := make([]keyvault.VirtualNetworkRule, 0)
+	if acls.VirtualNetworkRules != nil {
+		for _, rule := range *acls.VirtualNetworkRules {
+			if rule.ID == nil {
+				continue
+			}
+
+			// first double-check it doesn't exist
+			if strings.EqualFold(*rule.ID, subnetId) {
+				return fmt.Errorf("A Key Vault <-> Subnet association exists between %q and %q - please import it!", keyVaultId, subnetId)
+			}
+
+			rules = append(rules, rule)
+		}
+	}
+
+	rules = append(rules, keyvault.VirtualNetworkRule{
+		ID: utils.String(subnetId),
+	})
+	acls.VirtualNetworkRules = &rules
+
+	if err := updateKeyVaultNetworkAcls(ctx, client, resourceGroup, vaultName, acls); err != nil {
+		return fmt.Errorf("Error adding Subnet %q to the Network ACLs for Key Vault %q (Resource Group %q): %+v", subnetId, vaultName, resourceGroup, err)
+	}
+
+	resourceId := fmt.Sprintf("%s|%s", keyVaultId, subnetId)
+	d.SetId(resourceId)
+
+	return resourceArmKeyVaultSubnetAssociationRead(d, meta)
+}
+
+func resourceArmKeyVaultSubnetAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	splitId := strings.Split(d.Id(), "|")
+	if len(splitId) != 2 {
+		return fmt.Errorf("Expected ID to be in the format {keyVaultId}|{subnetId} but got %q", d.Id())
+	}
+
+	keyVaultId, err := parseAzureResourceID(splitId[0])
+	if err != nil {
+		return err
+	}
+	resourceGroup := keyVaultId.ResourceGroup
+	vaultName := keyVaultId.Path["vaults"]
+	subnetId := splitId[1]
+
+	read, err := client.Get(ctx, resourceGroup, vaultName)
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			log.Printf("[DEBUG] Key Vault %q (Resource Group %q) was not found - removing from state!", vaultName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", vaultName, resourceGroup, err)
+	}
+
+	found := false
+	if props := read.Properties; props != nil {
+		if acls := props.NetworkAcls; acls != nil && acls.VirtualNetworkRules != nil {
+			for _, rule := range *acls.VirtualNetworkRules {
+				if rule.ID == nil {
+					continue
+				}
+
+				if strings.EqualFold(*rule.ID, subnetId) {
+					found = true
+					break
+				}
+			}
+		}
+	}
+
+	if !found {
+		log.Printf("[DEBUG] Association between Key Vault %q (Resource Group %q) and Subnet %q was not found - removing from state!", vaultName, resourceGroup, subnetId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("key_vault_id", read.ID)
+	d.Set("subnet_id", subnetId)
+
+	return nil
+}
+
+func resourceArmKeyVaultSubnetAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	splitId := strings.Split(d.Id(), "|")
+	if len(splitId) != 2 {
+		return fmt.Errorf("Expected ID to be in the format {keyVaultId}|{subnetId} but got %q", d.Id())
+	}
+
+	keyVaultId, err := parseAzureResourceID(splitId[0])
+	if err != nil {
+		return err
+	}
+	resourceGroup := keyVaultId.ResourceGroup
+	vaultName := keyVaultId.Path["vaults"]
+	subnetId := splitId[1]
+
+	parsedSubnetId, err := parseAzureResourceID(subnetId)
+	if err != nil {
+		return err
+	}
+	virtualNetworkName := parsedSubnetId.Path["virtualNetworks"]
+
+	azureRMLockByName(vaultName, keyVaultResourceName)
+	defer azureRMUnlockByName(vaultName, keyVaultResourceName)
+
+	azureRMLockByName(virtualNetworkName, virtualNetworkResourceName)
+	defer azureRMUnlockByName(virtualNetworkName, virtualNetworkResourceName)
+
+	read, err := client.Get(ctx, resourceGroup, vaultName)
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			log.Printf("[DEBUG] Key Vault %q (Resource Group %q) was not found - removing from state!", vaultName, resourceGroup)
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", vaultName, resourceGroup, err)
+	}
+
+	props := read.Properties
+	if props == nil || props.NetworkAcls == nil || props.NetworkAcls.VirtualNetworkRules == nil {
+		log.Printf("[DEBUG] Key Vault %q (Resource Group %q) has no Virtual Network Rules - removing from state!", vaultName, resourceGroup)
+		return nil
+	}
+
+	acls := props.NetworkAcls
+	rules := make([]keyvault.VirtualNetworkRule, 0)
+	for _, rule := range *acls.VirtualNetworkRules {
+		if rule.ID == nil || strings.EqualFold(*rule.ID, subnetId) {
+			continue
+		}
+
+		rules = append(rules, rule)
+	}
+	acls.VirtualNetworkRules = &rules
+
+	if err := updateKeyVaultNetworkAcls(ctx, client, resourceGroup, vaultName, acls); err != nil {
+		return fmt.Errorf("Error removing Subnet %q from the Network ACLs for Key Vault %q (Resource Group %q): %+v", subnetId, vaultName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func updateKeyVaultNetworkAcls(ctx context.Context, client keyvault.VaultsClient, resourceGroup string, name string, acls *keyvault.NetworkRuleSet) error {
+	// PATCH only the Network ACLs, so that any other changes made to the Key Vault are retained
+	parameters := keyvault.VaultPatchParameters{
+		Properties: &keyvault.VaultPatchProperties{
+			NetworkAcls: acls,
+		},
+	}
+
+	_, err := client.Update(ctx, resourceGroup, name, parameters)
+	return err
+}
@@ -0,0 +1,185 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMKeyVaultSubnetAssociation_basic(t *testing.T) {
+	resourceName := "azurerm_key_vault_subnet_association.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultSubnetAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVaultSubnetAssociation_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultSubnetAssociationExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKeyVaultSubnetAssociation_requiresImport(t *testing.T) {
+	resourceName := "azurerm_key_vault_subnet_association.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultSubnetAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVaultSubnetAssociation_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultSubnetAssociationExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMKeyVaultSubnetAssociation_requiresImport(ri, location),
+				ExpectError: regexp.MustCompile("please import it"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMKeyVaultSubnetAssociationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		keyVaultId := rs.Primary.Attributes["key_vault_id"]
+		subnetId := rs.Primary.Attributes["subnet_id"]
+
+		found, err := testCheckAzureRMKeyVaultSubnetAssociationFound(keyVaultId, subnetId)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return fmt.Errorf("Bad: Association between Key Vault %q and Subnet %q was not found", keyVaultId, subnetId)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMKeyVaultSubnetAssociationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_key_vault_subnet_association" {
+			continue
+		}
+
+		keyVaultId := rs.Primary.Attributes["key_vault_id"]
+		subnetId := rs.Primary.Attributes["subnet_id"]
+
+		found, err := testCheckAzureRMKeyVaultSubnetAssociationFound(keyVaultId, subnetId)
+		if err != nil {
+			return err
+		}
+
+		if found {
+			return fmt.Errorf("Association between Key Vault %q and Subnet %q still exists", keyVaultId, subnetId)
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMKeyVaultSubnetAssociationFound(keyVaultId string, subnetId string) (bool, error) {
+	client := testAccProvider.Meta().(*ArmClient).keyVaultClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(keyVaultId)
+	if err != nil {
+		return false, err
+	}
+	resourceGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+
+	resp, err := client.Get(ctx, resourceGroup, vaultName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("Bad: Get on keyVaultClient: %+v", err)
+	}
+
+	if props := resp.Properties; props != nil {
+		if acls := props.NetworkAcls; acls != nil && acls.VirtualNetworkRules != nil {
+			for _, rule := range *acls.VirtualNetworkRules {
+				if rule.ID != nil && strings.EqualFold(*rule.ID, subnetId) {
+					return true, nil
+				}
+			}
+		}
+	}
+
+	return false, nil
+}
+
+func testAccAzureRMKeyVaultSubnetAssociation_basic(rInt int, location string) string {
+	template := testAccAzureRMKeyVault_networkAclsTemplate(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault" "test" {
+  name                = "vault%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  sku {
+    name = "premium"
+  }
+
+  network_acls {
+    default_action = "Deny"
+    bypass         = "AzureServices"
+  }
+
+  lifecycle {
+    # Subnets are managed using the azurerm_key_vault_subnet_association resource
+    ignore_changes = ["network_acls.0.virtual_network_subnet_ids"]
+  }
+}
+
+resource "azurerm_key_vault_subnet_association" "test" {
+  key_vault_id = "${azurerm_key_vault.test.id}"
+  subnet_id    = "${azurerm_subnet.test.id}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMKeyVaultSubnetAssociation_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMKeyVaultSubnetAssociation_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_subnet_association" "import" {
+  key_vault_id = "${azurerm_key_vault_subnet_association.test.key_vault_id}"
+  subnet_id    = "${azurerm_key_vault_subnet_association.test.subnet_id}"
+}
+`, template)
+}
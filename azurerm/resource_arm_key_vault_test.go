@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -90,6 +91,29 @@ func TestAccAzureRMKeyVault_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMKeyVault_nameUnavailable(t *testing.T) {
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVault_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultExists("azurerm_key_vault.test"),
+				),
+			},
+			{
+				Config:      testAccAzureRMKeyVault_nameUnavailable(ri, location),
+				ExpectError: regexp.MustCompile("needs to be globally unique and isn't available"),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMKeyVault_networkAcls(t *testing.T) {
 	resourceName := "azurerm_key_vault.test"
 	ri := acctest.RandInt()
@@ -122,6 +146,13 @@ func TestAccAzureRMKeyVault_networkAcls(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "network_acls.0.virtual_network_subnet_ids.#", "1"),
 				),
 			},
+			{
+				Config: testAccAzureRMKeyVault_networkAclsRemoved(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "network_acls.#", "0"),
+				),
+			},
 		},
 	})
 }
@@ -204,6 +235,45 @@ func TestAccAzureRMKeyVault_update(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMKeyVault_softDelete(t *testing.T) {
+	resourceName := "azurerm_key_vault.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVault_softDelete(ri, location, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "soft_delete_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "purge_protection_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccAzureRMKeyVault_softDelete(ri, location, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "soft_delete_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "purge_protection_enabled", "false"),
+				),
+			},
+			{
+				Config:      testAccAzureRMKeyVault_softDelete(ri, location, false),
+				ExpectError: regexp.MustCompile("`soft_delete_enabled` cannot be disabled once it's been enabled"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testCheckAzureRMKeyVaultDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*ArmClient).keyVaultClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
@@ -325,6 +395,55 @@ resource "azurerm_key_vault" "test" {
 `, rInt, location, rInt)
 }
 
+func testAccAzureRMKeyVault_nameUnavailable(rInt int, location string) string {
+	template := testAccAzureRMKeyVault_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault" "duplicate" {
+  name                = "vault%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  sku {
+    name = "premium"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMKeyVault_softDelete(rInt int, location string, enabled bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    key_vault {
+      purge_soft_delete_on_destroy = true
+    }
+  }
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "vault%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+  soft_delete_enabled = %t
+
+  sku {
+    name = "premium"
+  }
+}
+`, rInt, location, rInt, enabled)
+}
+
 func testAccAzureRMKeyVault_networkAclsTemplate(rInt int, location string) string {
 	return fmt.Sprintf(`
 data "azurerm_client_config" "current" {}
@@ -426,6 +545,37 @@ resource "azurerm_key_vault" "test" {
 `, template, rInt)
 }
 
+func testAccAzureRMKeyVault_networkAclsRemoved(rInt int, location string) string {
+	template := testAccAzureRMKeyVault_networkAclsTemplate(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault" "test" {
+  name                = "vault%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  sku {
+    name = "premium"
+  }
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.client_id}"
+
+    key_permissions = [
+      "create",
+    ]
+
+    secret_permissions = [
+      "set",
+    ]
+  }
+}
+`, template, rInt)
+}
+
 func testAccAzureRMKeyVault_update(rInt int, location string) string {
 	return fmt.Sprintf(`
 data "azurerm_client_config" "current" {}
@@ -10,19 +10,20 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/kubernetes"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmKubernetesCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmKubernetesClusterCreate,
-		Read:   resourceArmKubernetesClusterRead,
-		Update: resourceArmKubernetesClusterCreate,
-		Delete: resourceArmKubernetesClusterDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmKubernetesClusterCreate,
+		Read:     resourceArmKubernetesClusterRead,
+		Update:   resourceArmKubernetesClusterCreate,
+		Delete:   resourceArmKubernetesClusterDelete,
+		Importer: azure.ImporterValidatingResourceId("managedClusters"),
 
 		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
 			if v, exists := diff.GetOk("network_profile"); exists {
@@ -133,6 +134,48 @@ func resourceArmKubernetesCluster() *schema.Resource {
 				Sensitive: true,
 			},
 
+			"kube_admin_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"password": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"client_certificate": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_key": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"cluster_ca_certificate": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"kube_admin_config_raw": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
 			"linux_profile": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -319,13 +362,11 @@ func resourceArmKubernetesCluster() *schema.Resource {
 						"http_application_routing": {
 							Type:     schema.TypeList,
 							MaxItems: 1,
-							ForceNew: true,
 							Optional: true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"enabled": {
 										Type:     schema.TypeBool,
-										ForceNew: true,
 										Required: true,
 									},
 									"http_application_routing_zone_name": {
@@ -357,6 +398,64 @@ func resourceArmKubernetesCluster() *schema.Resource {
 				},
 			},
 
+			"role_based_access_control": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+
+						"azure_active_directory": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"client_app_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validate.UUID,
+									},
+
+									"server_app_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validate.UUID,
+									},
+
+									"server_app_secret": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										Sensitive:    true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+
+									"tenant_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+										// OrEmpty since this can be sourced from the client config if it's not specified
+										ValidateFunc: validate.UUIDOrEmpty,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -379,6 +478,7 @@ func resourceArmKubernetesClusterCreate(d *schema.ResourceData, meta interface{}
 	servicePrincipalProfile := expandAzureRmKubernetesClusterServicePrincipal(d)
 	networkProfile := expandAzureRmKubernetesClusterNetworkProfile(d)
 	addonProfiles := expandAzureRmKubernetesClusterAddonProfiles(d)
+	rbacEnabled, azureADProfile := expandAzureRmKubernetesClusterRoleBasedAccessControl(d, client.tenantId)
 
 	tags := d.Get("tags").(map[string]interface{})
 
@@ -398,9 +498,11 @@ func resourceArmKubernetesClusterCreate(d *schema.ResourceData, meta interface{}
 		Name:     &name,
 		Location: &location,
 		ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+			AadProfile:              azureADProfile,
 			AddonProfiles:           addonProfiles,
 			AgentPoolProfiles:       &agentProfiles,
 			DNSPrefix:               &dnsPrefix,
+			EnableRBAC:              utils.Bool(rbacEnabled),
 			KubernetesVersion:       &kubernetesVersion,
 			LinuxProfile:            linuxProfile,
 			ServicePrincipalProfile: servicePrincipalProfile,
@@ -409,7 +511,8 @@ func resourceArmKubernetesClusterCreate(d *schema.ResourceData, meta interface{}
 		Tags: expandTags(tags),
 	}
 
-	ctx := client.StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(client.StopContext, d)
+	defer cancel()
 	future, err := kubernetesClustersClient.CreateOrUpdate(ctx, resGroup, name, parameters)
 	if err != nil {
 		return err
@@ -443,9 +546,13 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["managedClusters"]
+	name, err := id.PopSegment("managedClusters")
+	if err != nil {
+		return err
+	}
 
-	ctx := client.StopContext
+	ctx, cancel := timeouts.ForRead(client.StopContext, d)
+	defer cancel()
 	resp, err := kubernetesClustersClient.Get(ctx, resGroup, name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
@@ -493,6 +600,11 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 			return fmt.Errorf("Error setting `network_profile`: %+v", err)
 		}
 
+		roleBasedAccessControl := flattenAzureRmKubernetesClusterRoleBasedAccessControl(props, d)
+		if err := d.Set("role_based_access_control", roleBasedAccessControl); err != nil {
+			return fmt.Errorf("Error setting `role_based_access_control`: %+v", err)
+		}
+
 		servicePrincipal := flattenAzureRmKubernetesClusterServicePrincipalProfile(resp.ManagedClusterProperties.ServicePrincipalProfile)
 		if err := d.Set("service_principal", servicePrincipal); err != nil {
 			return fmt.Errorf("Error setting `service_principal`: %+v", err)
@@ -506,6 +618,23 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error setting `kube_config`: %+v", err)
 	}
 
+	// the Admin credentials are only distinct from the User credentials when Azure Active Directory integration is enabled
+	var kubeAdminConfigRaw *string
+	kubeAdminConfig := make([]interface{}, 0)
+	if props := resp.ManagedClusterProperties; props != nil && props.AadProfile != nil {
+		adminProfile, err := kubernetesClustersClient.GetAccessProfile(ctx, resGroup, name, "clusterAdmin")
+		if err != nil {
+			return fmt.Errorf("Error getting admin access profile while making Read request on AKS Managed Cluster %q (resource group %q): %+v", name, resGroup, err)
+		}
+
+		kubeAdminConfigRaw, kubeAdminConfig = flattenAzureRmKubernetesClusterAccessProfile(&adminProfile)
+	}
+	d.Set("kube_admin_config_raw", kubeAdminConfigRaw)
+
+	if err := d.Set("kube_admin_config", kubeAdminConfig); err != nil {
+		return fmt.Errorf("Error setting `kube_admin_config`: %+v", err)
+	}
+
 	flattenAndSetTags(d, resp.Tags)
 
 	return nil
@@ -520,9 +649,13 @@ func resourceArmKubernetesClusterDelete(d *schema.ResourceData, meta interface{}
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["managedClusters"]
+	name, err := id.PopSegment("managedClusters")
+	if err != nil {
+		return err
+	}
 
-	ctx := client.StopContext
+	ctx, cancel := timeouts.ForDelete(client.StopContext, d)
+	defer cancel()
 	future, err := kubernetesClustersClient.Delete(ctx, resGroup, name)
 	if err != nil {
 		return fmt.Errorf("Error issuing AzureRM delete request of AKS Managed Cluster %q (resource Group %q): %+v", name, resGroup, err)
@@ -640,7 +773,13 @@ func flattenAzureRmKubernetesClusterAccessProfile(profile *containerservice.Mana
 
 				kubeConfig, err := kubernetes.ParseKubeConfig(rawConfig)
 				if err != nil {
-					return utils.String(rawConfig), []interface{}{}
+					// clusters with Azure Active Directory integration use the `azure` auth provider instead
+					kubeConfigAAD, err := kubernetes.ParseKubeConfigAAD(rawConfig)
+					if err != nil {
+						return utils.String(rawConfig), []interface{}{}
+					}
+
+					return utils.String(rawConfig), flattenKubernetesClusterKubeConfigAAD(*kubeConfigAAD)
 				}
 
 				flattenedKubeConfig := flattenKubernetesClusterKubeConfig(*kubeConfig)
@@ -696,6 +835,23 @@ func flattenKubernetesClusterKubeConfig(config kubernetes.KubeConfig) []interfac
 	return []interface{}{values}
 }
 
+func flattenKubernetesClusterKubeConfigAAD(config kubernetes.KubeConfigAAD) []interface{} {
+	values := make(map[string]interface{})
+
+	cluster := config.Clusters[0].Cluster
+	name := config.Users[0].Name
+
+	// credentials for Azure Active Directory are obtained interactively, so aren't available here
+	values["host"] = cluster.Server
+	values["username"] = name
+	values["password"] = ""
+	values["client_certificate"] = ""
+	values["client_key"] = ""
+	values["cluster_ca_certificate"] = cluster.ClusterAuthorityData
+
+	return []interface{}{values}
+}
+
 func expandAzureRmKubernetesClusterLinuxProfile(d *schema.ResourceData) *containerservice.LinuxProfile {
 	profiles := d.Get("linux_profile").([]interface{})
 
@@ -903,6 +1059,82 @@ func flattenAzureRmKubernetesClusterAddonProfiles(profile map[string]*containers
 	return []interface{}{values}
 }
 
+func expandAzureRmKubernetesClusterRoleBasedAccessControl(d *schema.ResourceData, providerTenantId string) (bool, *containerservice.ManagedClusterAADProfile) {
+	rbacRaw := d.Get("role_based_access_control").([]interface{})
+	if len(rbacRaw) == 0 {
+		return false, nil
+	}
+
+	rbac := rbacRaw[0].(map[string]interface{})
+	rbacEnabled := rbac["enabled"].(bool)
+
+	azureADsRaw := rbac["azure_active_directory"].([]interface{})
+	if len(azureADsRaw) == 0 {
+		return rbacEnabled, nil
+	}
+
+	azureAdRaw := azureADsRaw[0].(map[string]interface{})
+
+	clientAppId := azureAdRaw["client_app_id"].(string)
+	serverAppId := azureAdRaw["server_app_id"].(string)
+	serverAppSecret := azureAdRaw["server_app_secret"].(string)
+	tenantId := azureAdRaw["tenant_id"].(string)
+
+	// fall back to the Tenant the Provider is authenticated against
+	if tenantId == "" {
+		tenantId = providerTenantId
+	}
+
+	profile := containerservice.ManagedClusterAADProfile{
+		ClientAppID:     utils.String(clientAppId),
+		ServerAppID:     utils.String(serverAppId),
+		ServerAppSecret: utils.String(serverAppSecret),
+		TenantID:        utils.String(tenantId),
+	}
+
+	return rbacEnabled, &profile
+}
+
+func flattenAzureRmKubernetesClusterRoleBasedAccessControl(input *containerservice.ManagedClusterProperties, d *schema.ResourceData) []interface{} {
+	rbacEnabled := false
+	if input.EnableRBAC != nil {
+		rbacEnabled = *input.EnableRBAC
+	}
+
+	results := make([]interface{}, 0)
+	if profile := input.AadProfile; profile != nil {
+		output := make(map[string]interface{})
+
+		if profile.ClientAppID != nil {
+			output["client_app_id"] = *profile.ClientAppID
+		}
+
+		if profile.ServerAppID != nil {
+			output["server_app_id"] = *profile.ServerAppID
+		}
+
+		// The Server App Secret isn't returned from the API - so we need to look it up
+		serverAppSecret := ""
+		if existing, ok := d.GetOk("role_based_access_control.0.azure_active_directory.0.server_app_secret"); ok {
+			serverAppSecret = existing.(string)
+		}
+		output["server_app_secret"] = serverAppSecret
+
+		if profile.TenantID != nil {
+			output["tenant_id"] = *profile.TenantID
+		}
+
+		results = append(results, output)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled":                rbacEnabled,
+			"azure_active_directory": results,
+		},
+	}
+}
+
 func resourceAzureRMKubernetesClusterServicePrincipalProfileHash(v interface{}) int {
 	var buf bytes.Buffer
 
@@ -8,18 +8,18 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/operationalinsights/mgmt/2015-11-01-preview/operationalinsights"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmLogAnalyticsWorkspace() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmLogAnalyticsWorkspaceCreateUpdate,
-		Read:   resourceArmLogAnalyticsWorkspaceRead,
-		Update: resourceArmLogAnalyticsWorkspaceCreateUpdate,
-		Delete: resourceArmLogAnalyticsWorkspaceDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmLogAnalyticsWorkspaceCreateUpdate,
+		Read:     resourceArmLogAnalyticsWorkspaceRead,
+		Update:   resourceArmLogAnalyticsWorkspaceCreateUpdate,
+		Delete:   resourceArmLogAnalyticsWorkspaceDelete,
+		Importer: azure.ImporterValidatingResourceId("workspaces"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -85,7 +85,8 @@ func resourceArmLogAnalyticsWorkspace() *schema.Resource {
 
 func resourceArmLogAnalyticsWorkspaceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).workspacesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM Log Analytics workspace creation.")
 
 	name := d.Get("name").(string)
@@ -138,13 +139,17 @@ func resourceArmLogAnalyticsWorkspaceCreateUpdate(d *schema.ResourceData, meta i
 
 func resourceArmLogAnalyticsWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).workspacesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["workspaces"]
+	name, err := id.PopSegment("workspaces")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resGroup, name)
 	if err != nil {
@@ -182,13 +187,17 @@ func resourceArmLogAnalyticsWorkspaceRead(d *schema.ResourceData, meta interface
 
 func resourceArmLogAnalyticsWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).workspacesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["workspaces"]
+	name, err := id.PopSegment("workspaces")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Delete(ctx, resGroup, name)
 
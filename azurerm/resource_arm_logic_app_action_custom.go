@@ -14,13 +14,11 @@ import (
 
 func resourceArmLogicAppActionCustom() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmLogicAppActionCustomCreateUpdate,
-		Read:   resourceArmLogicAppActionCustomRead,
-		Update: resourceArmLogicAppActionCustomCreateUpdate,
-		Delete: resourceArmLogicAppActionCustomDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmLogicAppActionCustomCreateUpdate,
+		Read:     resourceArmLogicAppActionCustomRead,
+		Update:   resourceArmLogicAppActionCustomCreateUpdate,
+		Delete:   resourceArmLogicAppActionCustomDelete,
+		Importer: azure.ImporterValidatingResourceId("workflows", "actions"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -72,8 +70,14 @@ func resourceArmLogicAppActionCustomRead(d *schema.ResourceData, meta interface{
 	}
 
 	resourceGroup := id.ResourceGroup
-	logicAppName := id.Path["workflows"]
-	name := id.Path["actions"]
+	logicAppName, err := id.PopSegment("workflows")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("actions")
+	if err != nil {
+		return err
+	}
 
 	t, app, err := retrieveLogicAppAction(meta, resourceGroup, logicAppName, name)
 	if err != nil {
@@ -110,8 +114,14 @@ func resourceArmLogicAppActionCustomDelete(d *schema.ResourceData, meta interfac
 	}
 
 	resourceGroup := id.ResourceGroup
-	logicAppName := id.Path["workflows"]
-	name := id.Path["actions"]
+	logicAppName, err := id.PopSegment("workflows")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("actions")
+	if err != nil {
+		return err
+	}
 
 	err = resourceLogicAppActionRemove(d, meta, resourceGroup, logicAppName, name)
 	if err != nil {
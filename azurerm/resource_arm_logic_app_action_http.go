@@ -14,13 +14,11 @@ import (
 
 func resourceArmLogicAppActionHTTP() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmLogicAppActionHTTPCreateUpdate,
-		Read:   resourceArmLogicAppActionHTTPRead,
-		Update: resourceArmLogicAppActionHTTPCreateUpdate,
-		Delete: resourceArmLogicAppActionHTTPDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmLogicAppActionHTTPCreateUpdate,
+		Read:     resourceArmLogicAppActionHTTPRead,
+		Update:   resourceArmLogicAppActionHTTPCreateUpdate,
+		Delete:   resourceArmLogicAppActionHTTPDelete,
+		Importer: azure.ImporterValidatingResourceId("workflows", "actions"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -105,8 +103,14 @@ func resourceArmLogicAppActionHTTPRead(d *schema.ResourceData, meta interface{})
 	}
 
 	resourceGroup := id.ResourceGroup
-	logicAppName := id.Path["workflows"]
-	name := id.Path["actions"]
+	logicAppName, err := id.PopSegment("workflows")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("actions")
+	if err != nil {
+		return err
+	}
 
 	t, app, err := retrieveLogicAppAction(meta, resourceGroup, logicAppName, name)
 	if err != nil {
@@ -168,8 +172,14 @@ func resourceArmLogicAppActionHTTPDelete(d *schema.ResourceData, meta interface{
 	}
 
 	resourceGroup := id.ResourceGroup
-	logicAppName := id.Path["workflows"]
-	name := id.Path["actions"]
+	logicAppName, err := id.PopSegment("workflows")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("actions")
+	if err != nil {
+		return err
+	}
 
 	err = resourceLogicAppActionRemove(d, meta, resourceGroup, logicAppName, name)
 	if err != nil {
@@ -14,13 +14,11 @@ import (
 
 func resourceArmLogicAppTriggerCustom() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmLogicAppTriggerCustomCreateUpdate,
-		Read:   resourceArmLogicAppTriggerCustomRead,
-		Update: resourceArmLogicAppTriggerCustomCreateUpdate,
-		Delete: resourceArmLogicAppTriggerCustomDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmLogicAppTriggerCustomCreateUpdate,
+		Read:     resourceArmLogicAppTriggerCustomRead,
+		Update:   resourceArmLogicAppTriggerCustomCreateUpdate,
+		Delete:   resourceArmLogicAppTriggerCustomDelete,
+		Importer: azure.ImporterValidatingResourceId("workflows", "triggers"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -72,8 +70,14 @@ func resourceArmLogicAppTriggerCustomRead(d *schema.ResourceData, meta interface
 	}
 
 	resourceGroup := id.ResourceGroup
-	logicAppName := id.Path["workflows"]
-	name := id.Path["triggers"]
+	logicAppName, err := id.PopSegment("workflows")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("triggers")
+	if err != nil {
+		return err
+	}
 
 	t, app, err := retrieveLogicAppTrigger(meta, resourceGroup, logicAppName, name)
 	if err != nil {
@@ -110,8 +114,14 @@ func resourceArmLogicAppTriggerCustomDelete(d *schema.ResourceData, meta interfa
 	}
 
 	resourceGroup := id.ResourceGroup
-	logicAppName := id.Path["workflows"]
-	name := id.Path["triggers"]
+	logicAppName, err := id.PopSegment("workflows")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("triggers")
+	if err != nil {
+		return err
+	}
 
 	err = resourceLogicAppTriggerRemove(d, meta, resourceGroup, logicAppName, name)
 	if err != nil {
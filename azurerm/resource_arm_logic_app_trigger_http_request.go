@@ -15,13 +15,11 @@ import (
 
 func resourceArmLogicAppTriggerHttpRequest() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmLogicAppTriggerHttpRequestCreateUpdate,
-		Read:   resourceArmLogicAppTriggerHttpRequestRead,
-		Update: resourceArmLogicAppTriggerHttpRequestCreateUpdate,
-		Delete: resourceArmLogicAppTriggerHttpRequestDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmLogicAppTriggerHttpRequestCreateUpdate,
+		Read:     resourceArmLogicAppTriggerHttpRequestRead,
+		Update:   resourceArmLogicAppTriggerHttpRequestCreateUpdate,
+		Delete:   resourceArmLogicAppTriggerHttpRequestDelete,
+		Importer: azure.ImporterValidatingResourceId("workflows", "triggers"),
 
 		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
 
@@ -121,8 +119,14 @@ func resourceArmLogicAppTriggerHttpRequestRead(d *schema.ResourceData, meta inte
 	}
 
 	resourceGroup := id.ResourceGroup
-	logicAppName := id.Path["workflows"]
-	name := id.Path["triggers"]
+	logicAppName, err := id.PopSegment("workflows")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("triggers")
+	if err != nil {
+		return err
+	}
 
 	t, app, err := retrieveLogicAppTrigger(meta, resourceGroup, logicAppName, name)
 	if err != nil {
@@ -177,8 +181,14 @@ func resourceArmLogicAppTriggerHttpRequestDelete(d *schema.ResourceData, meta in
 	}
 
 	resourceGroup := id.ResourceGroup
-	logicAppName := id.Path["workflows"]
-	name := id.Path["triggers"]
+	logicAppName, err := id.PopSegment("workflows")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("triggers")
+	if err != nil {
+		return err
+	}
 
 	err = resourceLogicAppTriggerRemove(d, meta, resourceGroup, logicAppName, name)
 	if err != nil {
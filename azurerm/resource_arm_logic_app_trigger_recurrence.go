@@ -11,13 +11,11 @@ import (
 
 func resourceArmLogicAppTriggerRecurrence() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmLogicAppTriggerRecurrenceCreateUpdate,
-		Read:   resourceArmLogicAppTriggerRecurrenceRead,
-		Update: resourceArmLogicAppTriggerRecurrenceCreateUpdate,
-		Delete: resourceArmLogicAppTriggerRecurrenceDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmLogicAppTriggerRecurrenceCreateUpdate,
+		Read:     resourceArmLogicAppTriggerRecurrenceRead,
+		Update:   resourceArmLogicAppTriggerRecurrenceCreateUpdate,
+		Delete:   resourceArmLogicAppTriggerRecurrenceDelete,
+		Importer: azure.ImporterValidatingResourceId("workflows", "triggers"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -81,8 +79,14 @@ func resourceArmLogicAppTriggerRecurrenceRead(d *schema.ResourceData, meta inter
 	}
 
 	resourceGroup := id.ResourceGroup
-	logicAppName := id.Path["workflows"]
-	name := id.Path["triggers"]
+	logicAppName, err := id.PopSegment("workflows")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("triggers")
+	if err != nil {
+		return err
+	}
 
 	t, app, err := retrieveLogicAppTrigger(meta, resourceGroup, logicAppName, name)
 	if err != nil {
@@ -128,8 +132,14 @@ func resourceArmLogicAppTriggerRecurrenceDelete(d *schema.ResourceData, meta int
 	}
 
 	resourceGroup := id.ResourceGroup
-	logicAppName := id.Path["workflows"]
-	name := id.Path["triggers"]
+	logicAppName, err := id.PopSegment("workflows")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("triggers")
+	if err != nil {
+		return err
+	}
 
 	err = resourceLogicAppTriggerRemove(d, meta, resourceGroup, logicAppName, name)
 	if err != nil {
@@ -8,19 +8,19 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmManagedDisk() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmManagedDiskCreate,
-		Read:   resourceArmManagedDiskRead,
-		Update: resourceArmManagedDiskCreate,
-		Delete: resourceArmManagedDiskDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmManagedDiskCreate,
+		Read:     resourceArmManagedDiskRead,
+		Update:   resourceArmManagedDiskCreate,
+		Delete:   resourceArmManagedDiskDelete,
+		Importer: azure.ImporterValidatingResourceId("disks"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -111,7 +111,8 @@ func validateDiskSizeGB(v interface{}, k string) (ws []string, errors []error) {
 
 func resourceArmManagedDiskCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).diskClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Managed Disk creation.")
 
@@ -209,14 +210,18 @@ func resourceArmManagedDiskCreate(d *schema.ResourceData, meta interface{}) erro
 
 func resourceArmManagedDiskRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).diskClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["disks"]
+	name, err := id.PopSegment("disks")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resGroup, name)
 	if err != nil {
@@ -266,14 +271,18 @@ func resourceArmManagedDiskRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmManagedDiskDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).diskClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["disks"]
+	name, err := id.PopSegment("disks")
+	if err != nil {
+		return err
+	}
 
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
@@ -8,7 +8,10 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2018-03-01-preview/management"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -21,15 +24,26 @@ func resourceArmManagementGroup() *schema.Resource {
 		Read:   resourceArmManagementGroupRead,
 		Delete: resourceArmManagementGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceArmManagementGroupImport,
 		},
 
 		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  validate.ManagementGroupName(),
+				ConflictsWith: []string{"group_id"},
+			},
+
 			"group_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Deprecated:    "group_id has been renamed to name to match the Azure API",
+				ConflictsWith: []string{"name"},
 			},
 
 			"display_name": {
@@ -57,10 +71,14 @@ func resourceArmManagementGroup() *schema.Resource {
 func resourceArmManagementGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).managementGroupsClient
 	subscriptionsClient := meta.(*ArmClient).managementGroupsSubscriptionClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	armTenantID := meta.(*ArmClient).tenantId
 
-	groupId := d.Get("group_id").(string)
+	groupId := d.Get("name").(string)
+	if groupId == "" {
+		groupId = d.Get("group_id").(string)
+	}
 	if groupId == "" {
 		groupId = uuid.New().String()
 	}
@@ -69,32 +87,49 @@ func resourceArmManagementGroupCreateUpdate(d *schema.ResourceData, meta interfa
 		parentManagementGroupId = fmt.Sprintf("/providers/Microsoft.Management/managementGroups/%s", armTenantID)
 	}
 
-	log.Printf("[INFO] Creating Management Group %q", groupId)
-
-	properties := managementgroups.CreateManagementGroupRequest{
-		Name: utils.String(groupId),
-		CreateManagementGroupProperties: &managementgroups.CreateManagementGroupProperties{
-			TenantID: utils.String(armTenantID),
-			Details: &managementgroups.CreateManagementGroupDetails{
-				Parent: &managementgroups.CreateParentGroupInfo{
-					ID: utils.String(parentManagementGroupId),
+	if d.IsNewResource() {
+		log.Printf("[INFO] Creating Management Group %q", groupId)
+
+		properties := managementgroups.CreateManagementGroupRequest{
+			Name: utils.String(groupId),
+			CreateManagementGroupProperties: &managementgroups.CreateManagementGroupProperties{
+				TenantID: utils.String(armTenantID),
+				Details: &managementgroups.CreateManagementGroupDetails{
+					Parent: &managementgroups.CreateParentGroupInfo{
+						ID: utils.String(parentManagementGroupId),
+					},
 				},
 			},
-		},
-	}
+		}
 
-	if v, ok := d.GetOk("display_name"); ok {
-		properties.CreateManagementGroupProperties.DisplayName = utils.String(v.(string))
-	}
+		if v, ok := d.GetOk("display_name"); ok {
+			properties.CreateManagementGroupProperties.DisplayName = utils.String(v.(string))
+		}
 
-	future, err := client.CreateOrUpdate(ctx, groupId, properties, managementGroupCacheControl)
-	if err != nil {
-		return fmt.Errorf("Error creating Management Group %q: %+v", groupId, err)
-	}
+		future, err := client.CreateOrUpdate(ctx, groupId, properties, managementGroupCacheControl)
+		if err != nil {
+			return fmt.Errorf("Error creating Management Group %q: %+v", groupId, err)
+		}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
-	if err != nil {
-		return fmt.Errorf("Error waiting for creation of Management Group %q: %+v", groupId, err)
+		err = future.WaitForCompletionRef(ctx, client.Client)
+		if err != nil {
+			return fmt.Errorf("Error waiting for creation of Management Group %q: %+v", groupId, err)
+		}
+	} else if d.HasChange("display_name") || d.HasChange("parent_management_group_id") {
+		// updating the Parent Management Group moves this Management Group (and its children) rather than recreating it
+		log.Printf("[INFO] Updating Management Group %q", groupId)
+
+		patch := managementgroups.PatchManagementGroupRequest{
+			ParentID: utils.String(parentManagementGroupId),
+		}
+
+		if v, ok := d.GetOk("display_name"); ok {
+			patch.DisplayName = utils.String(v.(string))
+		}
+
+		if _, err := client.Update(ctx, groupId, patch, managementGroupCacheControl); err != nil {
+			return fmt.Errorf("Error updating Management Group %q: %+v", groupId, err)
+		}
 	}
 
 	recurse := false
@@ -143,7 +178,8 @@ func resourceArmManagementGroupCreateUpdate(d *schema.ResourceData, meta interfa
 
 func resourceArmManagementGroupRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).managementGroupsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseManagementGroupId(d.Id())
 	if err != nil {
@@ -162,6 +198,7 @@ func resourceArmManagementGroupRead(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("Error reading Management Group %q: %+v", d.Id(), err)
 	}
 
+	d.Set("name", id.groupId)
 	d.Set("group_id", id.groupId)
 
 	if props := resp.Properties; props != nil {
@@ -191,7 +228,8 @@ func resourceArmManagementGroupRead(d *schema.ResourceData, meta interface{}) er
 func resourceArmManagementGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).managementGroupsClient
 	subscriptionsClient := meta.(*ArmClient).managementGroupsSubscriptionClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseManagementGroupId(d.Id())
 	if err != nil {
@@ -243,6 +281,19 @@ func resourceArmManagementGroupDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+func resourceArmManagementGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// allow importing using either the Management Group ID or just the Name (e.g. `group1`)
+	if !strings.Contains(d.Id(), "/") {
+		d.SetId(fmt.Sprintf("/providers/Microsoft.Management/managementGroups/%s", d.Id()))
+	}
+
+	if _, errors := validateManagementGroupID(d.Id(), "id"); len(errors) > 0 {
+		return nil, fmt.Errorf("Error parsing Management Group ID %q: %+v", d.Id(), errors[0])
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func expandManagementGroupSubscriptionIds(input *schema.Set) []string {
 	output := make([]string, 0)
 
@@ -289,39 +340,68 @@ type subscriptionId struct {
 
 func parseManagementGroupId(input string) (*managementGroupId, error) {
 	// /providers/Microsoft.Management/managementGroups/00000000-0000-0000-0000-000000000000
-	segments := strings.Split(input, "/")
-	if len(segments) != 5 {
-		return nil, fmt.Errorf("Expected there to be 5 segments but got %d", len(segments))
+	parsed, err := azure.ParseResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if parsed.SubscriptionID != "" || parsed.ResourceGroup != "" || !strings.EqualFold(parsed.Provider, "Microsoft.Management") {
+		return nil, fmt.Errorf("Expected the ID to be in the format `/providers/Microsoft.Management/managementGroups/{groupId}` but got %q", input)
+	}
+
+	groupId, err := parsed.PopSegment("managementGroups")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(parsed.Path) > 0 {
+		return nil, fmt.Errorf("Expected the ID to be in the format `/providers/Microsoft.Management/managementGroups/{groupId}` but got %q", input)
 	}
 
 	id := managementGroupId{
-		groupId: segments[4],
+		groupId: groupId,
 	}
 	return &id, nil
 }
 
+func validateManagementGroupID(i interface{}, k string) (_ []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	// /providers/Microsoft.Management/managementGroups/group1
+	segments := strings.Split(v, "/")
+	if len(segments) != 5 || segments[0] != "" || !strings.EqualFold(segments[1], "providers") ||
+		!strings.EqualFold(segments[2], "Microsoft.Management") || !strings.EqualFold(segments[3], "managementGroups") || segments[4] == "" {
+		errors = append(errors, fmt.Errorf("%q must be a Management Group ID in the format `/providers/Microsoft.Management/managementGroups/{groupId}`, got %q", k, v))
+	}
+
+	return
+}
+
 func parseManagementGroupSubscriptionID(input string) (*subscriptionId, error) {
 	// this is either:
 	// /subscriptions/00000000-0000-0000-0000-000000000000
 	// /providers/Microsoft.Management/managementGroups/e4115b99-6be7-4153-a73f-5ff5e778ce28
 
 	// we skip out the managementGroup ID's
-	if strings.HasPrefix(input, "/providers/Microsoft.Management/managementGroups/") {
+	if strings.HasPrefix(strings.ToLower(input), "/providers/microsoft.management/managementgroups/") {
 		return nil, nil
 	}
 
-	components := strings.Split(input, "/")
-
-	if len(components) == 0 {
+	parsed, err := azure.ParseResourceID(input)
+	if err != nil {
 		return nil, fmt.Errorf("Subscription Id is empty or not formatted correctly: %s", input)
 	}
 
-	if len(components) != 3 {
-		return nil, fmt.Errorf("Subscription Id should have 2 segments, got %d: %q", len(components)-1, input)
+	if parsed.SubscriptionID == "" || parsed.ResourceGroup != "" || parsed.Provider != "" || len(parsed.Path) > 0 {
+		return nil, fmt.Errorf("Expected the Subscription ID to be in the format `/subscriptions/{subscriptionId}` but got %q", input)
 	}
 
 	id := subscriptionId{
-		subscriptionId: components[2],
+		subscriptionId: parsed.SubscriptionID,
 	}
 	return &id, nil
 }
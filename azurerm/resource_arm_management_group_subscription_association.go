@@ -0,0 +1,188 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2018-03-01-preview/management"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmManagementGroupSubscriptionAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmManagementGroupSubscriptionAssociationCreate,
+		Read:   resourceArmManagementGroupSubscriptionAssociationRead,
+		Delete: resourceArmManagementGroupSubscriptionAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"management_group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateManagementGroupID,
+			},
+
+			"subscription_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+		},
+	}
+}
+
+func resourceArmManagementGroupSubscriptionAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).managementGroupsClient
+	subscriptionsClient := meta.(*ArmClient).managementGroupsSubscriptionClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	managementGroupId := d.Get("management_group_id").(string)
+	subscriptionId := d.Get("subscription_id").(string)
+
+	groupId, err := parseManagementGroupId(managementGroupId)
+	if err != nil {
+		return err
+	}
+
+	recurse := false
+	group, err := client.Get(ctx, groupId.groupId, "", &recurse, "", managementGroupCacheControl)
+	if err != nil {
+		if utils.ResponseWasNotFound(group.Response) {
+			return fmt.Errorf("Management Group %q was not found!", groupId.groupId)
+		}
+
+		return fmt.Errorf("Error retrieving Management Group %q: %+v", groupId.groupId, err)
+	}
+
+	log.Printf("[INFO] Associating Subscription %q with Management Group %q", subscriptionId, groupId.groupId)
+	if _, err := subscriptionsClient.Create(ctx, groupId.groupId, subscriptionId, managementGroupCacheControl); err != nil {
+		return fmt.Errorf("Error associating Subscription %q with Management Group %q: %+v", subscriptionId, groupId.groupId, err)
+	}
+
+	d.SetId(managementGroupSubscriptionAssociationID(groupId.groupId, subscriptionId))
+
+	return resourceArmManagementGroupSubscriptionAssociationRead(d, meta)
+}
+
+func resourceArmManagementGroupSubscriptionAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).managementGroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseManagementGroupSubscriptionAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	recurse := false
+	group, err := client.Get(ctx, id.groupId, "children", &recurse, "", managementGroupCacheControl)
+	if err != nil {
+		if utils.ResponseWasNotFound(group.Response) {
+			log.Printf("[INFO] Management Group %q doesn't exist - removing Subscription Association from state", id.groupId)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Management Group %q: %+v", id.groupId, err)
+	}
+
+	found := false
+	if props := group.Properties; props != nil {
+		found = managementGroupHasSubscription(props.Children, id.subscriptionId)
+	}
+
+	if !found {
+		log.Printf("[INFO] Subscription %q is no longer associated with Management Group %q - removing from state", id.subscriptionId, id.groupId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("management_group_id", group.ID)
+	d.Set("subscription_id", id.subscriptionId)
+
+	return nil
+}
+
+func resourceArmManagementGroupSubscriptionAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	subscriptionsClient := meta.(*ArmClient).managementGroupsSubscriptionClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseManagementGroupSubscriptionAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] De-associating Subscription %q from Management Group %q..", id.subscriptionId, id.groupId)
+	// NOTE: whilst this says `Delete` it's actually `Deassociate` - which returns the Subscription to the Root Management Group
+	resp, err := subscriptionsClient.Delete(ctx, id.groupId, id.subscriptionId, managementGroupCacheControl)
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error de-associating Subscription %q from Management Group %q: %+v", id.subscriptionId, id.groupId, err)
+		}
+	}
+
+	return nil
+}
+
+type managementGroupSubscriptionAssociationId struct {
+	groupId        string
+	subscriptionId string
+}
+
+func managementGroupSubscriptionAssociationID(groupId, subscriptionId string) string {
+	return fmt.Sprintf("/providers/Microsoft.Management/managementGroups/%s/subscriptions/%s", groupId, subscriptionId)
+}
+
+func parseManagementGroupSubscriptionAssociationID(input string) (*managementGroupSubscriptionAssociationId, error) {
+	// /providers/Microsoft.Management/managementGroups/group1/subscriptions/00000000-0000-0000-0000-000000000000
+	parsed, err := azure.ParseResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	groupId, err := parsed.PopSegment("managementGroups")
+	if err != nil || parsed.SubscriptionID == "" || parsed.ResourceGroup != "" || !strings.EqualFold(parsed.Provider, "Microsoft.Management") || len(parsed.Path) > 0 {
+		return nil, fmt.Errorf("Expected the ID to be in the format `/providers/Microsoft.Management/managementGroups/{groupId}/subscriptions/{subscriptionId}` but got %q", input)
+	}
+
+	id := managementGroupSubscriptionAssociationId{
+		groupId:        groupId,
+		subscriptionId: parsed.SubscriptionID,
+	}
+	return &id, nil
+}
+
+func managementGroupHasSubscription(children *[]managementgroups.ChildInfo, subscriptionId string) bool {
+	if children == nil {
+		return false
+	}
+
+	for _, child := range *children {
+		if child.ID == nil {
+			continue
+		}
+
+		id, err := parseManagementGroupSubscriptionID(*child.ID)
+		if err != nil || id == nil {
+			continue
+		}
+
+		if strings.EqualFold(id.subscriptionId, subscriptionId) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,154 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAzureRMManagementGroupSubscriptionAssociation_parseID(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected *managementGroupSubscriptionAssociationId
+	}{
+		{
+			Input:    "",
+			Expected: nil,
+		},
+		{
+			Input:    "/providers/Microsoft.Management/managementGroups/group1",
+			Expected: nil,
+		},
+		{
+			Input:    "/providers/Microsoft.Management/managementGroups/group1/resourceGroups/00000000-0000-0000-0000-000000000000",
+			Expected: nil,
+		},
+		{
+			Input: "/providers/Microsoft.Management/managementGroups/group1/subscriptions/00000000-0000-0000-0000-000000000000",
+			Expected: &managementGroupSubscriptionAssociationId{
+				groupId:        "group1",
+				subscriptionId: "00000000-0000-0000-0000-000000000000",
+			},
+		},
+	}
+
+	for _, v := range cases {
+		actual, err := parseManagementGroupSubscriptionAssociationID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected no error for %q but got: %+v", v.Input, err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error for %q but didn't get one", v.Input)
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
+
+func TestAccAzureRMManagementGroupSubscriptionAssociation_basic(t *testing.T) {
+	resourceName := "azurerm_management_group_subscription_association.test"
+	subscriptionID := os.Getenv("ARM_SUBSCRIPTION_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagementGroupSubscriptionAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAzureRMManagementGroupSubscriptionAssociation_basic(subscriptionID),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagementGroupSubscriptionAssociationExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMManagementGroupSubscriptionAssociationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		id, err := parseManagementGroupSubscriptionAssociationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).managementGroupsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		recurse := false
+		resp, err := client.Get(ctx, id.groupId, "children", &recurse, "", "no-cache")
+		if err != nil {
+			return fmt.Errorf("Bad: Get on managementGroupsClient: %s", err)
+		}
+
+		if props := resp.Properties; props == nil || !managementGroupHasSubscription(props.Children, id.subscriptionId) {
+			return fmt.Errorf("Subscription %q is not associated with Management Group %q", id.subscriptionId, id.groupId)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMManagementGroupSubscriptionAssociationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).managementGroupsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_management_group_subscription_association" {
+			continue
+		}
+
+		id, err := parseManagementGroupSubscriptionAssociationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		recurse := false
+		resp, err := client.Get(ctx, id.groupId, "children", &recurse, "", "no-cache")
+		if err != nil {
+			return nil
+		}
+
+		if props := resp.Properties; props != nil && managementGroupHasSubscription(props.Children, id.subscriptionId) {
+			return fmt.Errorf("Subscription %q is still associated with Management Group %q", id.subscriptionId, id.groupId)
+		}
+	}
+
+	return nil
+}
+
+// TODO: switch this out for dynamically creating a subscription once that's supported in the future
+func testAzureRMManagementGroupSubscriptionAssociation_basic(subscriptionID string) string {
+	return fmt.Sprintf(`
+resource "azurerm_management_group" "test" {
+  # Subscriptions are assigned using the azurerm_management_group_subscription_association resource
+  lifecycle {
+    ignore_changes = ["subscription_ids"]
+  }
+}
+
+resource "azurerm_management_group_subscription_association" "test" {
+  management_group_id = "${azurerm_management_group.test.id}"
+  subscription_id     = "%s"
+}
+`, subscriptionID)
+}
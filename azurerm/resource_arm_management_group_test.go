@@ -11,6 +11,55 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func TestAzureRMManagementGroup_import(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected string
+		error    bool
+	}{
+		{
+			input: "",
+			error: true,
+		},
+		{
+			input:    "group1",
+			expected: "/providers/Microsoft.Management/managementGroups/group1",
+		},
+		{
+			input:    "/providers/Microsoft.Management/managementGroups/group1",
+			expected: "/providers/Microsoft.Management/managementGroups/group1",
+		},
+		{
+			input: "/subscriptions/00000000-0000-0000-0000-000000000000",
+			error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.input)
+
+		d := resourceArmManagementGroup().Data(nil)
+		d.SetId(v.input)
+
+		results, err := resourceArmManagementGroupImport(d, nil)
+		if err != nil {
+			if v.error {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if v.error {
+			t.Fatalf("Expected an error but didn't get one for %q", v.input)
+		}
+
+		if actual := results[0].Id(); actual != v.expected {
+			t.Fatalf("Expected %q but got %q", v.expected, actual)
+		}
+	}
+}
+
 func TestAccAzureRMManagementGroup_basic(t *testing.T) {
 	resourceName := "azurerm_management_group.test"
 
@@ -104,6 +153,32 @@ func TestAccAzureRMManagementGroup_multiLevelUpdated(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMManagementGroup_updateParent(t *testing.T) {
+	resourceName := "azurerm_management_group.child"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagementGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAzureRMManagementGroup_moved("first"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagementGroupExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "parent_management_group_id", "azurerm_management_group.first", "id"),
+				),
+			},
+			{
+				Config: testAzureRMManagementGroup_moved("second"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagementGroupExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "parent_management_group_id", "azurerm_management_group.second", "id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMManagementGroup_withName(t *testing.T) {
 	resourceName := "azurerm_management_group.test"
 	ri := acctest.RandInt()
@@ -149,6 +224,39 @@ func TestAccAzureRMManagementGroup_updateName(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMManagementGroup_customName(t *testing.T) {
+	resourceName := "azurerm_management_group.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagementGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAzureRMManagementGroup_customName(ri),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagementGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("acctestmg-%d", ri)),
+					resource.TestCheckResourceAttr(resourceName, "group_id", fmt.Sprintf("acctestmg-%d", ri)),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "Acceptance Test Management Group"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("acctestmg-%d", ri),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAzureRMManagementGroup_withSubscriptions(t *testing.T) {
 	resourceName := "azurerm_management_group.test"
 	subscriptionID := os.Getenv("ARM_SUBSCRIPTION_ID")
@@ -267,6 +375,20 @@ resource "azurerm_management_group" "child" {
 `)
 }
 
+func testAzureRMManagementGroup_moved(parent string) string {
+	return fmt.Sprintf(`
+resource "azurerm_management_group" "first" {
+}
+
+resource "azurerm_management_group" "second" {
+}
+
+resource "azurerm_management_group" "child" {
+  parent_management_group_id = "${azurerm_management_group.%s.id}"
+}
+`, parent)
+}
+
 func testAzureRMManagementGroup_withName(rInt int) string {
 	return fmt.Sprintf(`
 resource "azurerm_management_group" "test" {
@@ -275,6 +397,15 @@ resource "azurerm_management_group" "test" {
 `, rInt)
 }
 
+func testAzureRMManagementGroup_customName(rInt int) string {
+	return fmt.Sprintf(`
+resource "azurerm_management_group" "test" {
+  name         = "acctestmg-%d"
+  display_name = "Acceptance Test Management Group"
+}
+`, rInt)
+}
+
 // TODO: switch this out for dynamically creating a subscription once that's supported in the future
 func testAzureRMManagementGroup_withSubscriptions(subscriptionID string) string {
 	return fmt.Sprintf(`
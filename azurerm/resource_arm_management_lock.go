@@ -4,22 +4,21 @@ import (
 	"fmt"
 	"log"
 	"regexp"
-	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmManagementLock() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmManagementLockCreateUpdate,
-		Read:   resourceArmManagementLockRead,
-		Delete: resourceArmManagementLockDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmManagementLockCreateUpdate,
+		Read:     resourceArmManagementLockRead,
+		Delete:   resourceArmManagementLockDelete,
+		Importer: azure.ImporterValidatingScopedResourceId("Microsoft.Authorization", "locks"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -57,7 +56,8 @@ func resourceArmManagementLock() *schema.Resource {
 
 func resourceArmManagementLockCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).managementLocksClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM Management Lock creation.")
 
 	name := d.Get("name").(string)
@@ -92,7 +92,8 @@ func resourceArmManagementLockCreateUpdate(d *schema.ResourceData, meta interfac
 
 func resourceArmManagementLockRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).managementLocksClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureRMLockId(d.Id())
 	if err != nil {
@@ -121,7 +122,8 @@ func resourceArmManagementLockRead(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmManagementLockDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).managementLocksClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureRMLockId(d.Id())
 	if err != nil {
@@ -146,16 +148,14 @@ type AzureManagementLockId struct {
 }
 
 func parseAzureRMLockId(id string) (*AzureManagementLockId, error) {
-	segments := strings.Split(id, "/providers/Microsoft.Authorization/locks/")
-	if len(segments) != 2 {
-		return nil, fmt.Errorf("Expected ID to be in the format `{scope}/providers/Microsoft.Authorization/locks/{name} - got %d segments", len(segments))
+	parsed, err := azure.ParseScopedResourceID(id, "Microsoft.Authorization", "locks")
+	if err != nil {
+		return nil, err
 	}
 
-	scope := segments[0]
-	name := segments[1]
 	lockId := AzureManagementLockId{
-		Scope: scope,
-		Name:  name,
+		Scope: parsed.Scope,
+		Name:  parsed.Name,
 	}
 	return &lockId, nil
 }
@@ -6,19 +6,19 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmMonitorActionGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmMonitorActionGroupCreateOrUpdate,
-		Read:   resourceArmMonitorActionGroupRead,
-		Update: resourceArmMonitorActionGroupCreateOrUpdate,
-		Delete: resourceArmMonitorActionGroupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmMonitorActionGroupCreateOrUpdate,
+		Read:     resourceArmMonitorActionGroupRead,
+		Update:   resourceArmMonitorActionGroupCreateOrUpdate,
+		Delete:   resourceArmMonitorActionGroupDelete,
+		Importer: azure.ImporterValidatingResourceId("actionGroups"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -111,7 +111,8 @@ func resourceArmMonitorActionGroup() *schema.Resource {
 
 func resourceArmMonitorActionGroupCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).monitorActionGroupsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
@@ -158,14 +159,18 @@ func resourceArmMonitorActionGroupCreateOrUpdate(d *schema.ResourceData, meta in
 
 func resourceArmMonitorActionGroupRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).monitorActionGroupsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["actionGroups"]
+	name, err := id.PopSegment("actionGroups")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resGroup, name)
 	if err != nil {
@@ -203,14 +208,18 @@ func resourceArmMonitorActionGroupRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmMonitorActionGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).monitorActionGroupsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["actionGroups"]
+	name, err := id.PopSegment("actionGroups")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
@@ -6,17 +6,17 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmMySQLConfiguration() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmMySQLConfigurationCreate,
-		Read:   resourceArmMySQLConfigurationRead,
-		Delete: resourceArmMySQLConfigurationDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmMySQLConfigurationCreate,
+		Read:     resourceArmMySQLConfigurationRead,
+		Delete:   resourceArmMySQLConfigurationDelete,
+		Importer: azure.ImporterValidatingResourceId("servers", "configurations"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -44,7 +44,8 @@ func resourceArmMySQLConfiguration() *schema.Resource {
 
 func resourceArmMySQLConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).mysqlConfigurationsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM MySQL Configuration creation.")
 
@@ -85,15 +86,22 @@ func resourceArmMySQLConfigurationCreate(d *schema.ResourceData, meta interface{
 
 func resourceArmMySQLConfigurationRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).mysqlConfigurationsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	serverName := id.Path["servers"]
-	name := id.Path["configurations"]
+	serverName, err := id.PopSegment("servers")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("configurations")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resourceGroup, serverName, name)
 	if err != nil {
@@ -116,15 +124,22 @@ func resourceArmMySQLConfigurationRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmMySQLConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).mysqlConfigurationsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	serverName := id.Path["servers"]
-	name := id.Path["configurations"]
+	serverName, err := id.PopSegment("servers")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("configurations")
+	if err != nil {
+		return err
+	}
 
 	// "delete" = resetting this to the default value
 	resp, err := client.Get(ctx, resourceGroup, serverName, name)
@@ -6,17 +6,17 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmMySqlDatabase() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmMySqlDatabaseCreate,
-		Read:   resourceArmMySqlDatabaseRead,
-		Delete: resourceArmMySqlDatabaseDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmMySqlDatabaseCreate,
+		Read:     resourceArmMySqlDatabaseRead,
+		Delete:   resourceArmMySqlDatabaseDelete,
+		Importer: azure.ImporterValidatingResourceId("servers", "databases"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -51,7 +51,8 @@ func resourceArmMySqlDatabase() *schema.Resource {
 
 func resourceArmMySqlDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).mysqlDatabasesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM MySQL Database creation.")
 
@@ -94,7 +95,8 @@ func resourceArmMySqlDatabaseCreate(d *schema.ResourceData, meta interface{}) er
 
 func resourceArmMySqlDatabaseRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).mysqlDatabasesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -102,8 +104,14 @@ func resourceArmMySqlDatabaseRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	resourceGroup := id.ResourceGroup
-	serverName := id.Path["servers"]
-	name := id.Path["databases"]
+	serverName, err := id.PopSegment("servers")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("databases")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resourceGroup, serverName, name)
 	if err != nil {
@@ -125,15 +133,22 @@ func resourceArmMySqlDatabaseRead(d *schema.ResourceData, meta interface{}) erro
 
 func resourceArmMySqlDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).mysqlDatabasesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	serverName := id.Path["servers"]
-	name := id.Path["databases"]
+	serverName, err := id.PopSegment("servers")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("databases")
+	if err != nil {
+		return err
+	}
 
 	future, err := client.Delete(ctx, resGroup, serverName, name)
 	if err != nil {
@@ -6,18 +6,18 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmMySqlFirewallRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmMySqlFirewallRuleCreateUpdate,
-		Read:   resourceArmMySqlFirewallRuleRead,
-		Update: resourceArmMySqlFirewallRuleCreateUpdate,
-		Delete: resourceArmMySqlFirewallRuleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmMySqlFirewallRuleCreateUpdate,
+		Read:     resourceArmMySqlFirewallRuleRead,
+		Update:   resourceArmMySqlFirewallRuleCreateUpdate,
+		Delete:   resourceArmMySqlFirewallRuleDelete,
+		Importer: azure.ImporterValidatingResourceId("servers", "firewallRules"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -49,7 +49,8 @@ func resourceArmMySqlFirewallRule() *schema.Resource {
 
 func resourceArmMySqlFirewallRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).mysqlFirewallRulesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM MySQL Firewall Rule creation.")
 
@@ -91,15 +92,22 @@ func resourceArmMySqlFirewallRuleCreateUpdate(d *schema.ResourceData, meta inter
 
 func resourceArmMySqlFirewallRuleRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).mysqlFirewallRulesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	serverName := id.Path["servers"]
-	name := id.Path["firewallRules"]
+	serverName, err := id.PopSegment("servers")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("firewallRules")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resourceGroup, serverName, name)
 	if err != nil {
@@ -121,15 +129,22 @@ func resourceArmMySqlFirewallRuleRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmMySqlFirewallRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).mysqlFirewallRulesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	serverName := id.Path["servers"]
-	name := id.Path["firewallRules"]
+	serverName, err := id.PopSegment("servers")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("firewallRules")
+	if err != nil {
+		return err
+	}
 
 	future, err := client.Delete(ctx, resourceGroup, serverName, name)
 	if err != nil {
@@ -13,19 +13,18 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmMySqlVirtualNetworkRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmMySqlVirtualNetworkRuleCreateUpdate,
-		Read:   resourceArmMySqlVirtualNetworkRuleRead,
-		Update: resourceArmMySqlVirtualNetworkRuleCreateUpdate,
-		Delete: resourceArmMySqlVirtualNetworkRuleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmMySqlVirtualNetworkRuleCreateUpdate,
+		Read:     resourceArmMySqlVirtualNetworkRuleRead,
+		Update:   resourceArmMySqlVirtualNetworkRuleCreateUpdate,
+		Delete:   resourceArmMySqlVirtualNetworkRuleDelete,
+		Importer: azure.ImporterValidatingResourceId("servers", "virtualNetworkRules"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -55,7 +54,8 @@ func resourceArmMySqlVirtualNetworkRule() *schema.Resource {
 
 func resourceArmMySqlVirtualNetworkRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).mysqlVirtualNetworkRulesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	serverName := d.Get("server_name").(string)
@@ -138,7 +138,8 @@ func resourceArmMySqlVirtualNetworkRuleCreateUpdate(d *schema.ResourceData, meta
 
 func resourceArmMySqlVirtualNetworkRuleRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).mysqlVirtualNetworkRulesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -146,8 +147,14 @@ func resourceArmMySqlVirtualNetworkRuleRead(d *schema.ResourceData, meta interfa
 	}
 
 	resourceGroup := id.ResourceGroup
-	serverName := id.Path["servers"]
-	name := id.Path["virtualNetworkRules"]
+	serverName, err := id.PopSegment("servers")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("virtualNetworkRules")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resourceGroup, serverName, name)
 	if err != nil {
@@ -173,7 +180,8 @@ func resourceArmMySqlVirtualNetworkRuleRead(d *schema.ResourceData, meta interfa
 
 func resourceArmMySqlVirtualNetworkRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).mysqlVirtualNetworkRulesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -181,8 +189,14 @@ func resourceArmMySqlVirtualNetworkRuleDelete(d *schema.ResourceData, meta inter
 	}
 
 	resourceGroup := id.ResourceGroup
-	serverName := id.Path["servers"]
-	name := id.Path["virtualNetworkRules"]
+	serverName, err := id.PopSegment("servers")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("virtualNetworkRules")
+	if err != nil {
+		return err
+	}
 
 	future, err := client.Delete(ctx, resourceGroup, serverName, name)
 	if err != nil {
@@ -6,18 +6,18 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmNetworkSecurityRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmNetworkSecurityRuleCreate,
-		Read:   resourceArmNetworkSecurityRuleRead,
-		Update: resourceArmNetworkSecurityRuleCreate,
-		Delete: resourceArmNetworkSecurityRuleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmNetworkSecurityRuleCreate,
+		Read:     resourceArmNetworkSecurityRuleRead,
+		Update:   resourceArmNetworkSecurityRuleCreate,
+		Delete:   resourceArmNetworkSecurityRuleDelete,
+		Importer: azure.ImporterValidatingResourceId("networkSecurityGroups", "securityRules"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -154,7 +154,8 @@ func resourceArmNetworkSecurityRule() *schema.Resource {
 
 func resourceArmNetworkSecurityRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).secRuleClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	nsgName := d.Get("network_security_group_name").(string)
@@ -278,15 +279,22 @@ func resourceArmNetworkSecurityRuleCreate(d *schema.ResourceData, meta interface
 
 func resourceArmNetworkSecurityRuleRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).secRuleClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	networkSGName := id.Path["networkSecurityGroups"]
-	sgRuleName := id.Path["securityRules"]
+	networkSGName, err := id.PopSegment("networkSecurityGroups")
+	if err != nil {
+		return err
+	}
+	sgRuleName, err := id.PopSegment("securityRules")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resGroup, networkSGName, sgRuleName)
 	if err != nil {
@@ -322,15 +330,22 @@ func resourceArmNetworkSecurityRuleRead(d *schema.ResourceData, meta interface{}
 
 func resourceArmNetworkSecurityRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).secRuleClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	nsgName := id.Path["networkSecurityGroups"]
-	sgRuleName := id.Path["securityRules"]
+	nsgName, err := id.PopSegment("networkSecurityGroups")
+	if err != nil {
+		return err
+	}
+	sgRuleName, err := id.PopSegment("securityRules")
+	if err != nil {
+		return err
+	}
 
 	azureRMLockByName(nsgName, networkSecurityGroupResourceName)
 	defer azureRMUnlockByName(nsgName, networkSecurityGroupResourceName)
@@ -5,19 +5,19 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmNetworkWatcher() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmNetworkWatcherCreateUpdate,
-		Read:   resourceArmNetworkWatcherRead,
-		Update: resourceArmNetworkWatcherCreateUpdate,
-		Delete: resourceArmNetworkWatcherDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmNetworkWatcherCreateUpdate,
+		Read:     resourceArmNetworkWatcherRead,
+		Update:   resourceArmNetworkWatcherCreateUpdate,
+		Delete:   resourceArmNetworkWatcherDelete,
+		Importer: azure.ImporterValidatingResourceId("networkWatchers"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -37,7 +37,8 @@ func resourceArmNetworkWatcher() *schema.Resource {
 
 func resourceArmNetworkWatcherCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).watcherClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -68,14 +69,18 @@ func resourceArmNetworkWatcherCreateUpdate(d *schema.ResourceData, meta interfac
 
 func resourceArmNetworkWatcherRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).watcherClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["networkWatchers"]
+	name, err := id.PopSegment("networkWatchers")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -99,14 +104,18 @@ func resourceArmNetworkWatcherRead(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmNetworkWatcherDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).watcherClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["networkWatchers"]
+	name, err := id.PopSegment("networkWatchers")
+	if err != nil {
+		return err
+	}
 
 	future, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
@@ -0,0 +1,358 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Flow Logs aren't a standalone resource within Azure, but a configuration on the Network Watcher
+// for a given Network Security Group - as such the ID is comprised of both of these ID's
+const networkWatcherFlowLogIDSeparator = "/networkSecurityGroupId"
+
+type networkWatcherFlowLogID struct {
+	resourceGroup          string
+	networkWatcherName     string
+	networkSecurityGroupID string
+}
+
+func resourceArmNetworkWatcherFlowLog() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmNetworkWatcherFlowLogCreateUpdate,
+		Read:   resourceArmNetworkWatcherFlowLogRead,
+		Update: resourceArmNetworkWatcherFlowLogCreateUpdate,
+		Delete: resourceArmNetworkWatcherFlowLogDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				if _, err := parseNetworkWatcherFlowLogID(d.Id()); err != nil {
+					return nil, err
+				}
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_watcher_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"network_security_group_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+
+			"storage_account_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+
+			"retention_policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"days": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+
+			"traffic_analytics": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"workspace_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"workspace_region": {
+							Type:             schema.TypeString,
+							Required:         true,
+							StateFunc:        azureRMNormalizeLocation,
+							DiffSuppressFunc: azureRMSuppressLocationDiff,
+						},
+
+						"workspace_resource_id": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     azure.ValidateResourceID,
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmNetworkWatcherFlowLogCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).watcherClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	networkWatcherName := d.Get("network_watcher_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	networkSecurityGroupID := d.Get("network_security_group_id").(string)
+
+	parameters := network.FlowLogInformation{
+		TargetResourceID: utils.String(networkSecurityGroupID),
+		FlowLogProperties: &network.FlowLogProperties{
+			StorageID:       utils.String(d.Get("storage_account_id").(string)),
+			Enabled:         utils.Bool(d.Get("enabled").(bool)),
+			RetentionPolicy: expandNetworkWatcherFlowLogRetentionPolicy(d.Get("retention_policy").([]interface{})),
+		},
+		FlowAnalyticsConfiguration: expandNetworkWatcherFlowLogTrafficAnalytics(d.Get("traffic_analytics").([]interface{})),
+	}
+
+	future, err := client.SetFlowLogConfiguration(ctx, resourceGroup, networkWatcherName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error configuring Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q): %+v", networkSecurityGroupID, networkWatcherName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for configuration of Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q): %+v", networkSecurityGroupID, networkWatcherName, resourceGroup, err)
+	}
+
+	if d.IsNewResource() {
+		watcher, err := client.Get(ctx, resourceGroup, networkWatcherName)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Network Watcher %q (Resource Group %q): %+v", networkWatcherName, resourceGroup, err)
+		}
+		if watcher.ID == nil {
+			return fmt.Errorf("Cannot read Network Watcher %q (Resource Group %q) ID", networkWatcherName, resourceGroup)
+		}
+
+		d.SetId(fmt.Sprintf("%s%s%s", *watcher.ID, networkWatcherFlowLogIDSeparator, networkSecurityGroupID))
+	}
+
+	return resourceArmNetworkWatcherFlowLogRead(d, meta)
+}
+
+func resourceArmNetworkWatcherFlowLogRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).watcherClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseNetworkWatcherFlowLogID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parameters := network.FlowLogStatusParameters{
+		TargetResourceID: utils.String(id.networkSecurityGroupID),
+	}
+	future, err := client.GetFlowLogStatus(ctx, id.resourceGroup, id.networkWatcherName, parameters)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			log.Printf("[DEBUG] Network Watcher %q (Resource Group %q) was not found - removing Flow Log from state", id.networkWatcherName, id.resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q): %+v", id.networkSecurityGroupID, id.networkWatcherName, id.resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for retrieval of Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q): %+v", id.networkSecurityGroupID, id.networkWatcherName, id.resourceGroup, err)
+	}
+
+	resp, err := future.Result(client)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q): %+v", id.networkSecurityGroupID, id.networkWatcherName, id.resourceGroup, err)
+	}
+
+	d.Set("network_watcher_name", id.networkWatcherName)
+	d.Set("resource_group_name", id.resourceGroup)
+	d.Set("network_security_group_id", id.networkSecurityGroupID)
+
+	if props := resp.FlowLogProperties; props != nil {
+		d.Set("storage_account_id", props.StorageID)
+		d.Set("enabled", props.Enabled)
+
+		if err := d.Set("retention_policy", flattenNetworkWatcherFlowLogRetentionPolicy(props.RetentionPolicy)); err != nil {
+			return fmt.Errorf("Error setting `retention_policy`: %+v", err)
+		}
+	}
+
+	if err := d.Set("traffic_analytics", flattenNetworkWatcherFlowLogTrafficAnalytics(resp.FlowAnalyticsConfiguration)); err != nil {
+		return fmt.Errorf("Error setting `traffic_analytics`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmNetworkWatcherFlowLogDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).watcherClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseNetworkWatcherFlowLogID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Flow Logs can't be removed, only disabled - the Storage Account must still be specified
+	parameters := network.FlowLogInformation{
+		TargetResourceID: utils.String(id.networkSecurityGroupID),
+		FlowLogProperties: &network.FlowLogProperties{
+			StorageID: utils.String(d.Get("storage_account_id").(string)),
+			Enabled:   utils.Bool(false),
+		},
+		FlowAnalyticsConfiguration: &network.TrafficAnalyticsProperties{
+			NetworkWatcherFlowAnalyticsConfiguration: &network.TrafficAnalyticsConfigurationProperties{
+				Enabled: utils.Bool(false),
+			},
+		},
+	}
+
+	future, err := client.SetFlowLogConfiguration(ctx, id.resourceGroup, id.networkWatcherName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error disabling Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q): %+v", id.networkSecurityGroupID, id.networkWatcherName, id.resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q) to be disabled: %+v", id.networkSecurityGroupID, id.networkWatcherName, id.resourceGroup, err)
+	}
+
+	return nil
+}
+
+func parseNetworkWatcherFlowLogID(input string) (*networkWatcherFlowLogID, error) {
+	segments := strings.SplitN(input, networkWatcherFlowLogIDSeparator, 2)
+	if len(segments) != 2 || segments[1] == "" {
+		return nil, fmt.Errorf("Expected the Flow Log ID %q to be in the format `{networkWatcherId}%s{networkSecurityGroupId}`", input, networkWatcherFlowLogIDSeparator)
+	}
+
+	watcherID, err := parseAzureResourceID(segments[0])
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Network Watcher ID %q: %+v", segments[0], err)
+	}
+
+	watcherName, err := watcherID.PopSegment("networkWatchers")
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := parseAzureResourceID(segments[1]); err != nil {
+		return nil, fmt.Errorf("Error parsing Network Security Group ID %q: %+v", segments[1], err)
+	}
+
+	return &networkWatcherFlowLogID{
+		resourceGroup:          watcherID.ResourceGroup,
+		networkWatcherName:     watcherName,
+		networkSecurityGroupID: segments[1],
+	}, nil
+}
+
+func expandNetworkWatcherFlowLogRetentionPolicy(input []interface{}) *network.RetentionPolicyParameters {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &network.RetentionPolicyParameters{
+		Enabled: utils.Bool(v["enabled"].(bool)),
+		Days:    utils.Int32(int32(v["days"].(int))),
+	}
+}
+
+func flattenNetworkWatcherFlowLogRetentionPolicy(input *network.RetentionPolicyParameters) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	result := make(map[string]interface{})
+	if input.Enabled != nil {
+		result["enabled"] = *input.Enabled
+	}
+	if input.Days != nil {
+		result["days"] = int(*input.Days)
+	}
+
+	return []interface{}{result}
+}
+
+func expandNetworkWatcherFlowLogTrafficAnalytics(input []interface{}) *network.TrafficAnalyticsProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &network.TrafficAnalyticsProperties{
+		NetworkWatcherFlowAnalyticsConfiguration: &network.TrafficAnalyticsConfigurationProperties{
+			Enabled:             utils.Bool(v["enabled"].(bool)),
+			WorkspaceID:         utils.String(v["workspace_id"].(string)),
+			WorkspaceRegion:     utils.String(azureRMNormalizeLocation(v["workspace_region"].(string))),
+			WorkspaceResourceID: utils.String(v["workspace_resource_id"].(string)),
+		},
+	}
+}
+
+func flattenNetworkWatcherFlowLogTrafficAnalytics(input *network.TrafficAnalyticsProperties) []interface{} {
+	if input == nil || input.NetworkWatcherFlowAnalyticsConfiguration == nil {
+		return []interface{}{}
+	}
+
+	config := input.NetworkWatcherFlowAnalyticsConfiguration
+
+	// when Traffic Analytics has never been configured the API returns an empty block
+	if config.WorkspaceID == nil || *config.WorkspaceID == "" {
+		return []interface{}{}
+	}
+
+	result := make(map[string]interface{})
+	if config.Enabled != nil {
+		result["enabled"] = *config.Enabled
+	}
+	result["workspace_id"] = *config.WorkspaceID
+	if config.WorkspaceRegion != nil {
+		result["workspace_region"] = azureRMNormalizeLocation(*config.WorkspaceRegion)
+	}
+	if config.WorkspaceResourceID != nil {
+		result["workspace_resource_id"] = *config.WorkspaceResourceID
+	}
+
+	return []interface{}{result}
+}
@@ -0,0 +1,324 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestParseNetworkWatcherFlowLogID(t *testing.T) {
+	watcherID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkWatchers/watcher1"
+	nsgID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group2/providers/Microsoft.Network/networkSecurityGroups/nsg1"
+
+	testCases := []struct {
+		Input    string
+		Expected *networkWatcherFlowLogID
+	}{
+		{
+			Input: "",
+		},
+		{
+			Input: watcherID,
+		},
+		{
+			Input: watcherID + "/networkSecurityGroupId",
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/networkSecurityGroupId" + nsgID,
+		},
+		{
+			Input: watcherID + "/networkSecurityGroupId" + nsgID,
+			Expected: &networkWatcherFlowLogID{
+				resourceGroup:          "group1",
+				networkWatcherName:     "watcher1",
+				networkSecurityGroupID: nsgID,
+			},
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := parseNetworkWatcherFlowLogID(v.Input)
+		if v.Expected == nil {
+			if err == nil {
+				t.Fatalf("Expected an error parsing %q but didn't get one", v.Input)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Error parsing %q: %+v", v.Input, err)
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_basic(t *testing.T) {
+	resourceName := "azurerm_network_watcher_flow_log.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(5)
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkWatcherFlowLogDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkWatcherFlowLog_basicConfig(ri, rs, location, true, 7),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkWatcherFlowLogExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "retention_policy.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "retention_policy.0.days", "7"),
+				),
+			},
+			{
+				Config: testAccAzureRMNetworkWatcherFlowLog_basicConfig(ri, rs, location, true, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkWatcherFlowLogExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "retention_policy.0.days", "30"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_disabled(t *testing.T) {
+	resourceName := "azurerm_network_watcher_flow_log.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(5)
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkWatcherFlowLogDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkWatcherFlowLog_basicConfig(ri, rs, location, true, 7),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkWatcherFlowLogExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccAzureRMNetworkWatcherFlowLog_basicConfig(ri, rs, location, false, 7),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_trafficAnalytics(t *testing.T) {
+	resourceName := "azurerm_network_watcher_flow_log.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(5)
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkWatcherFlowLogDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkWatcherFlowLog_trafficAnalyticsConfig(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkWatcherFlowLogExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "traffic_analytics.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_analytics.0.enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "traffic_analytics.0.workspace_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "traffic_analytics.0.workspace_resource_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMNetworkWatcherFlowLogExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseNetworkWatcherFlowLogID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, notFound, err := testGetAzureRMNetworkWatcherFlowLog(id)
+		if err != nil {
+			return err
+		}
+
+		if notFound || resp.FlowLogProperties == nil || resp.FlowLogProperties.StorageID == nil {
+			return fmt.Errorf("Bad: Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q) isn't configured", id.networkSecurityGroupID, id.networkWatcherName, id.resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMNetworkWatcherFlowLogDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_network_watcher_flow_log" {
+			continue
+		}
+
+		id, err := parseNetworkWatcherFlowLogID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, notFound, err := testGetAzureRMNetworkWatcherFlowLog(id)
+		if err != nil {
+			return err
+		}
+
+		// the Network Watcher (and as such, the Flow Log) has been removed
+		if notFound {
+			continue
+		}
+
+		if props := resp.FlowLogProperties; props != nil && props.Enabled != nil && *props.Enabled {
+			return fmt.Errorf("Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q) is still enabled", id.networkSecurityGroupID, id.networkWatcherName, id.resourceGroup)
+		}
+	}
+
+	return nil
+}
+
+func testGetAzureRMNetworkWatcherFlowLog(id *networkWatcherFlowLogID) (*network.FlowLogInformation, bool, error) {
+	client := testAccProvider.Meta().(*ArmClient).watcherClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	parameters := network.FlowLogStatusParameters{
+		TargetResourceID: utils.String(id.networkSecurityGroupID),
+	}
+	future, err := client.GetFlowLogStatus(ctx, id.resourceGroup, id.networkWatcherName, parameters)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil, true, nil
+		}
+
+		return nil, false, fmt.Errorf("Bad: GetFlowLogStatus on watcherClient: %+v", err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return nil, false, fmt.Errorf("Bad: waiting for GetFlowLogStatus on watcherClient: %+v", err)
+	}
+
+	resp, err := future.Result(client)
+	if err != nil {
+		return nil, false, fmt.Errorf("Bad: retrieving result of GetFlowLogStatus on watcherClient: %+v", err)
+	}
+
+	return &resp, false, nil
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_network_watcher" "test" {
+  name                = "acctestnw-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_network_security_group" "test" {
+  name                = "acctestnsg-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_kind             = "StorageV2"
+  account_replication_type = "LRS"
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_basicConfig(rInt int, rString string, location string, enabled bool, retentionDays int) string {
+	template := testAccAzureRMNetworkWatcherFlowLog_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_watcher_flow_log" "test" {
+  network_watcher_name      = "${azurerm_network_watcher.test.name}"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  network_security_group_id = "${azurerm_network_security_group.test.id}"
+  storage_account_id        = "${azurerm_storage_account.test.id}"
+  enabled                   = %t
+
+  retention_policy {
+    enabled = true
+    days    = %d
+  }
+}
+`, template, enabled, retentionDays)
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_trafficAnalyticsConfig(rInt int, rString string, location string) string {
+	template := testAccAzureRMNetworkWatcherFlowLog_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestlaw-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_network_watcher_flow_log" "test" {
+  network_watcher_name      = "${azurerm_network_watcher.test.name}"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  network_security_group_id = "${azurerm_network_security_group.test.id}"
+  storage_account_id        = "${azurerm_storage_account.test.id}"
+  enabled                   = true
+
+  retention_policy {
+    enabled = true
+    days    = 7
+  }
+
+  traffic_analytics {
+    enabled               = true
+    workspace_id          = "${azurerm_log_analytics_workspace.test.workspace_id}"
+    workspace_region      = "${azurerm_log_analytics_workspace.test.location}"
+    workspace_resource_id = "${azurerm_log_analytics_workspace.test.id}"
+  }
+}
+`, template, rInt)
+}
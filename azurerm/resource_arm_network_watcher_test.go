@@ -24,6 +24,11 @@ func TestAccAzureRMNetworkWatcher(t *testing.T) {
 			"importBasic":    testAccAzureRMNetworkWatcher_importBasic,
 			"importComplete": testAccAzureRMNetworkWatcher_importComplete,
 		},
+		"FlowLog": {
+			"basic":            testAccAzureRMNetworkWatcherFlowLog_basic,
+			"disabled":         testAccAzureRMNetworkWatcherFlowLog_disabled,
+			"trafficAnalytics": testAccAzureRMNetworkWatcherFlowLog_trafficAnalytics,
+		},
 		"PacketCapture": {
 			"import":                     testAccAzureRMPacketCapture_importBasic,
 			"localDisk":                  testAccAzureRMPacketCapture_localDisk,
@@ -7,6 +7,8 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/notificationhubs/mgmt/2017-04-01/notificationhubs"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -17,13 +19,11 @@ const apnsSandboxEndpoint = "https://api.development.push.apple.com:443/3/device
 
 func resourceArmNotificationHub() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmNotificationHubCreateUpdate,
-		Read:   resourceArmNotificationHubRead,
-		Update: resourceArmNotificationHubCreateUpdate,
-		Delete: resourceArmNotificationHubDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmNotificationHubCreateUpdate,
+		Read:     resourceArmNotificationHubRead,
+		Update:   resourceArmNotificationHubCreateUpdate,
+		Delete:   resourceArmNotificationHubDelete,
+		Importer: azure.ImporterValidatingResourceId("namespaces", "notificationHubs"),
 		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
 			// NOTE: the ForceNew is to workaround a bug in the Azure SDK where nil-values aren't sent to the API.
 			// Bug: https://github.com/Azure/azure-sdk-for-go/issues/2246
@@ -125,7 +125,8 @@ func resourceArmNotificationHub() *schema.Resource {
 
 func resourceArmNotificationHubCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).notificationHubsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	namespaceName := d.Get("namespace_name").(string)
@@ -172,15 +173,22 @@ func resourceArmNotificationHubCreateUpdate(d *schema.ResourceData, meta interfa
 
 func resourceArmNotificationHubRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).notificationHubsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
-	name := id.Path["notificationHubs"]
+	namespaceName, err := id.PopSegment("namespaces")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("notificationHubs")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resourceGroup, namespaceName, name)
 	if err != nil {
@@ -222,15 +230,22 @@ func resourceArmNotificationHubRead(d *schema.ResourceData, meta interface{}) er
 
 func resourceArmNotificationHubDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).notificationHubsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
-	name := id.Path["notificationHubs"]
+	namespaceName, err := id.PopSegment("namespaces")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("notificationHubs")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Delete(ctx, resourceGroup, namespaceName, name)
 	if err != nil {
@@ -6,18 +6,18 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/notificationhubs/mgmt/2017-04-01/notificationhubs"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmNotificationHubAuthorizationRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmNotificationHubAuthorizationRuleCreateUpdate,
-		Read:   resourceArmNotificationHubAuthorizationRuleRead,
-		Update: resourceArmNotificationHubAuthorizationRuleCreateUpdate,
-		Delete: resourceArmNotificationHubAuthorizationRuleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmNotificationHubAuthorizationRuleCreateUpdate,
+		Read:     resourceArmNotificationHubAuthorizationRuleRead,
+		Update:   resourceArmNotificationHubAuthorizationRuleCreateUpdate,
+		Delete:   resourceArmNotificationHubAuthorizationRuleDelete,
+		Importer: azure.ImporterValidatingResourceId("namespaces", "notificationHubs", "AuthorizationRules"),
 		// TODO: customizeDiff for send+listen when manage selected
 
 		Schema: map[string]*schema.Schema{
@@ -74,7 +74,8 @@ func resourceArmNotificationHubAuthorizationRule() *schema.Resource {
 
 func resourceArmNotificationHubAuthorizationRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).notificationHubsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	notificationHubName := d.Get("notification_hub_name").(string)
@@ -111,16 +112,26 @@ func resourceArmNotificationHubAuthorizationRuleCreateUpdate(d *schema.ResourceD
 
 func resourceArmNotificationHubAuthorizationRuleRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).notificationHubsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
-	notificationHubName := id.Path["notificationHubs"]
-	name := id.Path["AuthorizationRules"]
+	namespaceName, err := id.PopSegment("namespaces")
+	if err != nil {
+		return err
+	}
+	notificationHubName, err := id.PopSegment("notificationHubs")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("AuthorizationRules")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.GetAuthorizationRule(ctx, resourceGroup, namespaceName, notificationHubName, name)
 	if err != nil {
@@ -158,16 +169,26 @@ func resourceArmNotificationHubAuthorizationRuleRead(d *schema.ResourceData, met
 
 func resourceArmNotificationHubAuthorizationRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).notificationHubsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
-	notificationHubName := id.Path["notificationHubs"]
-	name := id.Path["AuthorizationRules"]
+	namespaceName, err := id.PopSegment("namespaces")
+	if err != nil {
+		return err
+	}
+	notificationHubName, err := id.PopSegment("notificationHubs")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("AuthorizationRules")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.DeleteAuthorizationRule(ctx, resourceGroup, namespaceName, notificationHubName, name)
 	if err != nil {
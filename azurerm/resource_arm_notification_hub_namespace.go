@@ -5,25 +5,24 @@ import (
 	"fmt"
 	"log"
 	"strconv"
-	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/notificationhubs/mgmt/2017-04-01/notificationhubs"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmNotificationHubNamespace() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmNotificationHubNamespaceCreateUpdate,
-		Read:   resourceArmNotificationHubNamespaceRead,
-		Update: resourceArmNotificationHubNamespaceCreateUpdate,
-		Delete: resourceArmNotificationHubNamespaceDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmNotificationHubNamespaceCreateUpdate,
+		Read:     resourceArmNotificationHubNamespaceRead,
+		Update:   resourceArmNotificationHubNamespaceCreateUpdate,
+		Delete:   resourceArmNotificationHubNamespaceDelete,
+		Importer: azure.ImporterValidatingResourceId("namespaces"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -86,7 +85,8 @@ func resourceArmNotificationHubNamespace() *schema.Resource {
 
 func resourceArmNotificationHubNamespaceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).notificationNamespacesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -126,14 +126,18 @@ func resourceArmNotificationHubNamespaceCreateUpdate(d *schema.ResourceData, met
 
 func resourceArmNotificationHubNamespaceRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).notificationNamespacesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["namespaces"]
+	name, err := id.PopSegment("namespaces")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -168,14 +172,18 @@ func resourceArmNotificationHubNamespaceRead(d *schema.ResourceData, meta interf
 
 func resourceArmNotificationHubNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).notificationNamespacesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["namespaces"]
+	name, err := id.PopSegment("namespaces")
+	if err != nil {
+		return err
+	}
 
 	future, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
@@ -191,7 +199,7 @@ func resourceArmNotificationHubNamespaceDelete(d *schema.ResourceData, meta inte
 		Pending: []string{"200", "202"},
 		Target:  []string{"404"},
 		Refresh: notificationHubNamespaceStateRefreshFunc(ctx, client, resourceGroup, name),
-		Timeout: 10 * time.Minute,
+		Timeout: d.Timeout(schema.TimeoutDelete),
 	}
 	if _, err := stateConf.WaitForState(); err != nil {
 		return fmt.Errorf("Error waiting for Notification Hub %q (Resource Group %q) to be deleted: %s", name, resourceGroup, err)
@@ -8,18 +8,18 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmPacketCapture() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmPacketCaptureCreate,
-		Read:   resourceArmPacketCaptureRead,
-		Delete: resourceArmPacketCaptureDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmPacketCaptureCreate,
+		Read:     resourceArmPacketCaptureRead,
+		Delete:   resourceArmPacketCaptureDelete,
+		Importer: azure.ImporterValidatingResourceId("networkWatchers", "packetCaptures"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -132,7 +132,8 @@ func resourceArmPacketCapture() *schema.Resource {
 
 func resourceArmPacketCaptureCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).packetCapturesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	watcherName := d.Get("network_watcher_name").(string)
@@ -186,7 +187,8 @@ func resourceArmPacketCaptureCreate(d *schema.ResourceData, meta interface{}) er
 
 func resourceArmPacketCaptureRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).packetCapturesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -194,8 +196,14 @@ func resourceArmPacketCaptureRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	resourceGroup := id.ResourceGroup
-	watcherName := id.Path["networkWatchers"]
-	name := id.Path["packetCaptures"]
+	watcherName, err := id.PopSegment("networkWatchers")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("packetCaptures")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resourceGroup, watcherName, name)
 	if err != nil {
@@ -234,7 +242,8 @@ func resourceArmPacketCaptureRead(d *schema.ResourceData, meta interface{}) erro
 
 func resourceArmPacketCaptureDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).packetCapturesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -242,8 +251,14 @@ func resourceArmPacketCaptureDelete(d *schema.ResourceData, meta interface{}) er
 	}
 
 	resourceGroup := id.ResourceGroup
-	watcherName := id.Path["networkWatchers"]
-	name := id.Path["packetCaptures"]
+	watcherName, err := id.PopSegment("networkWatchers")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("packetCaptures")
+	if err != nil {
+		return err
+	}
 
 	future, err := client.Delete(ctx, resourceGroup, watcherName, name)
 	if err != nil {
@@ -14,17 +14,17 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmPolicyAssignment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmPolicyAssignmentCreate,
-		Read:   resourceArmPolicyAssignmentRead,
-		Delete: resourceArmPolicyAssignmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmPolicyAssignmentCreate,
+		Read:     resourceArmPolicyAssignmentRead,
+		Delete:   resourceArmPolicyAssignmentDelete,
+		Importer: azure.ImporterValidatingScopedResourceId("Microsoft.Authorization", "policyAssignments"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -70,7 +70,8 @@ func resourceArmPolicyAssignment() *schema.Resource {
 
 func resourceArmPolicyAssignmentCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).policyAssignmentsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	scope := d.Get("scope").(string)
@@ -130,7 +131,8 @@ func resourceArmPolicyAssignmentCreate(d *schema.ResourceData, meta interface{})
 
 func resourceArmPolicyAssignmentRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).policyAssignmentsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id := d.Id()
 
@@ -169,7 +171,8 @@ func resourceArmPolicyAssignmentRead(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmPolicyAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).policyAssignmentsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id := d.Id()
 
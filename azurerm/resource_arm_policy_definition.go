@@ -2,8 +2,11 @@ package azurerm
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"reflect"
+	"regexp"
 	"strings"
 
 	"time"
@@ -15,6 +18,8 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -28,6 +33,14 @@ func resourceArmPolicyDefinition() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		CustomizeDiff: resourceArmPolicyDefinitionCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -73,11 +86,17 @@ func resourceArmPolicyDefinition() *schema.Resource {
 				DiffSuppressFunc: structure.SuppressJsonDiff,
 			},
 
+			"category": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
 			"metadata": {
 				Type:             schema.TypeString,
 				Optional:         true,
 				ValidateFunc:     validation.ValidateJsonString,
-				DiffSuppressFunc: structure.SuppressJsonDiff,
+				DiffSuppressFunc: policyDefinitionsMetadataDiffSuppressFunc,
 			},
 
 			"parameters": {
@@ -90,9 +109,29 @@ func resourceArmPolicyDefinition() *schema.Resource {
 	}
 }
 
+func resourceArmPolicyDefinitionCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	// NOTE: values which aren't known until apply-time are returned as an empty string here - since these
+	// can't be told apart from values which aren't set, the check is skipped when either is empty and is
+	// instead performed when the Policy Definition is created/updated, where the values are known
+	policyRule := diff.Get("policy_rule").(string)
+	parameters := diff.Get("parameters").(string)
+	if policyRule == "" || parameters == "" {
+		return nil
+	}
+
+	return validatePolicyDefinitionParametersAreDeclared(policyRule, parameters)
+}
+
 func resourceArmPolicyDefinitionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).policyDefinitionsClient
-	ctx := meta.(*ArmClient).StopContext
+
+	// Policy Definitions are eventually consistent, which in larger tenants can take some time
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if !d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutUpdate)
+	}
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, timeout)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	policyType := d.Get("policy_type").(string)
@@ -107,6 +146,10 @@ func resourceArmPolicyDefinitionCreateUpdate(d *schema.ResourceData, meta interf
 		Description: utils.String(description),
 	}
 
+	if err := validatePolicyDefinitionParametersAreDeclared(d.Get("policy_rule").(string), d.Get("parameters").(string)); err != nil {
+		return err
+	}
+
 	if policyRuleString := d.Get("policy_rule").(string); policyRuleString != "" {
 		policyRule, err := structure.ExpandJsonFromString(policyRuleString)
 		if err != nil {
@@ -115,11 +158,18 @@ func resourceArmPolicyDefinitionCreateUpdate(d *schema.ResourceData, meta interf
 		properties.PolicyRule = &policyRule
 	}
 
-	if metaDataString := d.Get("metadata").(string); metaDataString != "" {
-		metaData, err := structure.ExpandJsonFromString(metaDataString)
-		if err != nil {
-			return fmt.Errorf("unable to parse metadata: %s", err)
-		}
+	// when only the `category` has changed the diff for `metadata` is suppressed, in which case this is the
+	// `metadata` from the state - so the previous `category` is replaced rather than treated as a conflict
+	previousCategory := ""
+	if !d.IsNewResource() && !d.HasChange("metadata") {
+		old, _ := d.GetChange("category")
+		previousCategory = old.(string)
+	}
+	metaData, err := expandPolicyDefinitionMetadata(d.Get("metadata").(string), d.Get("category").(string), previousCategory)
+	if err != nil {
+		return err
+	}
+	if metaData != nil {
 		properties.Metadata = &metaData
 	}
 
@@ -136,8 +186,7 @@ func resourceArmPolicyDefinitionCreateUpdate(d *schema.ResourceData, meta interf
 		DefinitionProperties: &properties,
 	}
 
-	_, err := client.CreateOrUpdate(ctx, name, definition)
-	if err != nil {
+	if _, err := client.CreateOrUpdate(ctx, name, definition); err != nil {
 		return err
 	}
 
@@ -147,7 +196,7 @@ func resourceArmPolicyDefinitionCreateUpdate(d *schema.ResourceData, meta interf
 		Pending:                   []string{"404"},
 		Target:                    []string{"200"},
 		Refresh:                   policyDefinitionRefreshFunc(ctx, client, name),
-		Timeout:                   5 * time.Minute,
+		Timeout:                   timeout,
 		MinTimeout:                10 * time.Second,
 		ContinuousTargetOccurence: 10,
 	}
@@ -167,7 +216,8 @@ func resourceArmPolicyDefinitionCreateUpdate(d *schema.ResourceData, meta interf
 
 func resourceArmPolicyDefinitionRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).policyDefinitionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name, err := parsePolicyDefinitionNameFromId(d.Id())
 	if err != nil {
@@ -211,6 +261,15 @@ func resourceArmPolicyDefinitionRead(d *schema.ResourceData, meta interface{}) e
 			}
 
 			d.Set("metadata", metadataStr)
+
+			// the `category` is only tracked when it's managed via the `category` field, rather than within `metadata`
+			if d.Get("category").(string) != "" {
+				category := ""
+				if v, ok := metadataVal["category"].(string); ok {
+					category = v
+				}
+				d.Set("category", category)
+			}
 		}
 
 		if parameters := props.Parameters; parameters != nil {
@@ -229,7 +288,8 @@ func resourceArmPolicyDefinitionRead(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmPolicyDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).policyDefinitionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 
 	name, err := parsePolicyDefinitionNameFromId(d.Id())
 	if err != nil {
@@ -250,17 +310,17 @@ func resourceArmPolicyDefinitionDelete(d *schema.ResourceData, meta interface{})
 }
 
 func parsePolicyDefinitionNameFromId(id string) (string, error) {
-	components := strings.Split(id, "/")
-
-	if len(components) == 0 {
-		return "", fmt.Errorf("Azure Policy Definition Id is empty or not formatted correctly: %s", id)
+	// /subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyDefinitions/{name}
+	parsed, err := azure.ParseResourceID(id)
+	if err != nil {
+		return "", fmt.Errorf("Error parsing Azure Policy Definition ID %q: %+v", id, err)
 	}
 
-	if len(components) != 7 {
-		return "", fmt.Errorf("Azure Policy Definition Id should have 6 segments, got %d: '%s'", len(components)-1, id)
+	if parsed.SubscriptionID == "" {
+		return "", fmt.Errorf("Expected Azure Policy Definition ID to contain a Subscription ID but got %q", id)
 	}
 
-	return components[6], nil
+	return parsed.PopSegment("policyDefinitions")
 }
 
 func policyDefinitionRefreshFunc(ctx context.Context, client policy.DefinitionsClient, name string) resource.StateRefreshFunc {
@@ -273,3 +333,134 @@ func policyDefinitionRefreshFunc(ctx context.Context, client policy.DefinitionsC
 		return res, strconv.Itoa(res.StatusCode), nil
 	}
 }
+
+// policyDefinitionServerManagedMetadataKeys are the keys which Azure adds to the `metadata` of a Policy Definition
+var policyDefinitionServerManagedMetadataKeys = []string{
+	"createdBy",
+	"createdOn",
+	"updatedBy",
+	"updatedOn",
+}
+
+func expandPolicyDefinitionMetadata(input string, category string, previousCategory string) (map[string]interface{}, error) {
+	metaData := make(map[string]interface{})
+	if input != "" {
+		var err error
+		metaData, err = structure.ExpandJsonFromString(input)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse metadata: %s", err)
+		}
+	}
+
+	// these are set by Azure, sending them back is either ignored or rejected
+	for _, key := range policyDefinitionServerManagedMetadataKeys {
+		delete(metaData, key)
+	}
+
+	// the previous `category` was set via the `category` field, so it's either replaced or removed
+	if previousCategory != "" {
+		if existing, ok := metaData["category"].(string); ok && existing == previousCategory {
+			delete(metaData, "category")
+		}
+	}
+
+	if category != "" {
+		if existing, ok := metaData["category"].(string); ok && existing != category {
+			return nil, fmt.Errorf("The `category` %q conflicts with the `category` %q specified within `metadata`", category, existing)
+		}
+
+		metaData["category"] = category
+	}
+
+	if len(metaData) == 0 {
+		return nil, nil
+	}
+
+	return metaData, nil
+}
+
+// policyDefinitionsMetadataDiffSuppressFunc compares the `metadata` JSON whilst ignoring the fields
+// which are added by Azure, and the `category` when it's being managed via the `category` field.
+func policyDefinitionsMetadataDiffSuppressFunc(_, old, new string, _ *schema.ResourceData) bool {
+	var oldMetadata map[string]interface{}
+	if old != "" {
+		if err := json.Unmarshal([]byte(old), &oldMetadata); err != nil {
+			return false
+		}
+	}
+
+	var newMetadata map[string]interface{}
+	if new != "" {
+		if err := json.Unmarshal([]byte(new), &newMetadata); err != nil {
+			return false
+		}
+	}
+
+	if oldMetadata == nil {
+		oldMetadata = make(map[string]interface{})
+	}
+	if newMetadata == nil {
+		newMetadata = make(map[string]interface{})
+	}
+
+	for _, key := range policyDefinitionServerManagedMetadataKeys {
+		delete(oldMetadata, key)
+		delete(newMetadata, key)
+	}
+
+	if _, ok := newMetadata["category"]; !ok {
+		delete(oldMetadata, "category")
+	}
+
+	return reflect.DeepEqual(oldMetadata, newMetadata)
+}
+
+var policyDefinitionParameterReferenceRegex = regexp.MustCompile(`parameters\(\s*'([^']+)'\s*\)`)
+
+// validatePolicyDefinitionParametersAreDeclared ensures that any parameters referenced within the
+// Policy Rule (e.g. `[parameters('allowedLocations')]`) have been declared within the Parameters
+func validatePolicyDefinitionParametersAreDeclared(policyRule string, parameters string) error {
+	matches := policyDefinitionParameterReferenceRegex.FindAllStringSubmatch(policyRule, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	declared := make(map[string]struct{})
+	if parameters != "" {
+		params, err := structure.ExpandJsonFromString(parameters)
+		if err != nil {
+			// this is caught by the validation on the field
+			return nil
+		}
+
+		// parameter names are case-insensitive in ARM
+		for k := range params {
+			declared[strings.ToLower(k)] = struct{}{}
+		}
+	}
+
+	undeclared := make([]string, 0)
+	for _, match := range matches {
+		name := match[1]
+		if _, ok := declared[strings.ToLower(name)]; ok {
+			continue
+		}
+
+		found := false
+		for _, v := range undeclared {
+			if strings.EqualFold(v, name) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			undeclared = append(undeclared, name)
+		}
+	}
+
+	if len(undeclared) > 0 {
+		return fmt.Errorf("The `policy_rule` references parameters which aren't declared in `parameters`: %s", strings.Join(undeclared, ", "))
+	}
+
+	return nil
+}
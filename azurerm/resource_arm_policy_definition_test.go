@@ -3,6 +3,8 @@ package azurerm
 import (
 	"fmt"
 	"net/http"
+	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -10,6 +12,223 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func TestAzureRMPolicyDefinition_metadataDiffSuppress(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Old      string
+		New      string
+		Suppress bool
+	}{
+		{
+			Name:     "empty",
+			Old:      "",
+			New:      "",
+			Suppress: true,
+		},
+		{
+			Name:     "identical",
+			Old:      `{"category": "General"}`,
+			New:      `{"category":"General"}`,
+			Suppress: true,
+		},
+		{
+			Name:     "server managed fields",
+			Old:      `{"category": "General", "createdBy": "00000000-0000-0000-0000-000000000000", "createdOn": "2018-10-01T10:00:00Z", "updatedBy": null, "updatedOn": null}`,
+			New:      `{"category": "General"}`,
+			Suppress: true,
+		},
+		{
+			Name:     "category managed via the category field",
+			Old:      `{"category": "General", "createdBy": "00000000-0000-0000-0000-000000000000"}`,
+			New:      "",
+			Suppress: true,
+		},
+		{
+			Name:     "category changed",
+			Old:      `{"category": "General", "createdBy": "00000000-0000-0000-0000-000000000000"}`,
+			New:      `{"category": "Storage"}`,
+			Suppress: false,
+		},
+		{
+			Name:     "additional field",
+			Old:      `{"category": "General"}`,
+			New:      `{"category": "General", "version": "1.0.0"}`,
+			Suppress: false,
+		},
+		{
+			Name:     "field removed",
+			Old:      `{"category": "General", "version": "1.0.0"}`,
+			New:      "",
+			Suppress: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if v := policyDefinitionsMetadataDiffSuppressFunc("metadata", tc.Old, tc.New, nil); v != tc.Suppress {
+				t.Fatalf("Expected %t but got %t", tc.Suppress, v)
+			}
+		})
+	}
+}
+
+func TestAzureRMPolicyDefinition_expandMetadata(t *testing.T) {
+	cases := []struct {
+		Name             string
+		Metadata         string
+		Category         string
+		PreviousCategory string
+		Expected         map[string]interface{}
+		Error            bool
+	}{
+		{
+			Name:     "empty",
+			Expected: nil,
+		},
+		{
+			Name:     "category only",
+			Category: "General",
+			Expected: map[string]interface{}{
+				"category": "General",
+			},
+		},
+		{
+			Name:     "category merged into metadata",
+			Metadata: `{"version": "1.0.0", "createdBy": "00000000-0000-0000-0000-000000000000"}`,
+			Category: "General",
+			Expected: map[string]interface{}{
+				"category": "General",
+				"version":  "1.0.0",
+			},
+		},
+		{
+			Name:     "same category in both",
+			Metadata: `{"category": "General"}`,
+			Category: "General",
+			Expected: map[string]interface{}{
+				"category": "General",
+			},
+		},
+		{
+			Name:     "conflicting category",
+			Metadata: `{"category": "Storage"}`,
+			Category: "General",
+			Error:    true,
+		},
+		{
+			Name:             "category changed",
+			Metadata:         `{"category": "General", "version": "1.0.0", "createdBy": "00000000-0000-0000-0000-000000000000"}`,
+			Category:         "Storage",
+			PreviousCategory: "General",
+			Expected: map[string]interface{}{
+				"category": "Storage",
+				"version":  "1.0.0",
+			},
+		},
+		{
+			Name:             "category removed",
+			Metadata:         `{"category": "General", "version": "1.0.0"}`,
+			PreviousCategory: "General",
+			Expected: map[string]interface{}{
+				"version": "1.0.0",
+			},
+		},
+		{
+			Name:             "category within metadata changed",
+			Metadata:         `{"category": "Storage"}`,
+			Category:         "General",
+			PreviousCategory: "General",
+			Error:            true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			actual, err := expandPolicyDefinitionMetadata(tc.Metadata, tc.Category, tc.PreviousCategory)
+			if err != nil {
+				if tc.Error {
+					return
+				}
+
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+
+			if tc.Error {
+				t.Fatalf("Expected an error but didn't get one")
+			}
+
+			if !reflect.DeepEqual(actual, tc.Expected) {
+				t.Fatalf("Expected %+v but got %+v", tc.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestAzureRMPolicyDefinition_validateParametersAreDeclared(t *testing.T) {
+	cases := []struct {
+		Name       string
+		PolicyRule string
+		Parameters string
+		Error      bool
+	}{
+		{
+			Name:       "no parameters referenced",
+			PolicyRule: `{"if": {"field": "type", "equals": "Microsoft.Storage/storageAccounts"}, "then": {"effect": "deny"}}`,
+			Parameters: "",
+			Error:      false,
+		},
+		{
+			Name:       "parameter declared",
+			PolicyRule: `{"if": {"not": {"field": "location", "in": "[parameters('allowedLocations')]"}}, "then": {"effect": "audit"}}`,
+			Parameters: `{"allowedLocations": {"type": "Array"}}`,
+			Error:      false,
+		},
+		{
+			Name:       "parameter declared with different casing",
+			PolicyRule: `{"if": {"not": {"field": "location", "in": "[parameters('AllowedLocations')]"}}, "then": {"effect": "audit"}}`,
+			Parameters: `{"allowedLocations": {"type": "Array"}}`,
+			Error:      false,
+		},
+		{
+			Name:       "no parameters declared",
+			PolicyRule: `{"if": {"not": {"field": "location", "in": "[parameters('allowedLocations')]"}}, "then": {"effect": "audit"}}`,
+			Parameters: "",
+			Error:      true,
+		},
+		{
+			Name:       "empty parameters declared",
+			PolicyRule: `{"if": {"not": {"field": "location", "in": "[parameters('allowedLocations')]"}}, "then": {"effect": "audit"}}`,
+			Parameters: "{}",
+			Error:      true,
+		},
+		{
+			Name:       "no policy rule",
+			PolicyRule: "",
+			Parameters: "",
+			Error:      false,
+		},
+		{
+			Name:       "one of multiple parameters not declared",
+			PolicyRule: `{"if": {"not": {"field": "location", "in": "[parameters('allowedLocations')]"}}, "then": {"effect": "[parameters( 'effect' )]"}}`,
+			Parameters: `{"allowedLocations": {"type": "Array"}}`,
+			Error:      true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := validatePolicyDefinitionParametersAreDeclared(tc.PolicyRule, tc.Parameters)
+			if err != nil && !tc.Error {
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+
+			if err == nil && tc.Error {
+				t.Fatalf("Expected an error but didn't get one")
+			}
+		})
+	}
+}
+
 func TestAccAzureRMPolicyDefinition_basic(t *testing.T) {
 	resourceName := "azurerm_policy_definition.test"
 
@@ -30,6 +249,78 @@ func TestAccAzureRMPolicyDefinition_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMPolicyDefinition_timeouts(t *testing.T) {
+	resourceName := "azurerm_policy_definition.test"
+
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPolicyDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAzureRMPolicyDefinition_timeouts(ri),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPolicyDefinitionExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPolicyDefinition_category(t *testing.T) {
+	resourceName := "azurerm_policy_definition.test"
+
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPolicyDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAzureRMPolicyDefinition_category(ri, "General"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPolicyDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "category", "General"),
+				),
+			},
+			{
+				Config: testAzureRMPolicyDefinition_category(ri, "Storage"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPolicyDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "category", "Storage"),
+				),
+			},
+			{
+				Config: testAzureRMPolicyDefinition_category(ri, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPolicyDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "category", ""),
+					testCheckAzureRMPolicyDefinitionHasNoCategory(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPolicyDefinition_undeclaredParameter(t *testing.T) {
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPolicyDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAzureRMPolicyDefinition_undeclaredParameter(ri),
+				ExpectError: regexp.MustCompile("references parameters which aren't declared"),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMPolicyDefinitionExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -55,6 +346,35 @@ func testCheckAzureRMPolicyDefinitionExists(name string) resource.TestCheckFunc
 	}
 }
 
+func testCheckAzureRMPolicyDefinitionHasNoCategory(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		policyName := rs.Primary.Attributes["name"]
+
+		client := testAccProvider.Meta().(*ArmClient).policyDefinitionsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, policyName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on policyDefinitionsClient: %s", err)
+		}
+
+		if props := resp.DefinitionProperties; props != nil && props.Metadata != nil {
+			if metadata, ok := props.Metadata.(map[string]interface{}); ok {
+				if category, ok := metadata["category"]; ok {
+					return fmt.Errorf("Expected the category to be removed from the metadata but got %q", category)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
 func testCheckAzureRMPolicyDefinitionDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*ArmClient).policyDefinitionsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
@@ -116,3 +436,120 @@ PARAMETERS
 }
 `, ri, ri)
 }
+
+func testAzureRMPolicyDefinition_timeouts(ri int) string {
+	return fmt.Sprintf(`
+resource "azurerm_policy_definition" "test" {
+  name         = "acctestpol-%d"
+  policy_type  = "Custom"
+  mode         = "All"
+  display_name = "acctestpol-%d"
+  policy_rule  = <<POLICY_RULE
+	{
+    "if": {
+      "not": {
+        "field": "location",
+        "in": "[parameters('allowedLocations')]"
+      }
+    },
+    "then": {
+      "effect": "audit"
+    }
+  }
+POLICY_RULE
+
+  parameters = <<PARAMETERS
+	{
+    "allowedLocations": {
+      "type": "Array",
+      "metadata": {
+        "description": "The list of allowed locations for resources.",
+        "displayName": "Allowed locations",
+        "strongType": "location"
+      }
+    }
+  }
+PARAMETERS
+
+  timeouts {
+    create = "15m"
+    update = "15m"
+    delete = "10m"
+  }
+}
+`, ri, ri)
+}
+
+func testAzureRMPolicyDefinition_category(ri int, category string) string {
+	categoryBlock := ""
+	if category != "" {
+		categoryBlock = fmt.Sprintf("category     = %q", category)
+	}
+
+	return fmt.Sprintf(`
+resource "azurerm_policy_definition" "test" {
+  name         = "acctestpol-%d"
+  policy_type  = "Custom"
+  mode         = "All"
+  display_name = "acctestpol-%d"
+  %s
+
+  policy_rule = <<POLICY_RULE
+	{
+    "if": {
+      "not": {
+        "field": "location",
+        "in": "[parameters('allowedLocations')]"
+      }
+    },
+    "then": {
+      "effect": "audit"
+    }
+  }
+POLICY_RULE
+
+  metadata = <<METADATA
+	{
+    "version": "1.0.0"
+  }
+METADATA
+
+  parameters = <<PARAMETERS
+	{
+    "allowedLocations": {
+      "type": "Array",
+      "metadata": {
+        "description": "The list of allowed locations for resources.",
+        "displayName": "Allowed locations",
+        "strongType": "location"
+      }
+    }
+  }
+PARAMETERS
+}
+`, ri, ri, categoryBlock)
+}
+
+func testAzureRMPolicyDefinition_undeclaredParameter(ri int) string {
+	return fmt.Sprintf(`
+resource "azurerm_policy_definition" "test" {
+  name         = "acctestpol-%d"
+  policy_type  = "Custom"
+  mode         = "All"
+  display_name = "acctestpol-%d"
+  policy_rule  = <<POLICY_RULE
+	{
+    "if": {
+      "not": {
+        "field": "location",
+        "in": "[parameters('allowedLocations')]"
+      }
+    },
+    "then": {
+      "effect": "audit"
+    }
+  }
+POLICY_RULE
+}
+`, ri, ri)
+}
@@ -6,18 +6,18 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2017-12-01/postgresql"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmPostgreSQLConfiguration() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmPostgreSQLConfigurationCreateUpdate,
-		Read:   resourceArmPostgreSQLConfigurationRead,
-		Delete: resourceArmPostgreSQLConfigurationDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmPostgreSQLConfigurationCreateUpdate,
+		Read:     resourceArmPostgreSQLConfigurationRead,
+		Delete:   resourceArmPostgreSQLConfigurationDelete,
+		Importer: azure.ImporterValidatingResourceId("servers", "configurations"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -45,7 +45,8 @@ func resourceArmPostgreSQLConfiguration() *schema.Resource {
 
 func resourceArmPostgreSQLConfigurationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).postgresqlConfigurationsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM PostgreSQL Configuration creation.")
 
@@ -86,15 +87,22 @@ func resourceArmPostgreSQLConfigurationCreateUpdate(d *schema.ResourceData, meta
 
 func resourceArmPostgreSQLConfigurationRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).postgresqlConfigurationsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	serverName := id.Path["servers"]
-	name := id.Path["configurations"]
+	serverName, err := id.PopSegment("servers")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("configurations")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resGroup, serverName, name)
 	if err != nil {
@@ -117,15 +125,22 @@ func resourceArmPostgreSQLConfigurationRead(d *schema.ResourceData, meta interfa
 
 func resourceArmPostgreSQLConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).postgresqlConfigurationsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	serverName := id.Path["servers"]
-	name := id.Path["configurations"]
+	serverName, err := id.PopSegment("servers")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("configurations")
+	if err != nil {
+		return err
+	}
 
 	// "delete" = resetting this to the default value
 	resp, err := client.Get(ctx, resGroup, serverName, name)
@@ -11,6 +11,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -120,7 +121,8 @@ func resourceArmPublicIp() *schema.Resource {
 
 func resourceArmPublicIpCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).publicIPClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Public IP creation.")
 
@@ -205,14 +207,18 @@ func resourceArmPublicIpCreate(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmPublicIpRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).publicIPClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["publicIPAddresses"]
+	name, err := id.PopSegment("publicIPAddresses")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resGroup, name, "")
 	if err != nil {
@@ -257,14 +263,18 @@ func resourceArmPublicIpRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmPublicIpDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).publicIPClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["publicIPAddresses"]
+	name, err := id.PopSegment("publicIPAddresses")
+	if err != nil {
+		return err
+	}
 
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
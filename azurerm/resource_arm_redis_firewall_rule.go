@@ -8,18 +8,18 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmRedisFirewallRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmRedisFirewallRuleCreateUpdate,
-		Read:   resourceArmRedisFirewallRuleRead,
-		Update: resourceArmRedisFirewallRuleCreateUpdate,
-		Delete: resourceArmRedisFirewallRuleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmRedisFirewallRuleCreateUpdate,
+		Read:     resourceArmRedisFirewallRuleRead,
+		Update:   resourceArmRedisFirewallRuleCreateUpdate,
+		Delete:   resourceArmRedisFirewallRuleDelete,
+		Importer: azure.ImporterValidatingResourceId("Redis", "firewallRules"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -52,7 +52,8 @@ func resourceArmRedisFirewallRule() *schema.Resource {
 
 func resourceArmRedisFirewallRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).redisFirewallClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM Redis Firewall Rule creation.")
 
 	name := d.Get("name").(string)
@@ -88,15 +89,22 @@ func resourceArmRedisFirewallRuleCreateUpdate(d *schema.ResourceData, meta inter
 
 func resourceArmRedisFirewallRuleRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).redisFirewallClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	cacheName := id.Path["Redis"]
-	name := id.Path["firewallRules"]
+	cacheName, err := id.PopSegment("Redis")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("firewallRules")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resourceGroup, cacheName, name)
 
@@ -123,15 +131,22 @@ func resourceArmRedisFirewallRuleRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmRedisFirewallRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).redisFirewallClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	cacheName := id.Path["Redis"]
-	name := id.Path["firewallRules"]
+	cacheName, err := id.PopSegment("Redis")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("firewallRules")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Delete(ctx, resourceGroup, cacheName, name)
 
@@ -11,19 +11,19 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmRelayNamespace() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmRelayNamespaceCreateUpdate,
-		Read:   resourceArmRelayNamespaceRead,
-		Update: resourceArmRelayNamespaceCreateUpdate,
-		Delete: resourceArmRelayNamespaceDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmRelayNamespaceCreateUpdate,
+		Read:     resourceArmRelayNamespaceRead,
+		Update:   resourceArmRelayNamespaceCreateUpdate,
+		Delete:   resourceArmRelayNamespaceDelete,
+		Importer: azure.ImporterValidatingResourceId("namespaces"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -91,7 +91,8 @@ func resourceArmRelayNamespace() *schema.Resource {
 
 func resourceArmRelayNamespaceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).relayNamespacesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for Relay Namespace creation.")
 
 	name := d.Get("name").(string)
@@ -134,14 +135,18 @@ func resourceArmRelayNamespaceCreateUpdate(d *schema.ResourceData, meta interfac
 
 func resourceArmRelayNamespaceRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).relayNamespacesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["namespaces"]
+	name, err := id.PopSegment("namespaces")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -187,14 +192,18 @@ func resourceArmRelayNamespaceRead(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmRelayNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).relayNamespacesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["namespaces"]
+	name, err := id.PopSegment("namespaces")
+	if err != nil {
+		return err
+	}
 
 	future, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
@@ -211,7 +220,7 @@ func resourceArmRelayNamespaceDelete(d *schema.ResourceData, meta interface{}) e
 		Pending:    []string{"Pending"},
 		Target:     []string{"Deleted"},
 		Refresh:    relayNamespaceDeleteRefreshFunc(ctx, client, resourceGroup, name),
-		Timeout:    60 * time.Minute,
+		Timeout:    d.Timeout(schema.TimeoutDelete),
 		MinTimeout: 15 * time.Second,
 	}
 	if _, err := stateConf.WaitForState(); err != nil {
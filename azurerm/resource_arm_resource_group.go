@@ -1,25 +1,27 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2017-05-10/resources"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmResourceGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmResourceGroupCreateUpdate,
-		Read:   resourceArmResourceGroupRead,
-		Update: resourceArmResourceGroupCreateUpdate,
-		Exists: resourceArmResourceGroupExists,
-		Delete: resourceArmResourceGroupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmResourceGroupCreateUpdate,
+		Read:     resourceArmResourceGroupRead,
+		Update:   resourceArmResourceGroupCreateUpdate,
+		Exists:   resourceArmResourceGroupExists,
+		Delete:   resourceArmResourceGroupDelete,
+		Importer: azure.ImporterValidatingResourceId(),
 
 		Schema: map[string]*schema.Schema{
 			"name": resourceGroupNameSchema(),
@@ -27,13 +29,20 @@ func resourceArmResourceGroup() *schema.Resource {
 			"location": locationSchema(),
 
 			"tags": tagsSchema(),
+
+			"prevent_deletion_if_contains_resources": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
 
 func resourceArmResourceGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).resourceGroupsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	location := azureRMNormalizeLocation(d.Get("location").(string))
@@ -59,7 +68,8 @@ func resourceArmResourceGroupCreateUpdate(d *schema.ResourceData, meta interface
 
 func resourceArmResourceGroupRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).resourceGroupsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -85,6 +95,9 @@ func resourceArmResourceGroupRead(d *schema.ResourceData, meta interface{}) erro
 	}
 	flattenAndSetTags(d, resp.Tags)
 
+	// this isn't returned from the API - so we use the value from the config/state, which defaults to false when importing
+	d.Set("prevent_deletion_if_contains_resources", d.Get("prevent_deletion_if_contains_resources").(bool))
+
 	return nil
 }
 
@@ -113,7 +126,8 @@ func resourceArmResourceGroupExists(d *schema.ResourceData, meta interface{}) (b
 
 func resourceArmResourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).resourceGroupsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -122,6 +136,19 @@ func resourceArmResourceGroupDelete(d *schema.ResourceData, meta interface{}) er
 
 	name := id.ResourceGroup
 
+	if d.Get("prevent_deletion_if_contains_resources").(bool) {
+		resourceIds, err := listResourceIdsWithinResourceGroup(ctx, meta.(*ArmClient).resourcesClient, name)
+		if err != nil {
+			return fmt.Errorf("Error listing the Resources within Resource Group %q: %+v", name, err)
+		}
+
+		if len(resourceIds) > 0 {
+			return fmt.Errorf("Error deleting Resource Group %q: the Resource Group still contains %d Resource(s) which aren't managed by Terraform:\n\n%s\n\n"+
+				"Either remove these Resources or set `prevent_deletion_if_contains_resources` to `false` to delete the Resource Group and all Resources within it.",
+				name, len(resourceIds), strings.Join(resourceIds, "\n"))
+		}
+	}
+
 	deleteFuture, err := client.Delete(ctx, name)
 	if err != nil {
 		if response.WasNotFound(deleteFuture.Response()) {
@@ -142,3 +169,24 @@ func resourceArmResourceGroupDelete(d *schema.ResourceData, meta interface{}) er
 
 	return nil
 }
+
+func listResourceIdsWithinResourceGroup(ctx context.Context, client resources.Client, resourceGroup string) ([]string, error) {
+	resourceIds := make([]string, 0)
+
+	iterator, err := client.ListByResourceGroupComplete(ctx, resourceGroup, "", "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for iterator.NotDone() {
+		if id := iterator.Value().ID; id != nil {
+			resourceIds = append(resourceIds, *id)
+		}
+
+		if err := iterator.Next(); err != nil {
+			return nil, err
+		}
+	}
+
+	return resourceIds, nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -129,6 +130,39 @@ func TestAccAzureRMResourceGroup_withTags(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMResourceGroup_preventDeletionIfContainsResources(t *testing.T) {
+	resourceName := "azurerm_resource_group.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMResourceGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMResourceGroup_preventDeletionIfContainsResources(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "prevent_deletion_if_contains_resources", "true"),
+				),
+			},
+			{
+				Config:      testAccAzureRMResourceGroup_preventDeletionIfContainsResources(ri, location),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("the Resource Group still contains 1 Resource"),
+			},
+			{
+				Config: testAccAzureRMResourceGroup_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "prevent_deletion_if_contains_resources", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMResourceGroupExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -243,3 +277,40 @@ resource "azurerm_resource_group" "test" {
 }
 `, rInt, location)
 }
+
+func testAccAzureRMResourceGroup_preventDeletionIfContainsResources(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name                                   = "acctestRG-%d"
+  location                               = "%s"
+  prevent_deletion_if_contains_resources = true
+}
+
+# the Virtual Network created by this Template Deployment isn't removed when the Template Deployment is
+resource "azurerm_template_deployment" "test" {
+  name                = "acctesttemplate-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  deployment_mode     = "Incremental"
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "resources": [
+    {
+      "type": "Microsoft.Network/virtualNetworks",
+      "apiVersion": "2018-08-01",
+      "name": "acctestvirtnet%d",
+      "location": "[resourceGroup().location]",
+      "properties": {
+        "addressSpace": {
+          "addressPrefixes": ["10.0.0.0/16"]
+        }
+      }
+    }
+  ]
+}
+DEPLOY
+}
+`, rInt, location, rInt, rInt)
+}
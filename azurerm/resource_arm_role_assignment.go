@@ -10,17 +10,17 @@ import (
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmRoleAssignment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmRoleAssignmentCreate,
-		Read:   resourceArmRoleAssignmentRead,
-		Delete: resourceArmRoleAssignmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmRoleAssignmentCreate,
+		Read:     resourceArmRoleAssignmentRead,
+		Delete:   resourceArmRoleAssignmentDelete,
+		Importer: azure.ImporterValidatingScopedResourceId("Microsoft.Authorization", "roleAssignments"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -65,7 +65,8 @@ func resourceArmRoleAssignment() *schema.Resource {
 func resourceArmRoleAssignmentCreate(d *schema.ResourceData, meta interface{}) error {
 	roleAssignmentsClient := meta.(*ArmClient).roleAssignmentsClient
 	roleDefinitionsClient := meta.(*ArmClient).roleDefinitionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	scope := d.Get("scope").(string)
@@ -126,7 +127,8 @@ func resourceArmRoleAssignmentCreate(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmRoleAssignmentRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).roleAssignmentsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resp, err := client.GetByID(ctx, d.Id())
 	if err != nil {
@@ -152,7 +154,8 @@ func resourceArmRoleAssignmentRead(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmRoleAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).roleAssignmentsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseRoleAssignmentId(d.Id())
 	if err != nil {
@@ -206,15 +209,15 @@ type roleAssignmentId struct {
 }
 
 func parseRoleAssignmentId(input string) (*roleAssignmentId, error) {
-	segments := strings.Split(input, "/providers/Microsoft.Authorization/roleAssignments/")
-	if len(segments) != 2 {
-		return nil, fmt.Errorf("Expected Role Assignment ID to be in the format `{scope}/providers/Microsoft.Authorization/roleAssignments/{name}` but got %q", input)
+	// /{scope}/providers/Microsoft.Authorization/roleAssignments/{roleAssignmentName}
+	parsed, err := azure.ParseScopedResourceID(input, "Microsoft.Authorization", "roleAssignments")
+	if err != nil {
+		return nil, err
 	}
 
-	// /{scope}/providers/Microsoft.Authorization/roleAssignments/{roleAssignmentName}
 	id := roleAssignmentId{
-		scope: strings.TrimPrefix(segments[0], "/"),
-		name:  segments[1],
+		scope: strings.TrimPrefix(parsed.Scope, "/"),
+		name:  parsed.Name,
 	}
 	return &id, nil
 }
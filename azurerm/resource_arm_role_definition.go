@@ -8,18 +8,18 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2018-01-01-preview/authorization"
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmRoleDefinition() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmRoleDefinitionCreateUpdate,
-		Read:   resourceArmRoleDefinitionRead,
-		Update: resourceArmRoleDefinitionCreateUpdate,
-		Delete: resourceArmRoleDefinitionDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmRoleDefinitionCreateUpdate,
+		Read:     resourceArmRoleDefinitionRead,
+		Update:   resourceArmRoleDefinitionCreateUpdate,
+		Delete:   resourceArmRoleDefinitionDelete,
+		Importer: azure.ImporterValidatingScopedResourceId("Microsoft.Authorization", "roleDefinitions"),
 
 		Schema: map[string]*schema.Schema{
 			"role_definition_id": {
@@ -97,7 +97,8 @@ func resourceArmRoleDefinition() *schema.Resource {
 
 func resourceArmRoleDefinitionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).roleDefinitionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	roleDefinitionId := d.Get("role_definition_id").(string)
 	if roleDefinitionId == "" {
@@ -139,13 +140,22 @@ func resourceArmRoleDefinitionCreateUpdate(d *schema.ResourceData, meta interfac
 		return fmt.Errorf("Cannot read Role Definition ID for %q (Scope %q)", name, scope)
 	}
 
-	d.SetId(*read.ID)
+	id := *read.ID
+
+	// Role Definitions created at a Management Group scope are returned without the scope
+	// prefixed - as such we build the ID ourselves so that it can be used for Read/Delete
+	if _, err := parseManagementGroupId(scope); err == nil {
+		id = fmt.Sprintf("%s/providers/Microsoft.Authorization/roleDefinitions/%s", scope, roleDefinitionId)
+	}
+
+	d.SetId(id)
 	return resourceArmRoleDefinitionRead(d, meta)
 }
 
 func resourceArmRoleDefinitionRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).roleDefinitionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resp, err := client.GetByID(ctx, d.Id())
 	if err != nil {
@@ -158,6 +168,19 @@ func resourceArmRoleDefinitionRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error loading Role Definition %q: %+v", d.Id(), err)
 	}
 
+	id, err := parseRoleDefinitionId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Set("role_definition_id", id.roleDefinitionId)
+
+	// the API doesn't return the scope the Role Definition was created at, so this is only set
+	// during import - where the scope is taken from the ID (e.g. a Subscription or Management Group)
+	if _, ok := d.GetOk("scope"); !ok {
+		d.Set("scope", fmt.Sprintf("/%s", id.scope))
+	}
+
 	if props := resp.RoleDefinitionProperties; props != nil {
 		d.Set("name", props.RoleName)
 		d.Set("description", props.Description)
@@ -178,7 +201,8 @@ func resourceArmRoleDefinitionRead(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmRoleDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).roleDefinitionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseRoleDefinitionId(d.Id())
 	if err != nil {
@@ -314,15 +338,15 @@ type roleDefinitionId struct {
 }
 
 func parseRoleDefinitionId(input string) (*roleDefinitionId, error) {
-	segments := strings.Split(input, "/providers/Microsoft.Authorization/roleDefinitions/")
-	if len(segments) != 2 {
-		return nil, fmt.Errorf("Expected Role Definition ID to be in the format `{scope}/providers/Microsoft.Authorization/roleDefinitions/{name}` but got %q", input)
+	// /{scope}/providers/Microsoft.Authorization/roleDefinitions/{roleDefinitionId}
+	parsed, err := azure.ParseScopedResourceID(input, "Microsoft.Authorization", "roleDefinitions")
+	if err != nil {
+		return nil, err
 	}
 
-	// /{scope}/providers/Microsoft.Authorization/roleDefinitions/{roleDefinitionId}
 	id := roleDefinitionId{
-		scope:            strings.TrimPrefix(segments[0], "/"),
-		roleDefinitionId: segments[1],
+		scope:            strings.TrimPrefix(parsed.Scope, "/"),
+		roleDefinitionId: parsed.Name,
 	}
 	return &id, nil
 }
@@ -49,6 +49,28 @@ func TestAccAzureRMRoleDefinition_complete(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMRoleDefinition_managementGroup(t *testing.T) {
+	resourceName := "azurerm_role_definition.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMRoleDefinition_managementGroup(uuid.New().String(), ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRoleDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRoleDefinitionExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "scope", "azurerm_management_group.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "assignable_scopes.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMRoleDefinition_update(t *testing.T) {
 	resourceName := "azurerm_role_definition.test"
 	id := uuid.New().String()
@@ -245,3 +267,26 @@ resource "azurerm_role_definition" "test" {
 }
 `, rInt)
 }
+
+func testAccAzureRMRoleDefinition_managementGroup(id string, rInt int) string {
+	return fmt.Sprintf(`
+resource "azurerm_management_group" "test" {
+  display_name = "acctestmg-%d"
+}
+
+resource "azurerm_role_definition" "test" {
+  role_definition_id = "%s"
+  name               = "acctestrd-%d"
+  scope              = "${azurerm_management_group.test.id}"
+
+  permissions {
+    actions     = ["*"]
+    not_actions = []
+  }
+
+  assignable_scopes = [
+    "${azurerm_management_group.test.id}",
+  ]
+}
+`, rInt, id, rInt)
+}
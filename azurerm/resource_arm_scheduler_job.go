@@ -15,8 +15,10 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/set"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -28,9 +30,7 @@ func resourceArmSchedulerJob() *schema.Resource {
 		Update: resourceArmSchedulerJobCreateUpdate,
 		Delete: resourceArmSchedulerJobDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: azure.ImporterValidatingResourceId("jobCollections", "jobs"),
 
 		CustomizeDiff: resourceArmSchedulerJobCustomizeDiff,
 
@@ -473,7 +473,8 @@ func resourceArmSchedulerJobCustomizeDiff(diff *schema.ResourceDiff, v interface
 
 func resourceArmSchedulerJobCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).schedulerJobsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -517,16 +518,23 @@ func resourceArmSchedulerJobCreateUpdate(d *schema.ResourceData, meta interface{
 
 func resourceArmSchedulerJobRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).schedulerJobsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	name := id.Path["jobs"]
+	name, err := id.PopSegment("jobs")
+	if err != nil {
+		return err
+	}
 	resourceGroup := id.ResourceGroup
-	jobCollection := id.Path["jobCollections"]
+	jobCollection, err := id.PopSegment("jobCollections")
+	if err != nil {
+		return err
+	}
 
 	log.Printf("[DEBUG] Reading Scheduler Job %q (resource group %q)", name, resourceGroup)
 
@@ -611,16 +619,23 @@ func resourceArmSchedulerJobRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmSchedulerJobDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).schedulerJobsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	name := id.Path["jobs"]
+	name, err := id.PopSegment("jobs")
+	if err != nil {
+		return err
+	}
 	resourceGroup := id.ResourceGroup
-	jobCollection := id.Path["jobCollections"]
+	jobCollection, err := id.PopSegment("jobCollections")
+	if err != nil {
+		return err
+	}
 
 	log.Printf("[DEBUG] Deleting Scheduler Job %q (resource group %q)", name, resourceGroup)
 
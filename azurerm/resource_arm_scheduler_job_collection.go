@@ -9,7 +9,9 @@ import (
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -20,9 +22,7 @@ func resourceArmSchedulerJobCollection() *schema.Resource {
 		Update: resourceArmSchedulerJobCollectionCreateUpdate,
 		Delete: resourceArmSchedulerJobCollectionDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: azure.ImporterValidatingResourceId("jobCollections"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -118,7 +118,8 @@ func resourceArmSchedulerJobCollection() *schema.Resource {
 
 func resourceArmSchedulerJobCollectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).schedulerJobCollectionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	location := azureRMNormalizeLocation(d.Get("location").(string))
@@ -161,14 +162,18 @@ func resourceArmSchedulerJobCollectionCreateUpdate(d *schema.ResourceData, meta
 
 func resourceArmSchedulerJobCollectionRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).schedulerJobCollectionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	name := id.Path["jobCollections"]
+	name, err := id.PopSegment("jobCollections")
+	if err != nil {
+		return err
+	}
 	resourceGroup := id.ResourceGroup
 
 	log.Printf("[DEBUG] Reading Scheduler Job Collection %q (resource group %q)", name, resourceGroup)
@@ -208,14 +213,18 @@ func resourceArmSchedulerJobCollectionRead(d *schema.ResourceData, meta interfac
 
 func resourceArmSchedulerJobCollectionDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).schedulerJobCollectionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	name := id.Path["jobCollections"]
+	name, err := id.PopSegment("jobCollections")
+	if err != nil {
+		return err
+	}
 	resourceGroup := id.ResourceGroup
 
 	log.Printf("[DEBUG] Deleting Scheduler Job Collection %q (resource group %q)", name, resourceGroup)
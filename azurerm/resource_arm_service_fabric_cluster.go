@@ -7,19 +7,19 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/servicefabric/mgmt/2018-02-01/servicefabric"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmServiceFabricCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmServiceFabricClusterCreate,
-		Read:   resourceArmServiceFabricClusterRead,
-		Update: resourceArmServiceFabricClusterUpdate,
-		Delete: resourceArmServiceFabricClusterDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmServiceFabricClusterCreate,
+		Read:     resourceArmServiceFabricClusterRead,
+		Update:   resourceArmServiceFabricClusterUpdate,
+		Delete:   resourceArmServiceFabricClusterDelete,
+		Importer: azure.ImporterValidatingResourceId("clusters"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -271,7 +271,8 @@ func resourceArmServiceFabricCluster() *schema.Resource {
 
 func resourceArmServiceFabricClusterCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).serviceFabricClustersClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Service Fabric Cluster creation.")
 
@@ -349,7 +350,8 @@ func resourceArmServiceFabricClusterCreate(d *schema.ResourceData, meta interfac
 
 func resourceArmServiceFabricClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).serviceFabricClustersClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Service Fabric Cluster update.")
 
@@ -407,7 +409,8 @@ func resourceArmServiceFabricClusterUpdate(d *schema.ResourceData, meta interfac
 
 func resourceArmServiceFabricClusterRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).serviceFabricClustersClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -415,7 +418,10 @@ func resourceArmServiceFabricClusterRead(d *schema.ResourceData, meta interface{
 	}
 
 	resourceGroup := id.ResourceGroup
-	name := id.Path["clusters"]
+	name, err := id.PopSegment("clusters")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -480,14 +486,18 @@ func resourceArmServiceFabricClusterRead(d *schema.ResourceData, meta interface{
 
 func resourceArmServiceFabricClusterDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).serviceFabricClustersClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["clusters"]
+	name, err := id.PopSegment("clusters")
+	if err != nil {
+		return err
+	}
 
 	log.Printf("[DEBUG] Deleting Service Fabric Cluster %q (Resource Group %q)", name, resourceGroup)
 
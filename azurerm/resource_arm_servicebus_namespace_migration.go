@@ -1,26 +1,22 @@
 package azurerm
 
 import (
-	"fmt"
 	"log"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/migration"
 )
 
-func resourceAzureRMServiceBusNamespaceMigrateState(
-	v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
-	switch v {
-	case 0:
-		log.Println("[INFO] Found AzureRM ServiceBus Namespace State v0; migrating to v1")
-		return migrateAzureRMServiceBusNamespaceStateV0toV1(is)
-	default:
-		return is, fmt.Errorf("Unexpected schema version: %d", v)
+func resourceAzureRMServiceBusNamespaceMigrateState(v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	upgraders := []migration.StateUpgrader{
+		migrateAzureRMServiceBusNamespaceStateV0toV1,
 	}
+	return migration.MigrateStateUsingUpgraders("ServiceBus Namespace", upgraders...)(v, is, meta)
 }
 
-func migrateAzureRMServiceBusNamespaceStateV0toV1(is *terraform.InstanceState) (*terraform.InstanceState, error) {
+func migrateAzureRMServiceBusNamespaceStateV0toV1(is *terraform.InstanceState, _ interface{}) (*terraform.InstanceState, error) {
 	if is.Empty() {
 		log.Println("[DEBUG] Empty InstanceState; nothing to migrate.")
 		return is, nil
@@ -7,18 +7,17 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmServiceBusSubscription() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmServiceBusSubscriptionCreate,
-		Read:   resourceArmServiceBusSubscriptionRead,
-		Update: resourceArmServiceBusSubscriptionCreate,
-		Delete: resourceArmServiceBusSubscriptionDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmServiceBusSubscriptionCreate,
+		Read:     resourceArmServiceBusSubscriptionRead,
+		Update:   resourceArmServiceBusSubscriptionCreate,
+		Delete:   resourceArmServiceBusSubscriptionDelete,
+		Importer: azure.ImporterValidatingResourceId("namespaces", "topics", "subscriptions"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -103,7 +102,8 @@ func resourceArmServiceBusSubscription() *schema.Resource {
 
 func resourceArmServiceBusSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).serviceBusSubscriptionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for Azure ARM ServiceBus Subscription creation.")
 
 	name := d.Get("name").(string)
@@ -161,16 +161,26 @@ func resourceArmServiceBusSubscriptionCreate(d *schema.ResourceData, meta interf
 
 func resourceArmServiceBusSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).serviceBusSubscriptionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
-	topicName := id.Path["topics"]
-	name := id.Path["subscriptions"]
+	namespaceName, err := id.PopSegment("namespaces")
+	if err != nil {
+		return err
+	}
+	topicName, err := id.PopSegment("topics")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("subscriptions")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resourceGroup, namespaceName, topicName, name)
 	if err != nil {
@@ -205,16 +215,26 @@ func resourceArmServiceBusSubscriptionRead(d *schema.ResourceData, meta interfac
 
 func resourceArmServiceBusSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).serviceBusSubscriptionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
-	topicName := id.Path["topics"]
-	name := id.Path["subscriptions"]
+	namespaceName, err := id.PopSegment("namespaces")
+	if err != nil {
+		return err
+	}
+	topicName, err := id.PopSegment("topics")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("subscriptions")
+	if err != nil {
+		return err
+	}
 
 	_, err = client.Delete(ctx, resourceGroup, namespaceName, topicName, name)
 
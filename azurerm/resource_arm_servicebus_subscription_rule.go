@@ -9,18 +9,17 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmServiceBusSubscriptionRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmServiceBusSubscriptionRuleCreateUpdate,
-		Read:   resourceArmServiceBusSubscriptionRuleRead,
-		Update: resourceArmServiceBusSubscriptionRuleCreateUpdate,
-		Delete: resourceArmServiceBusSubscriptionRuleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmServiceBusSubscriptionRuleCreateUpdate,
+		Read:     resourceArmServiceBusSubscriptionRuleRead,
+		Update:   resourceArmServiceBusSubscriptionRuleCreateUpdate,
+		Delete:   resourceArmServiceBusSubscriptionRuleDelete,
+		Importer: azure.ImporterValidatingResourceId("namespaces", "topics", "subscriptions", "rules"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -121,7 +120,8 @@ func resourceArmServiceBusSubscriptionRule() *schema.Resource {
 
 func resourceArmServiceBusSubscriptionRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).serviceBusSubscriptionRulesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for Azure Service Bus Subscription Rule creation.")
 
 	name := d.Get("name").(string)
@@ -178,7 +178,8 @@ func resourceArmServiceBusSubscriptionRuleCreateUpdate(d *schema.ResourceData, m
 
 func resourceArmServiceBusSubscriptionRuleRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).serviceBusSubscriptionRulesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -186,10 +187,22 @@ func resourceArmServiceBusSubscriptionRuleRead(d *schema.ResourceData, meta inte
 	}
 
 	resourceGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
-	topicName := id.Path["topics"]
-	subscriptionName := id.Path["subscriptions"]
-	name := id.Path["rules"]
+	namespaceName, err := id.PopSegment("namespaces")
+	if err != nil {
+		return err
+	}
+	topicName, err := id.PopSegment("topics")
+	if err != nil {
+		return err
+	}
+	subscriptionName, err := id.PopSegment("subscriptions")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("rules")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resourceGroup, namespaceName, topicName, subscriptionName, name)
 	if err != nil {
@@ -227,17 +240,30 @@ func resourceArmServiceBusSubscriptionRuleRead(d *schema.ResourceData, meta inte
 
 func resourceArmServiceBusSubscriptionRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).serviceBusSubscriptionRulesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	namespaceName := id.Path["namespaces"]
-	topicName := id.Path["topics"]
-	subscriptionName := id.Path["subscriptions"]
-	name := id.Path["rules"]
+	namespaceName, err := id.PopSegment("namespaces")
+	if err != nil {
+		return err
+	}
+	topicName, err := id.PopSegment("topics")
+	if err != nil {
+		return err
+	}
+	subscriptionName, err := id.PopSegment("subscriptions")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("rules")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Delete(ctx, resourceGroup, namespaceName, topicName, subscriptionName, name)
 	if err != nil {
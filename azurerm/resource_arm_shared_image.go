@@ -7,20 +7,20 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmSharedImage() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmSharedImageCreateUpdate,
-		Read:   resourceArmSharedImageRead,
-		Update: resourceArmSharedImageCreateUpdate,
-		Delete: resourceArmSharedImageDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmSharedImageCreateUpdate,
+		Read:     resourceArmSharedImageRead,
+		Update:   resourceArmSharedImageCreateUpdate,
+		Delete:   resourceArmSharedImageDelete,
+		Importer: azure.ImporterValidatingResourceId("galleries", "images"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -99,7 +99,8 @@ func resourceArmSharedImage() *schema.Resource {
 
 func resourceArmSharedImageCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).galleryImagesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Shared Image creation.")
 
@@ -159,7 +160,8 @@ func resourceArmSharedImageCreateUpdate(d *schema.ResourceData, meta interface{}
 
 func resourceArmSharedImageRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).galleryImagesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -167,8 +169,14 @@ func resourceArmSharedImageRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	resourceGroup := id.ResourceGroup
-	galleryName := id.Path["galleries"]
-	name := id.Path["images"]
+	galleryName, err := id.PopSegment("galleries")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("images")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resourceGroup, galleryName, name)
 	if err != nil {
@@ -208,7 +216,8 @@ func resourceArmSharedImageRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmSharedImageDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).galleryImagesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -216,8 +225,14 @@ func resourceArmSharedImageDelete(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	resourceGroup := id.ResourceGroup
-	galleryName := id.Path["galleries"]
-	name := id.Path["images"]
+	galleryName, err := id.PopSegment("galleries")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("images")
+	if err != nil {
+		return err
+	}
 
 	future, err := client.Delete(ctx, resourceGroup, galleryName, name)
 	if err != nil {
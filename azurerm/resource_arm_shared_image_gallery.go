@@ -6,20 +6,20 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmSharedImageGallery() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmSharedImageGalleryCreateUpdate,
-		Read:   resourceArmSharedImageGalleryRead,
-		Update: resourceArmSharedImageGalleryCreateUpdate,
-		Delete: resourceArmSharedImageGalleryDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmSharedImageGalleryCreateUpdate,
+		Read:     resourceArmSharedImageGalleryRead,
+		Update:   resourceArmSharedImageGalleryCreateUpdate,
+		Delete:   resourceArmSharedImageGalleryDelete,
+		Importer: azure.ImporterValidatingResourceId("galleries"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -50,7 +50,8 @@ func resourceArmSharedImageGallery() *schema.Resource {
 
 func resourceArmSharedImageGalleryCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).galleriesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Image Gallery creation.")
 
@@ -95,7 +96,8 @@ func resourceArmSharedImageGalleryCreateUpdate(d *schema.ResourceData, meta inte
 
 func resourceArmSharedImageGalleryRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).galleriesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -103,7 +105,10 @@ func resourceArmSharedImageGalleryRead(d *schema.ResourceData, meta interface{})
 	}
 
 	resourceGroup := id.ResourceGroup
-	name := id.Path["galleries"]
+	name, err := id.PopSegment("galleries")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -136,7 +141,8 @@ func resourceArmSharedImageGalleryRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmSharedImageGalleryDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).galleriesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -144,7 +150,10 @@ func resourceArmSharedImageGalleryDelete(d *schema.ResourceData, meta interface{
 	}
 
 	resourceGroup := id.ResourceGroup
-	name := id.Path["galleries"]
+	name, err := id.PopSegment("galleries")
+	if err != nil {
+		return err
+	}
 
 	future, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
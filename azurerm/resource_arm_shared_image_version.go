@@ -6,20 +6,20 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmSharedImageVersion() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmSharedImageVersionCreateUpdate,
-		Read:   resourceArmSharedImageVersionRead,
-		Update: resourceArmSharedImageVersionCreateUpdate,
-		Delete: resourceArmSharedImageVersionDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmSharedImageVersionCreateUpdate,
+		Read:     resourceArmSharedImageVersionRead,
+		Update:   resourceArmSharedImageVersionCreateUpdate,
+		Delete:   resourceArmSharedImageVersionDelete,
+		Importer: azure.ImporterValidatingResourceId("galleries", "images", "versions"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -86,7 +86,8 @@ func resourceArmSharedImageVersion() *schema.Resource {
 
 func resourceArmSharedImageVersionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).galleryImageVersionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	imageVersion := d.Get("name").(string)
 	imageName := d.Get("image_name").(string)
@@ -139,16 +140,26 @@ func resourceArmSharedImageVersionCreateUpdate(d *schema.ResourceData, meta inte
 
 func resourceArmSharedImageVersionRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).galleryImageVersionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	imageVersion := id.Path["versions"]
-	imageName := id.Path["images"]
-	galleryName := id.Path["galleries"]
+	imageVersion, err := id.PopSegment("versions")
+	if err != nil {
+		return err
+	}
+	imageName, err := id.PopSegment("images")
+	if err != nil {
+		return err
+	}
+	galleryName, err := id.PopSegment("galleries")
+	if err != nil {
+		return err
+	}
 	resourceGroup := id.ResourceGroup
 
 	resp, err := client.Get(ctx, resourceGroup, galleryName, imageName, imageVersion, compute.ReplicationStatusTypesReplicationStatus)
@@ -194,16 +205,26 @@ func resourceArmSharedImageVersionRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmSharedImageVersionDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).galleryImageVersionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	imageVersion := id.Path["versions"]
-	imageName := id.Path["images"]
-	galleryName := id.Path["galleries"]
+	imageVersion, err := id.PopSegment("versions")
+	if err != nil {
+		return err
+	}
+	imageName, err := id.PopSegment("images")
+	if err != nil {
+		return err
+	}
+	galleryName, err := id.PopSegment("galleries")
+	if err != nil {
+		return err
+	}
 	resourceGroup := id.ResourceGroup
 
 	future, err := client.Delete(ctx, resourceGroup, galleryName, imageName, imageVersion)
@@ -8,18 +8,18 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmSnapshot() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmSnapshotCreateUpdate,
-		Read:   resourceArmSnapshotRead,
-		Update: resourceArmSnapshotCreateUpdate,
-		Delete: resourceArmSnapshotDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmSnapshotCreateUpdate,
+		Read:     resourceArmSnapshotRead,
+		Update:   resourceArmSnapshotCreateUpdate,
+		Delete:   resourceArmSnapshotDelete,
+		Importer: azure.ImporterValidatingResourceId("snapshots"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -76,7 +76,8 @@ func resourceArmSnapshot() *schema.Resource {
 
 func resourceArmSnapshotCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).snapshotsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -139,7 +140,8 @@ func resourceArmSnapshotCreateUpdate(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmSnapshotRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).snapshotsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -147,7 +149,10 @@ func resourceArmSnapshotRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	resourceGroup := id.ResourceGroup
-	name := id.Path["snapshots"]
+	name, err := id.PopSegment("snapshots")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -192,7 +197,8 @@ func resourceArmSnapshotRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).snapshotsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -200,7 +206,10 @@ func resourceArmSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	resourceGroup := id.ResourceGroup
-	name := id.Path["snapshots"]
+	name, err := id.PopSegment("snapshots")
+	if err != nil {
+		return err
+	}
 
 	future, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
@@ -7,18 +7,19 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
 	"github.com/hashicorp/terraform/helper/schema"
 	uuid "github.com/satori/go.uuid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmSqlAdministrator() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmSqlActiveDirectoryAdministratorCreateUpdate,
-		Read:   resourceArmSqlActiveDirectoryAdministratorRead,
-		Update: resourceArmSqlActiveDirectoryAdministratorCreateUpdate,
-		Delete: resourceArmSqlActiveDirectoryAdministratorDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmSqlActiveDirectoryAdministratorCreateUpdate,
+		Read:     resourceArmSqlActiveDirectoryAdministratorRead,
+		Update:   resourceArmSqlActiveDirectoryAdministratorCreateUpdate,
+		Delete:   resourceArmSqlActiveDirectoryAdministratorDelete,
+		Importer: azure.ImporterValidatingResourceId("servers", "administrators"),
 
 		Schema: map[string]*schema.Schema{
 			"server_name": {
@@ -51,7 +52,8 @@ func resourceArmSqlAdministrator() *schema.Resource {
 
 func resourceArmSqlActiveDirectoryAdministratorCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).sqlServerAzureADAdministratorsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	serverName := d.Get("server_name").(string)
 	resGroup := d.Get("resource_group_name").(string)
@@ -69,27 +71,32 @@ func resourceArmSqlActiveDirectoryAdministratorCreateUpdate(d *schema.ResourceDa
 
 	future, err := client.CreateOrUpdate(ctx, resGroup, serverName, parameters)
 	if err != nil {
-		return err
+		return fmt.Errorf("Error setting SQL AD administrator for Server %q (Resource Group %q): %+v", serverName, resGroup, err)
 	}
 
 	err = future.WaitForCompletionRef(ctx, client.Client)
 	if err != nil {
-		return err
+		return fmt.Errorf("Error waiting for SQL AD administrator for Server %q (Resource Group %q) to be set: %+v", serverName, resGroup, err)
 	}
 
 	resp, err := client.Get(ctx, resGroup, serverName)
 	if err != nil {
-		return err
+		return fmt.Errorf("Error retrieving SQL AD administrator for Server %q (Resource Group %q): %+v", serverName, resGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ID for SQL AD administrator for Server %q (Resource Group %q)", serverName, resGroup)
 	}
 
 	d.SetId(*resp.ID)
 
-	return nil
+	return resourceArmSqlActiveDirectoryAdministratorRead(d, meta)
 }
 
 func resourceArmSqlActiveDirectoryAdministratorRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).sqlServerAzureADAdministratorsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -97,7 +104,10 @@ func resourceArmSqlActiveDirectoryAdministratorRead(d *schema.ResourceData, meta
 	}
 
 	resourceGroup := id.ResourceGroup
-	serverName := id.Path["servers"]
+	serverName, err := id.PopSegment("servers")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resourceGroup, serverName)
 	if err != nil {
@@ -112,16 +122,26 @@ func resourceArmSqlActiveDirectoryAdministratorRead(d *schema.ResourceData, meta
 
 	d.Set("resource_group_name", resourceGroup)
 	d.Set("server_name", serverName)
-	d.Set("login", resp.Login)
-	d.Set("object_id", resp.Sid.String())
-	d.Set("tenant_id", resp.TenantID.String())
+
+	if props := resp.ServerAdministratorProperties; props != nil {
+		d.Set("login", props.Login)
+
+		if sid := props.Sid; sid != nil {
+			d.Set("object_id", sid.String())
+		}
+
+		if tenantId := props.TenantID; tenantId != nil {
+			d.Set("tenant_id", tenantId.String())
+		}
+	}
 
 	return nil
 }
 
 func resourceArmSqlActiveDirectoryAdministratorDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).sqlServerAzureADAdministratorsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -129,12 +149,25 @@ func resourceArmSqlActiveDirectoryAdministratorDelete(d *schema.ResourceData, me
 	}
 
 	resourceGroup := id.ResourceGroup
-	serverName := id.Path["servers"]
+	serverName, err := id.PopSegment("servers")
+	if err != nil {
+		return err
+	}
 
-	_, err = client.Delete(ctx, resourceGroup, serverName)
+	future, err := client.Delete(ctx, resourceGroup, serverName)
 	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
 		return fmt.Errorf("Error deleting SQL AD administrator: %+v", err)
 	}
 
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of SQL AD administrator: %+v", err)
+		}
+	}
+
 	return nil
 }
@@ -11,19 +11,19 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/satori/go.uuid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmSqlDatabase() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmSqlDatabaseCreateUpdate,
-		Read:   resourceArmSqlDatabaseRead,
-		Update: resourceArmSqlDatabaseCreateUpdate,
-		Delete: resourceArmSqlDatabaseDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmSqlDatabaseCreateUpdate,
+		Read:     resourceArmSqlDatabaseRead,
+		Update:   resourceArmSqlDatabaseCreateUpdate,
+		Delete:   resourceArmSqlDatabaseDelete,
+		Importer: azure.ImporterValidatingResourceId("servers", "databases"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -182,6 +182,11 @@ func resourceArmSqlDatabase() *schema.Resource {
 				Computed: true,
 			},
 
+			"zone_redundant": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
 			"encryption": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -326,7 +331,8 @@ func resourceArmSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}
 	properties := sql.Database{
 		Location: utils.String(location),
 		DatabaseProperties: &sql.DatabaseProperties{
-			CreateMode: sql.CreateMode(createMode),
+			CreateMode:    sql.CreateMode(createMode),
+			ZoneRedundant: utils.Bool(d.Get("zone_redundant").(bool)),
 		},
 		Tags: expandTags(tags),
 	}
@@ -399,7 +405,8 @@ func resourceArmSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}
 		properties.DatabaseProperties.RequestedServiceObjectiveID = nil
 	}
 
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, name, properties)
 	if err != nil {
 		return err
@@ -448,7 +455,8 @@ func resourceArmSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}
 
 func resourceArmSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).sqlDatabasesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -456,8 +464,14 @@ func resourceArmSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	resourceGroup := id.ResourceGroup
-	serverName := id.Path["servers"]
-	name := id.Path["databases"]
+	serverName, err := id.PopSegment("servers")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("databases")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resourceGroup, serverName, name, "")
 	if err != nil {
@@ -497,6 +511,7 @@ func resourceArmSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("elastic_pool_name", props.ElasticPoolName)
 		d.Set("max_size_bytes", props.MaxSizeBytes)
 		d.Set("requested_service_objective_name", string(props.RequestedServiceObjectiveName))
+		d.Set("zone_redundant", props.ZoneRedundant)
 
 		if cd := props.CreationDate; cd != nil {
 			d.Set("creation_date", cd.String())
@@ -524,7 +539,8 @@ func resourceArmSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmSqlDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).sqlDatabasesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -532,8 +548,14 @@ func resourceArmSqlDatabaseDelete(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	resourceGroup := id.ResourceGroup
-	serverName := id.Path["servers"]
-	name := id.Path["databases"]
+	serverName, err := id.PopSegment("servers")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("databases")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Delete(ctx, resourceGroup, serverName, name)
 	if err != nil {
@@ -0,0 +1,226 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSqlDatabaseAuditingPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceArmSqlDatabaseAuditingPolicyCreateUpdate,
+		Read:     resourceArmSqlDatabaseAuditingPolicyRead,
+		Update:   resourceArmSqlDatabaseAuditingPolicyCreateUpdate,
+		Delete:   resourceArmSqlDatabaseAuditingPolicyDelete,
+		Importer: azure.ImporterValidatingResourceId("servers", "databases", "auditingSettings"),
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"server_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"database_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"storage_endpoint": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"storage_account_access_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"storage_account_access_key_is_secondary": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"retention_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 3285),
+			},
+
+			"audit_actions_and_groups": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+		},
+	}
+}
+
+func resourceArmSqlDatabaseAuditingPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlDatabaseBlobAuditingPoliciesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	serverName := d.Get("server_name").(string)
+	databaseName := d.Get("database_name").(string)
+
+	properties := sql.DatabaseBlobAuditingPolicyProperties{
+		State:                      sql.BlobAuditingPolicyStateEnabled,
+		StorageEndpoint:            utils.String(d.Get("storage_endpoint").(string)),
+		StorageAccountAccessKey:    utils.String(d.Get("storage_account_access_key").(string)),
+		IsStorageSecondaryKeyInUse: utils.Bool(d.Get("storage_account_access_key_is_secondary").(bool)),
+		RetentionDays:              utils.Int32(int32(d.Get("retention_days").(int))),
+	}
+
+	if v, ok := d.GetOk("audit_actions_and_groups"); ok {
+		auditActionsAndGroups := make([]string, 0)
+		for _, item := range v.([]interface{}) {
+			auditActionsAndGroups = append(auditActionsAndGroups, item.(string))
+		}
+		properties.AuditActionsAndGroups = &auditActionsAndGroups
+	}
+
+	parameters := sql.DatabaseBlobAuditingPolicy{
+		DatabaseBlobAuditingPolicyProperties: &properties,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, databaseName, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Auditing Policy for SQL Database %q (Server %q / Resource Group %q): %+v", databaseName, serverName, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, serverName, databaseName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Auditing Policy for SQL Database %q (Server %q / Resource Group %q): %+v", databaseName, serverName, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ID for Auditing Policy for SQL Database %q (Server %q / Resource Group %q)", databaseName, serverName, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmSqlDatabaseAuditingPolicyRead(d, meta)
+}
+
+func resourceArmSqlDatabaseAuditingPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlDatabaseBlobAuditingPoliciesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName, err := id.PopSegment("servers")
+	if err != nil {
+		return err
+	}
+	databaseName, err := id.PopSegment("databases")
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, serverName, databaseName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] SQL Database %q (Server %q / Resource Group %q) was not found - removing Auditing Policy from state", databaseName, serverName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Auditing Policy for SQL Database %q (Server %q / Resource Group %q): %+v", databaseName, serverName, resourceGroup, err)
+	}
+
+	props := resp.DatabaseBlobAuditingPolicyProperties
+	// the Auditing Policy always exists for a Database, so a Disabled policy is treated as deleted
+	if props == nil || props.State == sql.BlobAuditingPolicyStateDisabled {
+		log.Printf("[INFO] Auditing Policy for SQL Database %q (Server %q / Resource Group %q) is Disabled - removing from state", databaseName, serverName, resourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("server_name", serverName)
+	d.Set("database_name", databaseName)
+
+	// the `storage_account_access_key` isn't returned from the API
+	d.Set("storage_endpoint", props.StorageEndpoint)
+	d.Set("storage_account_access_key_is_secondary", props.IsStorageSecondaryKeyInUse)
+
+	if retentionDays := props.RetentionDays; retentionDays != nil {
+		d.Set("retention_days", int(*retentionDays))
+	}
+
+	auditActionsAndGroups := make([]interface{}, 0)
+	if props.AuditActionsAndGroups != nil {
+		for _, item := range *props.AuditActionsAndGroups {
+			auditActionsAndGroups = append(auditActionsAndGroups, item)
+		}
+	}
+	if err := d.Set("audit_actions_and_groups", auditActionsAndGroups); err != nil {
+		return fmt.Errorf("Error setting `audit_actions_and_groups`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmSqlDatabaseAuditingPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlDatabaseBlobAuditingPoliciesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName, err := id.PopSegment("servers")
+	if err != nil {
+		return err
+	}
+	databaseName, err := id.PopSegment("databases")
+	if err != nil {
+		return err
+	}
+
+	// the Auditing Policy can't be deleted, instead it's Disabled
+	parameters := sql.DatabaseBlobAuditingPolicy{
+		DatabaseBlobAuditingPolicyProperties: &sql.DatabaseBlobAuditingPolicyProperties{
+			State: sql.BlobAuditingPolicyStateDisabled,
+		},
+	}
+
+	if resp, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, databaseName, parameters); err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error disabling Auditing Policy for SQL Database %q (Server %q / Resource Group %q): %+v", databaseName, serverName, resourceGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,201 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMSqlDatabaseAuditingPolicy_basic(t *testing.T) {
+	resourceName := "azurerm_sql_database_auditing_policy.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlDatabaseAuditingPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSqlDatabaseAuditingPolicy_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseAuditingPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "retention_days", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "audit_actions_and_groups.#"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"storage_account_access_key"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSqlDatabaseAuditingPolicy_update(t *testing.T) {
+	resourceName := "azurerm_sql_database_auditing_policy.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlDatabaseAuditingPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSqlDatabaseAuditingPolicy_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseAuditingPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "retention_days", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMSqlDatabaseAuditingPolicy_complete(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseAuditingPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "retention_days", "30"),
+					resource.TestCheckResourceAttr(resourceName, "storage_account_access_key_is_secondary", "true"),
+					resource.TestCheckResourceAttr(resourceName, "audit_actions_and_groups.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "audit_actions_and_groups.0", "SUCCESSFUL_DATABASE_AUTHENTICATION_GROUP"),
+					resource.TestCheckResourceAttr(resourceName, "audit_actions_and_groups.1", "FAILED_DATABASE_AUTHENTICATION_GROUP"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSqlDatabaseAuditingPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		databaseName := rs.Primary.Attributes["database_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).sqlDatabaseBlobAuditingPoliciesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, databaseName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on sqlDatabaseBlobAuditingPoliciesClient: %+v", err)
+		}
+
+		if props := resp.DatabaseBlobAuditingPolicyProperties; props == nil || props.State != sql.BlobAuditingPolicyStateEnabled {
+			return fmt.Errorf("Bad: Auditing Policy for SQL Database %q (Server %q / Resource Group %q) is not Enabled", databaseName, serverName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMSqlDatabaseAuditingPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).sqlDatabaseBlobAuditingPoliciesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_sql_database_auditing_policy" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		databaseName := rs.Primary.Attributes["database_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, databaseName)
+		if err != nil {
+			// the Database is removed alongside the Auditing Policy
+			return nil
+		}
+
+		if props := resp.DatabaseBlobAuditingPolicyProperties; props != nil && props.State == sql.BlobAuditingPolicyStateEnabled {
+			return fmt.Errorf("Auditing Policy for SQL Database %q (Server %q / Resource Group %q) is still Enabled", databaseName, serverName, resourceGroup)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMSqlDatabaseAuditingPolicy_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMSqlDatabaseAuditingPolicy_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_database_auditing_policy" "test" {
+  resource_group_name        = "${azurerm_resource_group.test.name}"
+  server_name                = "${azurerm_sql_server.test.name}"
+  database_name              = "${azurerm_sql_database.test.name}"
+  storage_endpoint           = "${azurerm_storage_account.test.primary_blob_endpoint}"
+  storage_account_access_key = "${azurerm_storage_account.test.primary_access_key}"
+}
+`, template)
+}
+
+func testAccAzureRMSqlDatabaseAuditingPolicy_complete(rInt int, rString string, location string) string {
+	template := testAccAzureRMSqlDatabaseAuditingPolicy_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_database_auditing_policy" "test" {
+  resource_group_name                     = "${azurerm_resource_group.test.name}"
+  server_name                             = "${azurerm_sql_server.test.name}"
+  database_name                           = "${azurerm_sql_database.test.name}"
+  storage_endpoint                        = "${azurerm_storage_account.test.primary_blob_endpoint}"
+  storage_account_access_key              = "${azurerm_storage_account.test.secondary_access_key}"
+  storage_account_access_key_is_secondary = true
+  retention_days                          = 30
+
+  audit_actions_and_groups = [
+    "SUCCESSFUL_DATABASE_AUTHENTICATION_GROUP",
+    "FAILED_DATABASE_AUTHENTICATION_GROUP",
+  ]
+}
+`, template)
+}
+
+func testAccAzureRMSqlDatabaseAuditingPolicy_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[3]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[2]s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestsqlserver%[1]d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_database" "test" {
+  name                             = "acctestdb%[1]d"
+  resource_group_name              = "${azurerm_resource_group.test.name}"
+  server_name                      = "${azurerm_sql_server.test.name}"
+  location                         = "${azurerm_resource_group.test.location}"
+  edition                          = "Standard"
+  collation                        = "SQL_Latin1_General_CP1_CI_AS"
+  max_size_bytes                   = "1073741824"
+  requested_service_objective_name = "S0"
+}
+`, rInt, rString, location)
+}
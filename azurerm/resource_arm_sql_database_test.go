@@ -215,6 +215,36 @@ func TestAccAzureRMSqlDatabase_requestedServiceObjectiveName(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMSqlDatabase_zoneRedundant(t *testing.T) {
+	resourceName := "azurerm_sql_database.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	preConfig := testAccAzureRMSqlDatabase_zoneRedundant(ri, location, false)
+	postConfig := testAccAzureRMSqlDatabase_zoneRedundant(ri, location, true)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "zone_redundant", "false"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "zone_redundant", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMSqlDatabase_threatDetectionPolicy(t *testing.T) {
 	resourceName := "azurerm_sql_database.test"
 	ri := acctest.RandInt()
@@ -686,6 +716,36 @@ resource "azurerm_sql_database" "test" {
 `, rInt, location, rInt, rInt, requestedServiceObjectiveName)
 }
 
+func testAccAzureRMSqlDatabase_zoneRedundant(rInt int, location string, zoneRedundant bool) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG-%[1]d"
+    location = "%[2]s"
+}
+
+resource "azurerm_sql_server" "test" {
+    name = "acctestsqlserver%[1]d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    version = "12.0"
+    administrator_login = "mradministrator"
+    administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_database" "test" {
+    name = "acctestdb%[1]d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    server_name = "${azurerm_sql_server.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    edition = "Premium"
+    collation = "SQL_Latin1_General_CP1_CI_AS"
+    max_size_bytes = "1073741824"
+    requested_service_objective_name = "P1"
+    zone_redundant = %[3]t
+}
+`, rInt, location, zoneRedundant)
+}
+
 func testAccAzureRMSqlDatabase_threatDetectionPolicy(rInt int, location, state string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
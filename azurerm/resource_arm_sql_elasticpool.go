@@ -8,6 +8,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -68,6 +69,11 @@ func resourceArmSqlElasticPool() *schema.Resource {
 				Computed: true,
 			},
 
+			"zone_redundant": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
 			"creation_date": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -80,7 +86,8 @@ func resourceArmSqlElasticPool() *schema.Resource {
 
 func resourceArmSqlElasticPoolCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).sqlElasticPoolsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for SQL ElasticPool creation.")
 
@@ -122,7 +129,8 @@ func resourceArmSqlElasticPoolCreate(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmSqlElasticPoolRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).sqlElasticPoolsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resGroup, serverName, name, err := parseArmSqlElasticPoolId(d.Id())
 	if err != nil {
@@ -151,6 +159,7 @@ func resourceArmSqlElasticPoolRead(d *schema.ResourceData, meta interface{}) err
 		d.Set("db_dtu_min", int(*elasticPool.DatabaseDtuMin))
 		d.Set("db_dtu_max", int(*elasticPool.DatabaseDtuMax))
 		d.Set("pool_size", int(*elasticPool.StorageMB))
+		d.Set("zone_redundant", elasticPool.ZoneRedundant)
 
 		if date := elasticPool.CreationDate; date != nil {
 			d.Set("creation_date", date.Format(time.RFC3339))
@@ -164,7 +173,8 @@ func resourceArmSqlElasticPoolRead(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmSqlElasticPoolDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).sqlElasticPoolsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resGroup, serverName, name, err := parseArmSqlElasticPoolId(d.Id())
 	if err != nil {
@@ -179,10 +189,12 @@ func resourceArmSqlElasticPoolDelete(d *schema.ResourceData, meta interface{}) e
 func getArmSqlElasticPoolProperties(d *schema.ResourceData) *sql.ElasticPoolProperties {
 	edition := sql.ElasticPoolEdition(d.Get("edition").(string))
 	dtu := int32(d.Get("dtu").(int))
+	zoneRedundant := d.Get("zone_redundant").(bool)
 
 	props := &sql.ElasticPoolProperties{
-		Edition: edition,
-		Dtu:     &dtu,
+		Edition:       edition,
+		Dtu:           &dtu,
+		ZoneRedundant: &zoneRedundant,
 	}
 
 	if databaseDtuMin, ok := d.GetOk("db_dtu_min"); ok {
@@ -209,7 +221,17 @@ func parseArmSqlElasticPoolId(sqlElasticPoolId string) (string, string, string,
 		return "", "", "", fmt.Errorf("[ERROR] Unable to parse SQL ElasticPool ID %q: %+v", sqlElasticPoolId, err)
 	}
 
-	return id.ResourceGroup, id.Path["servers"], id.Path["elasticPools"], nil
+	serverName, err := id.PopSegment("servers")
+	if err != nil {
+		return "", "", "", err
+	}
+
+	name, err := id.PopSegment("elasticPools")
+	if err != nil {
+		return "", "", "", err
+	}
+
+	return id.ResourceGroup, serverName, name, nil
 }
 
 func validateSqlElasticPoolEdition() schema.SchemaValidateFunc {
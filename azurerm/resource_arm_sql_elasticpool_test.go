@@ -83,6 +83,36 @@ func TestAccAzureRMSqlElasticPool_resizeDtu(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMSqlElasticPool_zoneRedundant(t *testing.T) {
+	resourceName := "azurerm_sql_elasticpool.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	preConfig := testAccAzureRMSqlElasticPool_zoneRedundant(ri, location, false)
+	postConfig := testAccAzureRMSqlElasticPool_zoneRedundant(ri, location, true)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlElasticPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlElasticPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "zone_redundant", "false"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlElasticPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "zone_redundant", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMSqlElasticPoolExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -216,3 +246,32 @@ resource "azurerm_sql_elasticpool" "test" {
 }
 `, rInt, location)
 }
+
+func testAccAzureRMSqlElasticPool_zoneRedundant(rInt int, location string, zoneRedundant bool) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctest-%[1]d"
+    location = "%[2]s"
+}
+
+resource "azurerm_sql_server" "test" {
+    name = "acctest%[1]d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    version = "12.0"
+    administrator_login = "4dm1n157r470r"
+    administrator_login_password = "4-v3ry-53cr37-p455w0rd"
+}
+
+resource "azurerm_sql_elasticpool" "test" {
+    name = "acctest-pool-%[1]d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    server_name = "${azurerm_sql_server.test.name}"
+    edition = "Premium"
+    dtu = 125
+    pool_size = 256000
+    zone_redundant = %[3]t
+}
+`, rInt, location, zoneRedundant)
+}
@@ -0,0 +1,420 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSqlFailoverGroup() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceArmSqlFailoverGroupCreateUpdate,
+		Read:     resourceArmSqlFailoverGroupRead,
+		Update:   resourceArmSqlFailoverGroupCreateUpdate,
+		Delete:   resourceArmSqlFailoverGroupDelete,
+		Importer: azure.ImporterValidatingResourceId("servers", "failoverGroups"),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"server_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"databases": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+				Set: schema.HashString,
+			},
+
+			"partner_servers": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"readonly_endpoint_failover_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(sql.ReadOnlyEndpointFailoverPolicyDisabled),
+								string(sql.ReadOnlyEndpointFailoverPolicyEnabled),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"read_write_endpoint_failover_policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(sql.Automatic),
+								string(sql.Manual),
+							}, false),
+						},
+
+						"grace_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(60),
+						},
+					},
+				},
+			},
+
+			"role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"read_write_listener_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"read_only_listener_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmSqlFailoverGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlFailoverGroupsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	serverName := d.Get("server_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	readWriteEndpointFailoverPolicy, err := expandSqlFailoverGroupReadWriteEndpointFailoverPolicy(d.Get("read_write_endpoint_failover_policy").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	properties := sql.FailoverGroupProperties{
+		ReadOnlyEndpoint:  expandSqlFailoverGroupReadOnlyEndpointFailoverPolicy(d.Get("readonly_endpoint_failover_policy").([]interface{})),
+		ReadWriteEndpoint: readWriteEndpointFailoverPolicy,
+		PartnerServers:    expandSqlFailoverGroupPartnerServers(d.Get("partner_servers").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("databases"); ok {
+		databases := make([]string, 0)
+		for _, database := range v.(*schema.Set).List() {
+			databases = append(databases, database.(string))
+		}
+		properties.Databases = &databases
+	}
+
+	parameters := sql.FailoverGroup{
+		FailoverGroupProperties: &properties,
+		Tags:                    expandTags(tags),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating SQL Failover Group %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of SQL Failover Group %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving SQL Failover Group %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ID for SQL Failover Group %q (Server %q / Resource Group %q)", name, serverName, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmSqlFailoverGroupRead(d, meta)
+}
+
+func resourceArmSqlFailoverGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlFailoverGroupsClient
+	environment := meta.(*ArmClient).environment
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName, err := id.PopSegment("servers")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("failoverGroups")
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] SQL Failover Group %q (Server %q / Resource Group %q) was not found - removing from state", name, serverName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving SQL Failover Group %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("server_name", serverName)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.FailoverGroupProperties; props != nil {
+		if err := d.Set("read_write_endpoint_failover_policy", flattenSqlFailoverGroupReadWriteEndpointFailoverPolicy(props.ReadWriteEndpoint)); err != nil {
+			return fmt.Errorf("Error setting `read_write_endpoint_failover_policy`: %+v", err)
+		}
+
+		if err := d.Set("readonly_endpoint_failover_policy", flattenSqlFailoverGroupReadOnlyEndpointFailoverPolicy(props.ReadOnlyEndpoint)); err != nil {
+			return fmt.Errorf("Error setting `readonly_endpoint_failover_policy`: %+v", err)
+		}
+
+		if err := d.Set("databases", flattenSqlFailoverGroupDatabases(props.Databases)); err != nil {
+			return fmt.Errorf("Error setting `databases`: %+v", err)
+		}
+
+		if err := d.Set("partner_servers", flattenSqlFailoverGroupPartnerServers(props.PartnerServers)); err != nil {
+			return fmt.Errorf("Error setting `partner_servers`: %+v", err)
+		}
+
+		d.Set("role", string(props.ReplicationRole))
+	}
+
+	// the listener endpoints aren't returned from the API, but are derived from the name of the Failover Group
+	d.Set("read_write_listener_endpoint", fmt.Sprintf("%s.%s", name, environment.SQLDatabaseDNSSuffix))
+	d.Set("read_only_listener_endpoint", fmt.Sprintf("%s.secondary.%s", name, environment.SQLDatabaseDNSSuffix))
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmSqlFailoverGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlFailoverGroupsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName, err := id.PopSegment("servers")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("failoverGroups")
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting SQL Failover Group %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of SQL Failover Group %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandSqlFailoverGroupReadOnlyEndpointFailoverPolicy(input []interface{}) *sql.FailoverGroupReadOnlyEndpoint {
+	if len(input) == 0 {
+		return nil
+	}
+
+	policy := input[0].(map[string]interface{})
+	mode := policy["mode"].(string)
+
+	return &sql.FailoverGroupReadOnlyEndpoint{
+		FailoverPolicy: sql.ReadOnlyEndpointFailoverPolicy(mode),
+	}
+}
+
+func flattenSqlFailoverGroupReadOnlyEndpointFailoverPolicy(input *sql.FailoverGroupReadOnlyEndpoint) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"mode": string(input.FailoverPolicy),
+		},
+	}
+}
+
+func expandSqlFailoverGroupReadWriteEndpointFailoverPolicy(input []interface{}) (*sql.FailoverGroupReadWriteEndpoint, error) {
+	policy := input[0].(map[string]interface{})
+	mode := sql.ReadWriteEndpointFailoverPolicy(policy["mode"].(string))
+	graceMinutes := policy["grace_minutes"].(int)
+
+	endpoint := sql.FailoverGroupReadWriteEndpoint{
+		FailoverPolicy: mode,
+	}
+
+	if mode == sql.Automatic {
+		if graceMinutes == 0 {
+			return nil, fmt.Errorf("`grace_minutes` must be specified when the `mode` of the `read_write_endpoint_failover_policy` is `Automatic`")
+		}
+
+		endpoint.FailoverWithDataLossGracePeriodMinutes = utils.Int32(int32(graceMinutes))
+	} else if graceMinutes != 0 {
+		return nil, fmt.Errorf("`grace_minutes` can only be specified when the `mode` of the `read_write_endpoint_failover_policy` is `Automatic`")
+	}
+
+	return &endpoint, nil
+}
+
+func flattenSqlFailoverGroupReadWriteEndpointFailoverPolicy(input *sql.FailoverGroupReadWriteEndpoint) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	graceMinutes := 0
+	if input.FailoverWithDataLossGracePeriodMinutes != nil {
+		graceMinutes = int(*input.FailoverWithDataLossGracePeriodMinutes)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"mode":          string(input.FailoverPolicy),
+			"grace_minutes": graceMinutes,
+		},
+	}
+}
+
+func expandSqlFailoverGroupPartnerServers(input []interface{}) *[]sql.PartnerInfo {
+	partners := make([]sql.PartnerInfo, 0)
+
+	for _, raw := range input {
+		partner := raw.(map[string]interface{})
+		partners = append(partners, sql.PartnerInfo{
+			ID: utils.String(partner["id"].(string)),
+		})
+	}
+
+	return &partners
+}
+
+func flattenSqlFailoverGroupPartnerServers(input *[]sql.PartnerInfo) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, partner := range *input {
+		output := make(map[string]interface{})
+
+		if partner.ID != nil {
+			output["id"] = *partner.ID
+		}
+
+		if partner.Location != nil {
+			output["location"] = azureRMNormalizeLocation(*partner.Location)
+		}
+
+		output["role"] = string(partner.ReplicationRole)
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func flattenSqlFailoverGroupDatabases(input *[]string) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, database := range *input {
+		results = append(results, database)
+	}
+
+	return results
+}
@@ -0,0 +1,345 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSqlManagedInstance() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceArmSqlManagedInstanceCreateUpdate,
+		Read:     resourceArmSqlManagedInstanceRead,
+		Update:   resourceArmSqlManagedInstanceCreateUpdate,
+		Delete:   resourceArmSqlManagedInstanceDelete,
+		Importer: azure.ImporterValidatingResourceId("managedInstances"),
+
+		// provisioning a Managed Instance into an empty Subnet can take upwards of 6 hours
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(24 * time.Hour),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(24 * time.Hour),
+			Delete: schema.DefaultTimeout(24 * time.Hour),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"sku": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"GP_Gen4",
+								"GP_Gen5",
+								"BC_Gen4",
+								"BC_Gen5",
+							}, false),
+						},
+
+						"tier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"family": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"administrator_login": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"administrator_login_password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"license_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"LicenseIncluded",
+					"BasePrice",
+				}, false),
+			},
+
+			"vcores": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ValidateFunc: validate.IntInSlice([]int{
+					8,
+					16,
+					24,
+					32,
+					40,
+					64,
+					80,
+				}),
+			},
+
+			"storage_size_in_gb": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateSqlManagedInstanceStorageSize,
+			},
+
+			"collation": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "SQL_Latin1_General_CP1_CI_AS",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"dns_zone_partner_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"dns_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmSqlManagedInstanceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlManagedInstancesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	properties := sql.ManagedInstanceProperties{
+		AdministratorLogin:         utils.String(d.Get("administrator_login").(string)),
+		AdministratorLoginPassword: utils.String(d.Get("administrator_login_password").(string)),
+		SubnetID:                   utils.String(d.Get("subnet_id").(string)),
+		LicenseType:                utils.String(d.Get("license_type").(string)),
+		VCores:                     utils.Int32(int32(d.Get("vcores").(int))),
+		StorageSizeInGB:            utils.Int32(int32(d.Get("storage_size_in_gb").(int))),
+		Collation:                  utils.String(d.Get("collation").(string)),
+	}
+
+	if v, ok := d.GetOk("dns_zone_partner_id"); ok {
+		properties.DNSZonePartner = utils.String(v.(string))
+	}
+
+	parameters := sql.ManagedInstance{
+		Location:                  utils.String(location),
+		Sku:                       expandSqlManagedInstanceSku(d.Get("sku").([]interface{})),
+		ManagedInstanceProperties: &properties,
+		Tags:                      expandTags(tags),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating SQL Managed Instance %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of SQL Managed Instance %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving SQL Managed Instance %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ID for SQL Managed Instance %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmSqlManagedInstanceRead(d, meta)
+}
+
+func resourceArmSqlManagedInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlManagedInstancesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["managedInstances"]
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] SQL Managed Instance %q (Resource Group %q) was not found - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving SQL Managed Instance %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if err := d.Set("sku", flattenSqlManagedInstanceSku(resp.Sku)); err != nil {
+		return fmt.Errorf("Error setting `sku`: %+v", err)
+	}
+
+	if props := resp.ManagedInstanceProperties; props != nil {
+		// the `administrator_login_password` isn't returned from the API
+		d.Set("administrator_login", props.AdministratorLogin)
+		d.Set("subnet_id", props.SubnetID)
+		d.Set("license_type", props.LicenseType)
+		d.Set("collation", props.Collation)
+		d.Set("dns_zone", props.DNSZone)
+		d.Set("fqdn", props.FullyQualifiedDomainName)
+
+		if vCores := props.VCores; vCores != nil {
+			d.Set("vcores", int(*vCores))
+		}
+
+		if storageSize := props.StorageSizeInGB; storageSize != nil {
+			d.Set("storage_size_in_gb", int(*storageSize))
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmSqlManagedInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlManagedInstancesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["managedInstances"]
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting SQL Managed Instance %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of SQL Managed Instance %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandSqlManagedInstanceSku(input []interface{}) *sql.Sku {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &sql.Sku{
+		Name: utils.String(v["name"].(string)),
+	}
+}
+
+func flattenSqlManagedInstanceSku(input *sql.Sku) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make(map[string]interface{})
+	if name := input.Name; name != nil {
+		output["name"] = *name
+	}
+	if tier := input.Tier; tier != nil {
+		output["tier"] = *tier
+	}
+	if family := input.Family; family != nil {
+		output["family"] = *family
+	}
+
+	return []interface{}{output}
+}
+
+func validateSqlManagedInstanceStorageSize(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(int)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be int", k))
+		return
+	}
+
+	if v < 32 || v > 8192 {
+		errors = append(errors, fmt.Errorf("%q must be between 32 and 8192 (inclusive), got %d", k, v))
+		return
+	}
+
+	if v%32 != 0 {
+		errors = append(errors, fmt.Errorf("%q must be a multiple of 32, got %d", k, v))
+	}
+
+	return
+}
@@ -0,0 +1,185 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestValidateSqlManagedInstanceStorageSize(t *testing.T) {
+	testCases := []struct {
+		input       int
+		shouldError bool
+	}{
+		{0, true},
+		{16, true},
+		{32, false},
+		{50, true},
+		{64, false},
+		{8192, false},
+		{8224, true},
+	}
+
+	for _, test := range testCases {
+		_, es := validateSqlManagedInstanceStorageSize(test.input, "storage_size_in_gb")
+
+		if test.shouldError && len(es) == 0 {
+			t.Fatalf("Expected validating storage size %d to fail", test.input)
+		}
+
+		if !test.shouldError && len(es) > 0 {
+			t.Fatalf("Expected validating storage size %d not to fail", test.input)
+		}
+	}
+}
+
+func TestAccAzureRMSqlManagedInstance_basic(t *testing.T) {
+	resourceName := "azurerm_sql_managed_instance.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlManagedInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSqlManagedInstance_basic(ri, location, 32),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlManagedInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.name", "GP_Gen5"),
+					resource.TestCheckResourceAttr(resourceName, "vcores", "8"),
+					resource.TestCheckResourceAttr(resourceName, "storage_size_in_gb", "32"),
+					resource.TestCheckResourceAttrSet(resourceName, "fqdn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"administrator_login_password"},
+			},
+			{
+				Config: testAccAzureRMSqlManagedInstance_basic(ri, location, 64),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlManagedInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "storage_size_in_gb", "64"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSqlManagedInstanceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).sqlManagedInstancesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: SQL Managed Instance %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on sqlManagedInstancesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMSqlManagedInstanceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).sqlManagedInstancesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_sql_managed_instance" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("SQL Managed Instance %q (Resource Group %q) still exists", name, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMSqlManagedInstance_basic(rInt int, location string, storageSizeInGB int) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_route_table" "test" {
+  name                = "acctestrt%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  route {
+    name           = "internet"
+    address_prefix = "0.0.0.0/0"
+    next_hop_type  = "Internet"
+  }
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsubnet%[1]d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+  route_table_id       = "${azurerm_route_table.test.id}"
+}
+
+resource "azurerm_subnet_route_table_association" "test" {
+  subnet_id      = "${azurerm_subnet.test.id}"
+  route_table_id = "${azurerm_route_table.test.id}"
+}
+
+resource "azurerm_sql_managed_instance" "test" {
+  name                         = "acctestsqlmi%[1]d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11thisIsDog11"
+  subnet_id                    = "${azurerm_subnet_route_table_association.test.subnet_id}"
+  license_type                 = "BasePrice"
+  vcores                       = 8
+  storage_size_in_gb           = %[3]d
+
+  sku {
+    name = "GP_Gen5"
+  }
+}
+`, rInt, location, storageSizeInGB)
+}
@@ -1,7 +1,7 @@
 package azurerm
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -10,7 +10,9 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-10-01/storage"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -23,9 +25,8 @@ func resourceArmStorageAccount() *schema.Resource {
 		Update: resourceArmStorageAccountUpdate,
 		Delete: resourceArmStorageAccountDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer:      azure.ImporterValidatingResourceId("storageAccounts"),
+		CustomizeDiff: resourceArmStorageAccountCustomizeDiff,
 		MigrateState:  resourceStorageAccountMigrateState,
 		SchemaVersion: 2,
 
@@ -300,27 +301,25 @@ func resourceArmStorageAccount() *schema.Resource {
 	}
 }
 
-func validateAzureRMStorageAccountTags(v interface{}, _ string) (ws []string, es []error) {
-	tagsMap := v.(map[string]interface{})
-
-	if len(tagsMap) > 15 {
-		es = append(es, errors.New("a maximum of 15 tags can be applied to each ARM resource"))
-	}
-
-	for k, v := range tagsMap {
-		if len(k) > 128 {
-			es = append(es, fmt.Errorf("the maximum length for a tag key is 128 characters: %q is %d characters", k, len(k)))
+func resourceArmStorageAccountCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	return validateNameIsAvailable(diff, meta, "Storage Account", func(ctx context.Context, name string) (*bool, *string, error) {
+		client := meta.(*ArmClient).storageServiceClient
+		parameters := storage.AccountCheckNameAvailabilityParameters{
+			Name: utils.String(name),
+			Type: utils.String("Microsoft.Storage/storageAccounts"),
 		}
-
-		value, err := tagValueToString(v)
+		available, err := client.CheckNameAvailability(ctx, parameters)
 		if err != nil {
-			es = append(es, err)
-		} else if len(value) > 256 {
-			es = append(es, fmt.Errorf("the maximum length for a tag value is 256 characters: the value for %q is %d characters", k, len(value)))
+			return nil, nil, err
 		}
-	}
 
-	return ws, es
+		return available.NameAvailable, available.Message, nil
+	})
+}
+
+func validateAzureRMStorageAccountTags(v interface{}, _ string) (ws []string, es []error) {
+	// the keys of tags on a Storage Account are limited to 128 characters
+	return validateTagsWithMaxKeyLength(v.(map[string]interface{}), 128)
 }
 
 func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) error {
@@ -394,7 +393,8 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	// Create
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	future, err := client.Create(ctx, resourceGroupName, storageAccountName, parameters)
 	if err != nil {
 		return fmt.Errorf("Error creating Azure Storage Account %q: %+v", storageAccountName, err)
@@ -424,13 +424,17 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 // and idempotent operation for CreateOrUpdate. In particular updating all of the parameters
 // available requires a call to Update per parameter...
 func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	client := meta.(*ArmClient).storageServiceClient
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
-	storageAccountName := id.Path["storageAccounts"]
+	storageAccountName, err := id.PopSegment("storageAccounts")
+	if err != nil {
+		return err
+	}
 	resourceGroupName := id.ResourceGroup
 
 	accountTier := d.Get("account_tier").(string)
@@ -591,7 +595,8 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	client := meta.(*ArmClient).storageServiceClient
 	endpointSuffix := meta.(*ArmClient).environment.StorageEndpointSuffix
 
@@ -599,7 +604,10 @@ func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) err
 	if err != nil {
 		return err
 	}
-	name := id.Path["storageAccounts"]
+	name, err := id.PopSegment("storageAccounts")
+	if err != nil {
+		return err
+	}
 	resGroup := id.ResourceGroup
 
 	resp, err := client.GetProperties(ctx, resGroup, name)
@@ -723,14 +731,18 @@ func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceArmStorageAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	client := meta.(*ArmClient).storageServiceClient
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
-	name := id.Path["storageAccounts"]
+	name, err := id.PopSegment("storageAccounts")
+	if err != nil {
+		return err
+	}
 	resourceGroup := id.ResourceGroup
 
 	read, err := client.GetProperties(ctx, resourceGroup, name)
@@ -1,29 +1,23 @@
 package azurerm
 
 import (
-	"fmt"
 	"log"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-10-01/storage"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/migration"
 )
 
-func resourceStorageAccountMigrateState(
-	v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
-	switch v {
-	case 0:
-		log.Println("[INFO] Found AzureRM Storage Account State v0; migrating to v1")
-		return migrateStorageAccountStateV0toV1(is)
-	case 1:
-		log.Println("[INFO] Found AzureRM Storage Account State v1; migrating to v2")
-		return migrateStorageAccountStateV1toV2(is)
-	default:
-		return is, fmt.Errorf("Unexpected schema version: %d", v)
+func resourceStorageAccountMigrateState(v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	upgraders := []migration.StateUpgrader{
+		migrateStorageAccountStateV0toV1,
+		migrateStorageAccountStateV1toV2,
 	}
+	return migration.MigrateStateUsingUpgraders("Storage Account", upgraders...)(v, is, meta)
 }
 
-func migrateStorageAccountStateV0toV1(is *terraform.InstanceState) (*terraform.InstanceState, error) {
+func migrateStorageAccountStateV0toV1(is *terraform.InstanceState, _ interface{}) (*terraform.InstanceState, error) {
 	if is.Empty() {
 		log.Println("[DEBUG] Empty InstanceState; nothing to migrate.")
 		return is, nil
@@ -41,7 +35,7 @@ func migrateStorageAccountStateV0toV1(is *terraform.InstanceState) (*terraform.I
 	return is, nil
 }
 
-func migrateStorageAccountStateV1toV2(is *terraform.InstanceState) (*terraform.InstanceState, error) {
+func migrateStorageAccountStateV1toV2(is *terraform.InstanceState, _ interface{}) (*terraform.InstanceState, error) {
 	if is.Empty() {
 		log.Println("[DEBUG] Empty InstanceState; nothing to migrate.")
 		return is, nil
@@ -36,6 +36,18 @@ func TestAzureRMStorageAccountMigrateState(t *testing.T) {
 				"account_replication_type": "GRS",
 			},
 		},
+		"v0_2_chained": {
+			StateVersion: 0,
+			ID:           "some_id",
+			InputAttributes: map[string]string{
+				"account_type": "Standard_ZRS",
+			},
+			ExpectedAttributes: map[string]string{
+				"account_tier":              "Standard",
+				"account_replication_type":  "ZRS",
+				"account_encryption_source": "Microsoft.Storage",
+			},
+		},
 		"v1_2_empty": {
 			StateVersion:    1,
 			ID:              "some_id",
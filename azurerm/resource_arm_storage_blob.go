@@ -16,6 +16,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 )
 
 func resourceArmStorageBlob() *schema.Resource {
@@ -104,7 +105,7 @@ func validateArmStorageBlobParallelism(v interface{}, k string) (ws []string, er
 	value := v.(int)
 
 	if value <= 0 {
-		errors = append(errors, fmt.Errorf("Blob Parallelism %q is invalid, must be greater than 0", value))
+		errors = append(errors, fmt.Errorf("Blob Parallelism %d is invalid, must be greater than 0", value))
 	}
 
 	return
@@ -114,7 +115,7 @@ func validateArmStorageBlobAttempts(v interface{}, k string) (ws []string, error
 	value := v.(int)
 
 	if value <= 0 {
-		errors = append(errors, fmt.Errorf("Blob Attempts %q is invalid, must be greater than 0", value))
+		errors = append(errors, fmt.Errorf("Blob Attempts %d is invalid, must be greater than 0", value))
 	}
 
 	return
@@ -124,7 +125,7 @@ func validateArmStorageBlobSize(v interface{}, k string) (ws []string, errors []
 	value := v.(int)
 
 	if value%512 != 0 {
-		errors = append(errors, fmt.Errorf("Blob Size %q is invalid, must be a multiple of 512", value))
+		errors = append(errors, fmt.Errorf("Blob Size %d is invalid, must be a multiple of 512", value))
 	}
 
 	return
@@ -145,7 +146,8 @@ func validateArmStorageBlobType(v interface{}, k string) (ws []string, errors []
 
 func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForCreate(armClient.StopContext, d)
+	defer cancel()
 	env := armClient.environment
 
 	resourceGroupName := d.Get("resource_group_name").(string)
@@ -545,7 +547,8 @@ func resourceArmStorageBlobBlockUploadWorker(ctx resourceArmStorageBlobBlockUplo
 
 func resourceArmStorageBlobUpdate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForUpdate(armClient.StopContext, d)
+	defer cancel()
 
 	id, err := parseStorageBlobID(d.Id(), armClient.environment)
 	if err != nil {
@@ -587,7 +590,8 @@ func resourceArmStorageBlobUpdate(d *schema.ResourceData, meta interface{}) erro
 
 func resourceArmStorageBlobRead(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForRead(armClient.StopContext, d)
+	defer cancel()
 
 	id, err := parseStorageBlobID(d.Id(), armClient.environment)
 	if err != nil {
@@ -656,7 +660,8 @@ func resourceArmStorageBlobRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmStorageBlobDelete(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForDelete(armClient.StopContext, d)
+	defer cancel()
 
 	id, err := parseStorageBlobID(d.Id(), armClient.environment)
 	if err != nil {
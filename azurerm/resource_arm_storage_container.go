@@ -12,6 +12,7 @@ import (
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 )
 
 func resourceArmStorageContainer() *schema.Resource {
@@ -89,7 +90,8 @@ func validateArmStorageContainerAccessType(v interface{}, k string) (ws []string
 
 func resourceArmStorageContainerCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(armClient.StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroupName := d.Get("resource_group_name").(string)
@@ -136,7 +138,8 @@ func resourceArmStorageContainerCreateUpdate(d *schema.ResourceData, meta interf
 // read the status of the storage container off Azure.
 func resourceArmStorageContainerRead(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForRead(armClient.StopContext, d)
+	defer cancel()
 
 	id, err := parseStorageContainerID(d.Id(), armClient.environment)
 	if err != nil {
@@ -214,7 +217,8 @@ func resourceArmStorageContainerRead(d *schema.ResourceData, meta interface{}) e
 // delete a storage container off Azure.
 func resourceArmStorageContainerDelete(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForDelete(armClient.StopContext, d)
+	defer cancel()
 
 	id, err := parseStorageContainerID(d.Id(), armClient.environment)
 	if err != nil {
@@ -5,16 +5,14 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/migration"
 )
 
 func resourceStorageContainerMigrateState(v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
-	switch v {
-	case 0:
-		log.Println("[INFO] Found AzureRM Storage Container State v0; migrating to v1")
-		return migrateStorageContainerStateV0toV1(is, meta)
-	default:
-		return is, fmt.Errorf("Unexpected schema version: %d", v)
+	upgraders := []migration.StateUpgrader{
+		migrateStorageContainerStateV0toV1,
 	}
+	return migration.MigrateStateUsingUpgraders("Storage Container", upgraders...)(v, is, meta)
 }
 
 func migrateStorageContainerStateV0toV1(is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
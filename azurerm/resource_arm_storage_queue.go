@@ -9,6 +9,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 )
 
 func resourceArmStorageQueue() *schema.Resource {
@@ -70,7 +71,8 @@ func validateArmStorageQueueName(v interface{}, k string) (ws []string, errors [
 
 func resourceArmStorageQueueCreate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForCreate(armClient.StopContext, d)
+	defer cancel()
 	environment := armClient.environment
 
 	name := d.Get("name").(string)
@@ -100,7 +102,8 @@ func resourceArmStorageQueueCreate(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmStorageQueueRead(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForRead(armClient.StopContext, d)
+	defer cancel()
 
 	id, err := parseStorageQueueID(d.Id())
 	if err != nil {
@@ -150,7 +153,8 @@ func resourceArmStorageQueueRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmStorageQueueDelete(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForDelete(armClient.StopContext, d)
+	defer cancel()
 
 	id, err := parseStorageQueueID(d.Id())
 	if err != nil {
@@ -5,17 +5,14 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/migration"
 )
 
-func resourceStorageQueueMigrateState(
-	v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
-	switch v {
-	case 0:
-		log.Println("[INFO] Found AzureRM Storage Queue State v0; migrating to v1")
-		return migrateStorageQueueStateV0toV1(is, meta)
-	default:
-		return is, fmt.Errorf("Unexpected schema version: %d", v)
+func resourceStorageQueueMigrateState(v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	upgraders := []migration.StateUpgrader{
+		migrateStorageQueueStateV0toV1,
 	}
+	return migration.MigrateStateUsingUpgraders("Storage Queue", upgraders...)(v, is, meta)
 }
 
 func migrateStorageQueueStateV0toV1(is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
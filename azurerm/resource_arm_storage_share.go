@@ -10,6 +10,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 )
 
 func resourceArmStorageShare() *schema.Resource {
@@ -52,7 +53,8 @@ func resourceArmStorageShare() *schema.Resource {
 }
 func resourceArmStorageShareCreate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForCreate(armClient.StopContext, d)
+	defer cancel()
 
 	resourceGroupName := d.Get("resource_group_name").(string)
 	storageAccountName := d.Get("storage_account_name").(string)
@@ -89,7 +91,8 @@ func resourceArmStorageShareCreate(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmStorageShareRead(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForRead(armClient.StopContext, d)
+	defer cancel()
 
 	id := strings.Split(d.Id(), "/")
 	if len(id) != 3 {
@@ -138,7 +141,8 @@ func resourceArmStorageShareRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmStorageShareUpdate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForUpdate(armClient.StopContext, d)
+	defer cancel()
 
 	id := strings.Split(d.Id(), "/")
 	if len(id) != 3 {
@@ -171,7 +175,8 @@ func resourceArmStorageShareUpdate(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmStorageShareDelete(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForDelete(armClient.StopContext, d)
+	defer cancel()
 
 	id := strings.Split(d.Id(), "/")
 	if len(id) != 3 {
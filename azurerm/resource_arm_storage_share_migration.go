@@ -5,20 +5,17 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/migration"
 )
 
-func resourceStorageShareMigrateState(
-	v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
-	switch v {
-	case 0:
-		log.Println("[INFO] Found AzureRM Storage Share State v0; migrating to v1")
-		return migrateStorageShareStateV0toV1(is)
-	default:
-		return is, fmt.Errorf("Unexpected schema version: %d", v)
+func resourceStorageShareMigrateState(v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	upgraders := []migration.StateUpgrader{
+		migrateStorageShareStateV0toV1,
 	}
+	return migration.MigrateStateUsingUpgraders("Storage Share", upgraders...)(v, is, meta)
 }
 
-func migrateStorageShareStateV0toV1(is *terraform.InstanceState) (*terraform.InstanceState, error) {
+func migrateStorageShareStateV0toV1(is *terraform.InstanceState, _ interface{}) (*terraform.InstanceState, error) {
 	if is.Empty() {
 		log.Println("[DEBUG] Empty InstanceState; nothing to migrate.")
 		return is, nil
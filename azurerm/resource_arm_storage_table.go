@@ -9,6 +9,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 )
 
 func resourceArmStorageTable() *schema.Resource {
@@ -57,7 +58,8 @@ func validateArmStorageTableName(v interface{}, k string) (ws []string, errors [
 
 func resourceArmStorageTableCreate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForCreate(armClient.StopContext, d)
+	defer cancel()
 	environment := armClient.environment
 
 	name := d.Get("name").(string)
@@ -90,7 +92,8 @@ func resourceArmStorageTableCreate(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmStorageTableRead(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForRead(armClient.StopContext, d)
+	defer cancel()
 
 	id, err := parseStorageTableID(d.Id())
 	if err != nil {
@@ -149,7 +152,8 @@ func resourceArmStorageTableRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmStorageTableDelete(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForDelete(armClient.StopContext, d)
+	defer cancel()
 
 	id, err := parseStorageTableID(d.Id())
 	if err != nil {
@@ -6,6 +6,8 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -13,13 +15,11 @@ var subnetResourceName = "azurerm_subnet"
 
 func resourceArmSubnet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmSubnetCreate,
-		Read:   resourceArmSubnetRead,
-		Update: resourceArmSubnetCreate,
-		Delete: resourceArmSubnetDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmSubnetCreate,
+		Read:     resourceArmSubnetRead,
+		Update:   resourceArmSubnetCreate,
+		Delete:   resourceArmSubnetDelete,
+		Importer: azure.ImporterValidatingResourceId("virtualNetworks", "subnets"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -72,7 +72,8 @@ func resourceArmSubnet() *schema.Resource {
 
 func resourceArmSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).subnetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Subnet creation.")
 
@@ -159,15 +160,22 @@ func resourceArmSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmSubnetRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).subnetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	vnetName := id.Path["virtualNetworks"]
-	name := id.Path["subnets"]
+	vnetName, err := id.PopSegment("virtualNetworks")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("subnets")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resGroup, vnetName, name, "")
 
@@ -212,15 +220,22 @@ func resourceArmSubnetRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).subnetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["subnets"]
-	vnetName := id.Path["virtualNetworks"]
+	name, err := id.PopSegment("subnets")
+	if err != nil {
+		return err
+	}
+	vnetName, err := id.PopSegment("virtualNetworks")
+	if err != nil {
+		return err
+	}
 
 	if v, ok := d.GetOk("network_security_group_id"); ok {
 		networkSecurityGroupId := v.(string)
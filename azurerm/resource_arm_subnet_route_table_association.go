@@ -7,17 +7,16 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmSubnetRouteTableAssociation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmSubnetRouteTableAssociationCreate,
-		Read:   resourceArmSubnetRouteTableAssociationRead,
-		Delete: resourceArmSubnetRouteTableAssociationDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmSubnetRouteTableAssociationCreate,
+		Read:     resourceArmSubnetRouteTableAssociationRead,
+		Delete:   resourceArmSubnetRouteTableAssociationDelete,
+		Importer: azure.ImporterValidatingResourceId("virtualNetworks", "subnets"),
 
 		Schema: map[string]*schema.Schema{
 			"subnet_id": {
@@ -39,7 +38,8 @@ func resourceArmSubnetRouteTableAssociation() *schema.Resource {
 
 func resourceArmSubnetRouteTableAssociationCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).subnetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Subnet <-> Route Table Association creation.")
 
@@ -103,15 +103,22 @@ func resourceArmSubnetRouteTableAssociationCreate(d *schema.ResourceData, meta i
 
 func resourceArmSubnetRouteTableAssociationRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).subnetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	virtualNetworkName := id.Path["virtualNetworks"]
-	subnetName := id.Path["subnets"]
+	virtualNetworkName, err := id.PopSegment("virtualNetworks")
+	if err != nil {
+		return err
+	}
+	subnetName, err := id.PopSegment("subnets")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
 
@@ -144,15 +151,22 @@ func resourceArmSubnetRouteTableAssociationRead(d *schema.ResourceData, meta int
 
 func resourceArmSubnetRouteTableAssociationDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).subnetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	virtualNetworkName := id.Path["virtualNetworks"]
-	subnetName := id.Path["subnets"]
+	virtualNetworkName, err := id.PopSegment("virtualNetworks")
+	if err != nil {
+		return err
+	}
+	subnetName, err := id.PopSegment("subnets")
+	if err != nil {
+		return err
+	}
 
 	// retrieve the subnet
 	read, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
@@ -13,15 +13,18 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmTemplateDeployment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmTemplateDeploymentCreate,
-		Read:   resourceArmTemplateDeploymentRead,
-		Update: resourceArmTemplateDeploymentCreate,
-		Delete: resourceArmTemplateDeploymentDelete,
+		Create:   resourceArmTemplateDeploymentCreate,
+		Read:     resourceArmTemplateDeploymentRead,
+		Update:   resourceArmTemplateDeploymentCreate,
+		Delete:   resourceArmTemplateDeploymentDelete,
+		Importer: azure.ImporterValidatingResourceId("deployments"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -73,7 +76,8 @@ func resourceArmTemplateDeployment() *schema.Resource {
 func resourceArmTemplateDeploymentCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
 	deployClient := client.deploymentsClient
-	ctx := client.StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(client.StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -147,16 +151,17 @@ func resourceArmTemplateDeploymentCreate(d *schema.ResourceData, meta interface{
 func resourceArmTemplateDeploymentRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
 	deployClient := client.deploymentsClient
-	ctx := client.StopContext
+	ctx, cancel := timeouts.ForRead(client.StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["deployments"]
-	if name == "" {
-		name = id.Path["Deployments"]
+	name, err := id.PopSegment("deployments")
+	if err != nil {
+		return err
 	}
 
 	resp, err := deployClient.Get(ctx, resourceGroup, name)
@@ -168,6 +173,12 @@ func resourceArmTemplateDeploymentRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error making Read request on Azure RM Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	if props := resp.Properties; props != nil {
+		d.Set("deployment_mode", string(props.Mode))
+	}
+
 	outputs := make(map[string]string, 0)
 	if outs := resp.Properties.Outputs; outs != nil {
 		outsVal := outs.(map[string]interface{})
@@ -213,16 +224,17 @@ func resourceArmTemplateDeploymentRead(d *schema.ResourceData, meta interface{})
 func resourceArmTemplateDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
 	deployClient := client.deploymentsClient
-	ctx := client.StopContext
+	ctx, cancel := timeouts.ForDelete(client.StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["deployments"]
-	if name == "" {
-		name = id.Path["Deployments"]
+	name, err := id.PopSegment("deployments")
+	if err != nil {
+		return err
 	}
 
 	_, err = deployClient.Delete(ctx, resourceGroup, name)
@@ -230,7 +242,7 @@ func resourceArmTemplateDeploymentDelete(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	return waitForTemplateDeploymentToBeDeleted(ctx, deployClient, resourceGroup, name)
+	return waitForTemplateDeploymentToBeDeleted(ctx, deployClient, resourceGroup, name, d.Timeout(schema.TimeoutDelete))
 }
 
 // TODO: move this out into the new `helpers` structure
@@ -265,14 +277,14 @@ func normalizeJson(jsonString interface{}) string {
 	return string(b[:])
 }
 
-func waitForTemplateDeploymentToBeDeleted(ctx context.Context, client resources.DeploymentsClient, resourceGroup, name string) error {
+func waitForTemplateDeploymentToBeDeleted(ctx context.Context, client resources.DeploymentsClient, resourceGroup, name string, timeout time.Duration) error {
 	// we can't use the Waiter here since the API returns a 200 once it's deleted which is considered a polling status code..
 	log.Printf("[DEBUG] Waiting for Template Deployment (%q in Resource Group %q) to be deleted", name, resourceGroup)
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"200"},
 		Target:  []string{"404"},
 		Refresh: templateDeploymentStateStatusCodeRefreshFunc(ctx, client, resourceGroup, name),
-		Timeout: 40 * time.Minute,
+		Timeout: timeout,
 	}
 	if _, err := stateConf.WaitForState(); err != nil {
 		return fmt.Errorf("Error waiting for Template Deployment (%q in Resource Group %q) to be deleted: %+v", name, resourceGroup, err)
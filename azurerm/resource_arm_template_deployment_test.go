@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
@@ -184,7 +185,7 @@ func testCheckAzureRMTemplateDeploymentDisappears(name string) resource.TestChec
 			return fmt.Errorf("Failed deleting Deployment %q (Resource Group %q): %+v", deploymentName, resourceGroup, err)
 		}
 
-		return waitForTemplateDeploymentToBeDeleted(ctx, client, resourceGroup, deploymentName)
+		return waitForTemplateDeploymentToBeDeleted(ctx, client, resourceGroup, deploymentName, 40*time.Minute)
 	}
 }
 
@@ -8,18 +8,18 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2017-05-01/trafficmanager"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmTrafficManagerEndpoint() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmTrafficManagerEndpointCreate,
-		Read:   resourceArmTrafficManagerEndpointRead,
-		Update: resourceArmTrafficManagerEndpointCreate,
-		Delete: resourceArmTrafficManagerEndpointDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmTrafficManagerEndpointCreate,
+		Read:     resourceArmTrafficManagerEndpointRead,
+		Update:   resourceArmTrafficManagerEndpointCreate,
+		Delete:   resourceArmTrafficManagerEndpointDelete,
+		Importer: azure.ImporterValidatingResourceId("trafficManagerProfiles"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -129,7 +129,8 @@ func resourceArmTrafficManagerEndpointCreate(d *schema.ResourceData, meta interf
 		EndpointProperties: getArmTrafficManagerEndpointProperties(d),
 	}
 
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	_, err := client.CreateOrUpdate(ctx, resourceGroup, profileName, endpointType, name, params)
 	if err != nil {
 		return err
@@ -165,10 +166,14 @@ func resourceArmTrafficManagerEndpointRead(d *schema.ResourceData, meta interfac
 			endpointType = k
 		}
 	}
-	profileName := id.Path["trafficManagerProfiles"]
+	profileName, err := id.PopSegment("trafficManagerProfiles")
+	if err != nil {
+		return err
+	}
 	name := id.Path[endpointType]
 
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	resp, err := client.Get(ctx, resGroup, profileName, endpointType, name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
@@ -207,11 +212,15 @@ func resourceArmTrafficManagerEndpointDelete(d *schema.ResourceData, meta interf
 	}
 	resGroup := id.ResourceGroup
 	endpointType := d.Get("type").(string)
-	profileName := id.Path["trafficManagerProfiles"]
+	profileName, err := id.PopSegment("trafficManagerProfiles")
+	if err != nil {
+		return err
+	}
 
 	// endpoint name is keyed by endpoint type in ARM ID
 	name := id.Path[endpointType]
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	resp, err := client.Delete(ctx, resGroup, profileName, endpointType, name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
@@ -10,18 +10,18 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmTrafficManagerProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmTrafficManagerProfileCreate,
-		Read:   resourceArmTrafficManagerProfileRead,
-		Update: resourceArmTrafficManagerProfileCreate,
-		Delete: resourceArmTrafficManagerProfileDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmTrafficManagerProfileCreate,
+		Read:     resourceArmTrafficManagerProfileRead,
+		Update:   resourceArmTrafficManagerProfileCreate,
+		Delete:   resourceArmTrafficManagerProfileDelete,
+		Importer: azure.ImporterValidatingResourceId("trafficManagerProfiles"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -132,7 +132,8 @@ func resourceArmTrafficManagerProfileCreate(d *schema.ResourceData, meta interfa
 		Tags:              expandTags(tags),
 	}
 
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	_, err := client.CreateOrUpdate(ctx, resGroup, name, profile)
 	if err != nil {
 		return err
@@ -153,14 +154,18 @@ func resourceArmTrafficManagerProfileCreate(d *schema.ResourceData, meta interfa
 
 func resourceArmTrafficManagerProfileRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).trafficManagerProfilesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["trafficManagerProfiles"]
+	name, err := id.PopSegment("trafficManagerProfiles")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resGroup, name)
 	if err != nil {
@@ -201,9 +206,13 @@ func resourceArmTrafficManagerProfileDelete(d *schema.ResourceData, meta interfa
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["trafficManagerProfiles"]
+	name, err := id.PopSegment("trafficManagerProfiles")
+	if err != nil {
+		return err
+	}
 
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	resp, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp.Response) {
@@ -7,18 +7,18 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/msi/mgmt/2015-08-31-preview/msi"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmUserAssignedIdentity() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmUserAssignedIdentityCreateUpdate,
-		Read:   resourceArmUserAssignedIdentityRead,
-		Update: resourceArmUserAssignedIdentityCreateUpdate,
-		Delete: resourceArmUserAssignedIdentityDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmUserAssignedIdentityCreateUpdate,
+		Read:     resourceArmUserAssignedIdentityRead,
+		Update:   resourceArmUserAssignedIdentityCreateUpdate,
+		Delete:   resourceArmUserAssignedIdentityDelete,
+		Importer: azure.ImporterValidatingResourceId("userAssignedIdentities"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -44,7 +44,8 @@ func resourceArmUserAssignedIdentity() *schema.Resource {
 
 func resourceArmUserAssignedIdentityCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).userAssignedIdentitiesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM user identity creation.")
 
@@ -79,7 +80,8 @@ func resourceArmUserAssignedIdentityCreateUpdate(d *schema.ResourceData, meta in
 
 func resourceArmUserAssignedIdentityRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).userAssignedIdentitiesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -87,7 +89,10 @@ func resourceArmUserAssignedIdentityRead(d *schema.ResourceData, meta interface{
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["userAssignedIdentities"]
+	name, err := id.PopSegment("userAssignedIdentities")
+	if err != nil {
+		return err
+	}
 	resp, err := client.Get(ctx, resGroup, name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
@@ -114,7 +119,8 @@ func resourceArmUserAssignedIdentityRead(d *schema.ResourceData, meta interface{
 
 func resourceArmUserAssignedIdentityDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).userAssignedIdentitiesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -122,7 +128,10 @@ func resourceArmUserAssignedIdentityDelete(d *schema.ResourceData, meta interfac
 	}
 
 	resGroup := id.ResourceGroup
-	name := id.Path["userAssignedIdentities"]
+	name, err := id.PopSegment("userAssignedIdentities")
+	if err != nil {
+		return err
+	}
 
 	_, err = client.Delete(ctx, resGroup, name)
 	if err != nil {
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 	"golang.org/x/net/context"
 )
@@ -21,13 +23,11 @@ var virtualMachineResourceName = "azurerm_virtual_machine"
 
 func resourceArmVirtualMachine() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmVirtualMachineCreate,
-		Read:   resourceArmVirtualMachineRead,
-		Update: resourceArmVirtualMachineCreate,
-		Delete: resourceArmVirtualMachineDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmVirtualMachineCreate,
+		Read:     resourceArmVirtualMachineRead,
+		Update:   resourceArmVirtualMachineCreate,
+		Delete:   resourceArmVirtualMachineDelete,
+		Importer: azure.ImporterValidatingResourceId("virtualMachines"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -90,12 +90,17 @@ func resourceArmVirtualMachine() *schema.Resource {
 							ValidateFunc: validation.StringInSlice([]string{
 								string(compute.ResourceIdentityTypeSystemAssigned),
 								string(compute.ResourceIdentityTypeUserAssigned),
+								string(compute.ResourceIdentityTypeSystemAssignedUserAssigned),
 							}, false),
 						},
 						"principal_id": {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"identity_ids": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -553,7 +558,8 @@ func resourceArmVirtualMachine() *schema.Resource {
 
 func resourceArmVirtualMachineCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Virtual Machine creation.")
 
@@ -695,14 +701,18 @@ func resourceArmVirtualMachineCreate(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) error {
 	vmClient := meta.(*ArmClient).vmClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["virtualMachines"]
+	name, err := id.PopSegment("virtualMachines")
+	if err != nil {
+		return err
+	}
 
 	resp, err := vmClient.Get(ctx, resGroup, name, "")
 	if err != nil {
@@ -818,14 +828,18 @@ func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["virtualMachines"]
+	name, err := id.PopSegment("virtualMachines")
+	if err != nil {
+		return err
+	}
 
 	azureRMLockByName(name, virtualMachineResourceName)
 	defer azureRMUnlockByName(name, virtualMachineResourceName)
@@ -840,9 +854,11 @@ func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	// delete OS Disk if opted in
-	if deleteOsDisk := d.Get("delete_os_disk_on_termination").(bool); deleteOsDisk {
-		log.Printf("[INFO] delete_os_disk_on_termination is enabled, deleting disk from %s", name)
+	vmFeatures := meta.(*ArmClient).features.virtualMachine
+
+	// delete OS Disk if opted in, either on this Virtual Machine or for all Virtual Machines via the `features` block
+	if deleteOsDisk := d.Get("delete_os_disk_on_termination").(bool) || vmFeatures.deleteOSDiskOnDeletion; deleteOsDisk {
+		log.Printf("[INFO] Deleting the OS Disk is enabled, deleting disk from %s", name)
 
 		osDisk, err := expandAzureRmVirtualMachineOsDisk(d)
 		if err != nil {
@@ -866,9 +882,9 @@ func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	// delete Data disks if opted in
-	if deleteDataDisks := d.Get("delete_data_disks_on_termination").(bool); deleteDataDisks {
-		log.Printf("[INFO] delete_data_disks_on_termination is enabled, deleting each data disk from %s", name)
+	// delete Data disks if opted in, otherwise they're detached and left intact
+	if deleteDataDisks := d.Get("delete_data_disks_on_termination").(bool) || vmFeatures.deleteDataDisksOnDeletion; deleteDataDisks {
+		log.Printf("[INFO] Deleting the Data Disks is enabled, deleting each data disk from %s", name)
 
 		disks, err := expandAzureRmVirtualMachineDataDisk(d)
 		if err != nil {
@@ -950,7 +966,10 @@ func resourceArmVirtualMachineDeleteManagedDisk(managedDiskID string, meta inter
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["disks"]
+	name, err := id.PopSegment("disks")
+	if err != nil {
+		return err
+	}
 
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
@@ -1005,6 +1024,9 @@ func flattenAzureRmVirtualMachineIdentity(identity *compute.VirtualMachineIdenti
 	if identity.PrincipalID != nil {
 		result["principal_id"] = *identity.PrincipalID
 	}
+	if identity.TenantID != nil {
+		result["tenant_id"] = *identity.TenantID
+	}
 
 	identityIds := make([]string, 0)
 	if identity.UserAssignedIdentities != nil {
@@ -1264,7 +1286,7 @@ func expandAzureRmVirtualMachineIdentity(d *schema.ResourceData) *compute.Virtua
 		Type: identityType,
 	}
 
-	if vmIdentity.Type == compute.ResourceIdentityTypeUserAssigned {
+	if vmIdentity.Type == compute.ResourceIdentityTypeUserAssigned || vmIdentity.Type == compute.ResourceIdentityTypeSystemAssignedUserAssigned {
 		vmIdentity.UserAssignedIdentities = identityIds
 	}
 
@@ -8,18 +8,17 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmVirtualMachineDataDiskAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmVirtualMachineDataDiskAttachmentCreateUpdate,
-		Read:   resourceArmVirtualMachineDataDiskAttachmentRead,
-		Update: resourceArmVirtualMachineDataDiskAttachmentCreateUpdate,
-		Delete: resourceArmVirtualMachineDataDiskAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmVirtualMachineDataDiskAttachmentCreateUpdate,
+		Read:     resourceArmVirtualMachineDataDiskAttachmentRead,
+		Update:   resourceArmVirtualMachineDataDiskAttachmentCreateUpdate,
+		Delete:   resourceArmVirtualMachineDataDiskAttachmentDelete,
+		Importer: azure.ImporterValidatingResourceId("virtualMachines", "dataDisks"),
 
 		Schema: map[string]*schema.Schema{
 			"managed_disk_id": {
@@ -78,7 +77,8 @@ func resourceArmVirtualMachineDataDiskAttachment() *schema.Resource {
 
 func resourceArmVirtualMachineDataDiskAttachmentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	virtualMachineId := d.Get("virtual_machine_id").(string)
 	parsedVirtualMachineId, err := parseAzureResourceID(virtualMachineId)
@@ -174,7 +174,8 @@ func resourceArmVirtualMachineDataDiskAttachmentCreateUpdate(d *schema.ResourceD
 
 func resourceArmVirtualMachineDataDiskAttachmentRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -182,8 +183,14 @@ func resourceArmVirtualMachineDataDiskAttachmentRead(d *schema.ResourceData, met
 	}
 
 	resourceGroup := id.ResourceGroup
-	virtualMachineName := id.Path["virtualMachines"]
-	name := id.Path["dataDisks"]
+	virtualMachineName, err := id.PopSegment("virtualMachines")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("dataDisks")
+	if err != nil {
+		return err
+	}
 
 	virtualMachine, err := client.Get(ctx, resourceGroup, virtualMachineName, "")
 	if err != nil {
@@ -231,7 +238,8 @@ func resourceArmVirtualMachineDataDiskAttachmentRead(d *schema.ResourceData, met
 
 func resourceArmVirtualMachineDataDiskAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -239,8 +247,14 @@ func resourceArmVirtualMachineDataDiskAttachmentDelete(d *schema.ResourceData, m
 	}
 
 	resourceGroup := id.ResourceGroup
-	virtualMachineName := id.Path["virtualMachines"]
-	name := id.Path["dataDisks"]
+	virtualMachineName, err := id.PopSegment("virtualMachines")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("dataDisks")
+	if err != nil {
+		return err
+	}
 
 	azureRMLockByName(virtualMachineName, virtualMachineResourceName)
 	defer azureRMUnlockByName(virtualMachineName, virtualMachineResourceName)
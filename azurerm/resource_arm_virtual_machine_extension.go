@@ -7,18 +7,18 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmVirtualMachineExtensions() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmVirtualMachineExtensionsCreate,
-		Read:   resourceArmVirtualMachineExtensionsRead,
-		Update: resourceArmVirtualMachineExtensionsCreate,
-		Delete: resourceArmVirtualMachineExtensionsDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmVirtualMachineExtensionsCreate,
+		Read:     resourceArmVirtualMachineExtensionsRead,
+		Update:   resourceArmVirtualMachineExtensionsCreate,
+		Delete:   resourceArmVirtualMachineExtensionsDelete,
+		Importer: azure.ImporterValidatingResourceId("virtualMachines", "extensions"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -80,7 +80,8 @@ func resourceArmVirtualMachineExtensions() *schema.Resource {
 
 func resourceArmVirtualMachineExtensionsCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmExtensionClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	location := azureRMNormalizeLocation(d.Get("location").(string))
@@ -145,15 +146,22 @@ func resourceArmVirtualMachineExtensionsCreate(d *schema.ResourceData, meta inte
 
 func resourceArmVirtualMachineExtensionsRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmExtensionClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	vmName := id.Path["virtualMachines"]
-	name := id.Path["extensions"]
+	vmName, err := id.PopSegment("virtualMachines")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("extensions")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resGroup, vmName, name, "")
 
@@ -195,15 +203,22 @@ func resourceArmVirtualMachineExtensionsRead(d *schema.ResourceData, meta interf
 
 func resourceArmVirtualMachineExtensionsDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmExtensionClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["extensions"]
-	vmName := id.Path["virtualMachines"]
+	name, err := id.PopSegment("extensions")
+	if err != nil {
+		return err
+	}
+	vmName, err := id.PopSegment("virtualMachines")
+	if err != nil {
+		return err
+	}
 
 	future, err := client.Delete(ctx, resGroup, vmName, name)
 	if err != nil {
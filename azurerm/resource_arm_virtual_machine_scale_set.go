@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -23,9 +25,7 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 		Update: resourceArmVirtualMachineScaleSetCreate,
 		Delete: resourceArmVirtualMachineScaleSetDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: azure.ImporterValidatingResourceId("virtualMachineScaleSets"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -55,6 +55,7 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 							ValidateFunc: validation.StringInSlice([]string{
 								string(compute.ResourceIdentityTypeSystemAssigned),
 								string(compute.ResourceIdentityTypeUserAssigned),
+								string(compute.ResourceIdentityTypeSystemAssignedUserAssigned),
 							}, false),
 						},
 						"identity_ids": {
@@ -68,6 +69,10 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -121,6 +126,56 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 				}, true),
 			},
 
+			"health_probe_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"automatic_os_upgrade": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"rolling_upgrade_policy": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: azureRmVirtualMachineScaleSetSuppressRollingUpgradePolicyDiff,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_batch_instance_percent": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      20,
+							ValidateFunc: validation.IntBetween(5, 100),
+						},
+
+						"max_unhealthy_instance_percent": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      20,
+							ValidateFunc: validation.IntBetween(5, 100),
+						},
+
+						"max_unhealthy_upgraded_instance_percent": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      20,
+							ValidateFunc: validation.IntBetween(5, 100),
+						},
+
+						"pause_time_between_batches": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "PT0S",
+							ValidateFunc: validateIso8601Duration(),
+						},
+					},
+				},
+			},
+
 			"overprovision": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -144,6 +199,17 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 				}, true),
 			},
 
+			"eviction_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(compute.Deallocate),
+					string(compute.Delete),
+				}, false),
+			},
+
 			"os_profile": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -664,7 +730,8 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 
 func resourceArmVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmScaleSetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Virtual Machine Scale Set creation.")
 
@@ -712,26 +779,40 @@ func resourceArmVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interf
 		return err
 	}
 
-	updatePolicy := d.Get("upgrade_policy_mode").(string)
 	overprovision := d.Get("overprovision").(bool)
 	singlePlacementGroup := d.Get("single_placement_group").(bool)
 	priority := d.Get("priority").(string)
+	evictionPolicy := d.Get("eviction_policy").(string)
+
+	if evictionPolicy != "" && !strings.EqualFold(priority, string(compute.Low)) {
+		return fmt.Errorf("Error creating Virtual Machine Scale Set %q (Resource Group %q): `eviction_policy` can only be specified when `priority` is set to `Low`", name, resGroup)
+	}
+
+	upgradePolicy, err := expandAzureRmVirtualMachineScaleSetUpgradePolicy(d)
+	if err != nil {
+		return fmt.Errorf("Error expanding `rolling_upgrade_policy` for Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resGroup, err)
+	}
 
 	scaleSetProps := compute.VirtualMachineScaleSetProperties{
-		UpgradePolicy: &compute.UpgradePolicy{
-			Mode: compute.UpgradeMode(updatePolicy),
-		},
+		UpgradePolicy: upgradePolicy,
 		VirtualMachineProfile: &compute.VirtualMachineScaleSetVMProfile{
-			NetworkProfile:   expandAzureRmVirtualMachineScaleSetNetworkProfile(d),
-			StorageProfile:   &storageProfile,
-			OsProfile:        osProfile,
-			ExtensionProfile: extensions,
-			Priority:         compute.VirtualMachinePriorityTypes(priority),
+			NetworkProfile: expandAzureRmVirtualMachineScaleSetNetworkProfile(d),
+			StorageProfile: &storageProfile,
+			OsProfile:      osProfile,
+			Priority:       compute.VirtualMachinePriorityTypes(priority),
+			EvictionPolicy: compute.VirtualMachineEvictionPolicyTypes(evictionPolicy),
 		},
 		Overprovision:        &overprovision,
 		SinglePlacementGroup: &singlePlacementGroup,
 	}
 
+	// the Extensions read back from Azure don't include the `protected_settings` - as such the Extension Profile
+	// is only sent when the inline `extension` blocks change, so that Extensions managed via the
+	// `azurerm_virtual_machine_scale_set_extension` resource aren't resent without them
+	if d.IsNewResource() || d.HasChange("extension") {
+		scaleSetProps.VirtualMachineProfile.ExtensionProfile = extensions
+	}
+
 	if _, ok := d.GetOk("boot_diagnostics"); ok {
 		diagnosticProfile := expandAzureRMVirtualMachineScaleSetsDiagnosticProfile(d)
 		scaleSetProps.VirtualMachineProfile.DiagnosticsProfile = &diagnosticProfile
@@ -787,14 +868,18 @@ func resourceArmVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interf
 
 func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmScaleSetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["virtualMachineScaleSets"]
+	name, err := id.PopSegment("virtualMachineScaleSets")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resGroup, name)
 	if err != nil {
@@ -826,6 +911,16 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 
 		if upgradePolicy := properties.UpgradePolicy; upgradePolicy != nil {
 			d.Set("upgrade_policy_mode", upgradePolicy.Mode)
+			d.Set("automatic_os_upgrade", upgradePolicy.AutomaticOSUpgrade)
+
+			// a Rolling Upgrade Policy can be returned for the other modes, where it has no effect
+			rollingUpgradePolicy := make([]interface{}, 0)
+			if upgradePolicy.Mode == compute.Rolling {
+				rollingUpgradePolicy = flattenAzureRmVirtualMachineScaleSetRollingUpgradePolicy(upgradePolicy.RollingUpgradePolicy)
+			}
+			if err := d.Set("rolling_upgrade_policy", rollingUpgradePolicy); err != nil {
+				return fmt.Errorf("[DEBUG] Error setting `rolling_upgrade_policy`: %#v", err)
+			}
 		}
 		d.Set("overprovision", properties.Overprovision)
 		d.Set("single_placement_group", properties.SinglePlacementGroup)
@@ -833,6 +928,7 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 		if profile := properties.VirtualMachineProfile; profile != nil {
 			d.Set("license_type", profile.LicenseType)
 			d.Set("priority", profile.Priority)
+			d.Set("eviction_policy", profile.EvictionPolicy)
 
 			osProfile := flattenAzureRMVirtualMachineScaleSetOsProfile(d, profile.OsProfile)
 			if err := d.Set("os_profile", osProfile); err != nil {
@@ -874,6 +970,12 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 			}
 
 			if networkProfile := profile.NetworkProfile; networkProfile != nil {
+				healthProbeId := ""
+				if probe := networkProfile.HealthProbe; probe != nil && probe.ID != nil {
+					healthProbeId = *probe.ID
+				}
+				d.Set("health_probe_id", healthProbeId)
+
 				flattenedNetworkProfile := flattenAzureRmVirtualMachineScaleSetNetworkProfile(networkProfile)
 				if err := d.Set("network_profile", flattenedNetworkProfile); err != nil {
 					return fmt.Errorf("[DEBUG] Error setting `network_profile`: %#v", err)
@@ -929,14 +1031,18 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 
 func resourceArmVirtualMachineScaleSetDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmScaleSetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["virtualMachineScaleSets"]
+	name, err := id.PopSegment("virtualMachineScaleSets")
+	if err != nil {
+		return err
+	}
 
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
@@ -960,6 +1066,9 @@ func flattenAzureRmVirtualMachineScaleSetIdentity(identity *compute.VirtualMachi
 	if identity.PrincipalID != nil {
 		result["principal_id"] = *identity.PrincipalID
 	}
+	if identity.TenantID != nil {
+		result["tenant_id"] = *identity.TenantID
+	}
 
 	identityIds := make([]string, 0)
 	if identity.UserAssignedIdentities != nil {
@@ -1088,6 +1197,28 @@ func flattenAzureRmVirtualMachineScaleSetBootDiagnostics(bootDiagnostic *compute
 	return []interface{}{b}
 }
 
+func flattenAzureRmVirtualMachineScaleSetRollingUpgradePolicy(policy *compute.RollingUpgradePolicy) []interface{} {
+	if policy == nil {
+		return []interface{}{}
+	}
+
+	result := make(map[string]interface{})
+	if v := policy.MaxBatchInstancePercent; v != nil {
+		result["max_batch_instance_percent"] = *v
+	}
+	if v := policy.MaxUnhealthyInstancePercent; v != nil {
+		result["max_unhealthy_instance_percent"] = *v
+	}
+	if v := policy.MaxUnhealthyUpgradedInstancePercent; v != nil {
+		result["max_unhealthy_upgraded_instance_percent"] = *v
+	}
+	if v := policy.PauseTimeBetweenBatches; v != nil {
+		result["pause_time_between_batches"] = *v
+	}
+
+	return []interface{}{result}
+}
+
 func flattenAzureRmVirtualMachineScaleSetNetworkProfile(profile *compute.VirtualMachineScaleSetNetworkProfile) []map[string]interface{} {
 	networkConfigurations := profile.NetworkInterfaceConfigurations
 	result := make([]map[string]interface{}, 0, len(*networkConfigurations))
@@ -1579,9 +1710,59 @@ func expandAzureRmVirtualMachineScaleSetNetworkProfile(d *schema.ResourceData) *
 		networkProfileConfig = append(networkProfileConfig, nProfile)
 	}
 
-	return &compute.VirtualMachineScaleSetNetworkProfile{
+	networkProfile := compute.VirtualMachineScaleSetNetworkProfile{
 		NetworkInterfaceConfigurations: &networkProfileConfig,
 	}
+
+	if v := d.Get("health_probe_id").(string); v != "" {
+		networkProfile.HealthProbe = &compute.APIEntityReference{
+			ID: utils.String(v),
+		}
+	}
+
+	return &networkProfile
+}
+
+func expandAzureRmVirtualMachineScaleSetUpgradePolicy(d *schema.ResourceData) (*compute.UpgradePolicy, error) {
+	mode := compute.UpgradeMode(d.Get("upgrade_policy_mode").(string))
+
+	upgradePolicy := compute.UpgradePolicy{
+		Mode:               mode,
+		AutomaticOSUpgrade: utils.Bool(d.Get("automatic_os_upgrade").(bool)),
+	}
+
+	policies := d.Get("rolling_upgrade_policy").([]interface{})
+	if len(policies) == 0 || policies[0] == nil {
+		return &upgradePolicy, nil
+	}
+
+	if !strings.EqualFold(string(mode), string(compute.Rolling)) {
+		return nil, fmt.Errorf("`rolling_upgrade_policy` can only be specified when `upgrade_policy_mode` is set to %q", string(compute.Rolling))
+	}
+
+	policy := policies[0].(map[string]interface{})
+	upgradePolicy.RollingUpgradePolicy = &compute.RollingUpgradePolicy{
+		MaxBatchInstancePercent:             utils.Int32(int32(policy["max_batch_instance_percent"].(int))),
+		MaxUnhealthyInstancePercent:         utils.Int32(int32(policy["max_unhealthy_instance_percent"].(int))),
+		MaxUnhealthyUpgradedInstancePercent: utils.Int32(int32(policy["max_unhealthy_upgraded_instance_percent"].(int))),
+		PauseTimeBetweenBatches:             utils.String(policy["pause_time_between_batches"].(string)),
+	}
+
+	return &upgradePolicy, nil
+}
+
+// azureRmVirtualMachineScaleSetSuppressRollingUpgradePolicyDiff suppresses the removal of the `rolling_upgrade_policy`
+// block when the `upgrade_policy_mode` is Rolling, since the API always returns a policy (with the defaults) in that mode
+func azureRmVirtualMachineScaleSetSuppressRollingUpgradePolicyDiff(k, old, new string, d *schema.ResourceData) bool {
+	if !strings.EqualFold(d.Get("upgrade_policy_mode").(string), string(compute.Rolling)) {
+		return false
+	}
+
+	if k == "rolling_upgrade_policy.#" {
+		return new == "0"
+	}
+
+	return new == ""
 }
 
 func expandAzureRMVirtualMachineScaleSetsOsProfile(d *schema.ResourceData) (*compute.VirtualMachineScaleSetOSProfile, error) {
@@ -1669,7 +1850,7 @@ func expandAzureRmVirtualMachineScaleSetIdentity(d *schema.ResourceData) *comput
 		Type: identityType,
 	}
 
-	if vmssIdentity.Type == compute.ResourceIdentityTypeUserAssigned {
+	if vmssIdentity.Type == compute.ResourceIdentityTypeUserAssigned || vmssIdentity.Type == compute.ResourceIdentityTypeSystemAssignedUserAssigned {
 		vmssIdentity.UserAssignedIdentities = identityIds
 	}
 
@@ -0,0 +1,248 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmVirtualMachineScaleSetExtension() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceArmVirtualMachineScaleSetExtensionCreateUpdate,
+		Read:     resourceArmVirtualMachineScaleSetExtensionRead,
+		Update:   resourceArmVirtualMachineScaleSetExtensionCreateUpdate,
+		Delete:   resourceArmVirtualMachineScaleSetExtensionDelete,
+		Importer: azure.ImporterValidatingResourceId("virtualMachineScaleSets", "extensions"),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"virtual_machine_scale_set_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"publisher": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"type_handler_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"auto_upgrade_minor_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"force_update_tag": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"settings": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+
+			// due to the sensitive nature, these are not returned by the API
+			"protected_settings": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+		},
+	}
+}
+
+func resourceArmVirtualMachineScaleSetExtensionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmScaleSetExtensionsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	scaleSetName := d.Get("virtual_machine_scale_set_name").(string)
+
+	props := compute.VirtualMachineScaleSetExtensionProperties{
+		Publisher:               utils.String(d.Get("publisher").(string)),
+		Type:                    utils.String(d.Get("type").(string)),
+		TypeHandlerVersion:      utils.String(d.Get("type_handler_version").(string)),
+		AutoUpgradeMinorVersion: utils.Bool(d.Get("auto_upgrade_minor_version").(bool)),
+	}
+
+	if v := d.Get("force_update_tag").(string); v != "" {
+		props.ForceUpdateTag = utils.String(v)
+	}
+
+	if settingsString := d.Get("settings").(string); settingsString != "" {
+		settings, err := structure.ExpandJsonFromString(settingsString)
+		if err != nil {
+			return fmt.Errorf("Error parsing `settings`: %+v", err)
+		}
+		props.Settings = settings
+	}
+
+	if protectedSettingsString := d.Get("protected_settings").(string); protectedSettingsString != "" {
+		protectedSettings, err := structure.ExpandJsonFromString(protectedSettingsString)
+		if err != nil {
+			return fmt.Errorf("Error parsing `protected_settings`: %+v", err)
+		}
+		props.ProtectedSettings = protectedSettings
+	}
+
+	extension := compute.VirtualMachineScaleSetExtension{
+		Name: utils.String(name),
+		VirtualMachineScaleSetExtensionProperties: &props,
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, scaleSetName, name, extension)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", name, scaleSetName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", name, scaleSetName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, scaleSetName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", name, scaleSetName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Extension %q (Virtual Machine Scale Set %q / Resource Group %q)", name, scaleSetName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmVirtualMachineScaleSetExtensionRead(d, meta)
+}
+
+func resourceArmVirtualMachineScaleSetExtensionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmScaleSetExtensionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	scaleSetName, err := id.PopSegment("virtualMachineScaleSets")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("extensions")
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, scaleSetName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Extension %q (Virtual Machine Scale Set %q / Resource Group %q) was not found - removing from state", name, scaleSetName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", name, scaleSetName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("virtual_machine_scale_set_name", scaleSetName)
+
+	if props := resp.VirtualMachineScaleSetExtensionProperties; props != nil {
+		d.Set("publisher", props.Publisher)
+		d.Set("type", props.Type)
+		d.Set("type_handler_version", props.TypeHandlerVersion)
+		d.Set("auto_upgrade_minor_version", props.AutoUpgradeMinorVersion)
+		d.Set("force_update_tag", props.ForceUpdateTag)
+
+		settings := ""
+		if props.Settings != nil {
+			settingsVal, ok := props.Settings.(map[string]interface{})
+			if ok {
+				settingsJson, err := structure.FlattenJsonToString(settingsVal)
+				if err != nil {
+					return fmt.Errorf("Error flattening `settings`: %+v", err)
+				}
+				settings = settingsJson
+			}
+		}
+		d.Set("settings", settings)
+	}
+
+	return nil
+}
+
+func resourceArmVirtualMachineScaleSetExtensionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmScaleSetExtensionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	scaleSetName, err := id.PopSegment("virtualMachineScaleSets")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("extensions")
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, resourceGroup, scaleSetName, name)
+	if err != nil {
+		// deleted outside of Terraform
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", name, scaleSetName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", name, scaleSetName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,282 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVirtualMachineScaleSetExtension_basic(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_scale_set_extension.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineScaleSetExtensionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineScaleSetExtension_basic(ri, location, "hostname"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExtensionExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "settings", regexp.MustCompile("hostname")),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualMachineScaleSetExtension_basic(ri, location, "whoami"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExtensionExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "settings", regexp.MustCompile("whoami")),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineScaleSetExtension_protectedSettings(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_scale_set_extension.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineScaleSetExtensionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineScaleSetExtension_protectedSettings(ri, testLocation(), 1),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExtensionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "force_update_tag", "first"),
+				),
+			},
+			{
+				// updating the Scale Set mustn't resend the Extension without its `protected_settings`,
+				// which the new instance would otherwise fail to provision
+				Config: testAccAzureRMVirtualMachineScaleSetExtension_protectedSettings(ri, testLocation(), 2),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExtensionExists(resourceName),
+					testCheckAzureRMVirtualMachineScaleSetExtensionProvisioned(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "force_update_tag", "first"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"protected_settings"},
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVirtualMachineScaleSetExtensionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		scaleSetName := rs.Primary.Attributes["virtual_machine_scale_set_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).vmScaleSetExtensionsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, scaleSetName, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Extension %q (Virtual Machine Scale Set %q / Resource Group %q) does not exist", name, scaleSetName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on vmScaleSetExtensionsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVirtualMachineScaleSetExtensionProvisioned(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		scaleSetName := rs.Primary.Attributes["virtual_machine_scale_set_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).vmScaleSetExtensionsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, scaleSetName, name, "")
+		if err != nil {
+			return fmt.Errorf("Bad: Get on vmScaleSetExtensionsClient: %+v", err)
+		}
+
+		props := resp.VirtualMachineScaleSetExtensionProperties
+		if props == nil || props.ProvisioningState == nil || *props.ProvisioningState != "Succeeded" {
+			return fmt.Errorf("Bad: Extension %q (Virtual Machine Scale Set %q / Resource Group %q) wasn't provisioned successfully", name, scaleSetName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVirtualMachineScaleSetExtensionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).vmScaleSetExtensionsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_virtual_machine_scale_set_extension" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		scaleSetName := rs.Primary.Attributes["virtual_machine_scale_set_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, scaleSetName, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Extension %q (Virtual Machine Scale Set %q / Resource Group %q) still exists", name, scaleSetName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMVirtualMachineScaleSetExtension_basic(rInt int, location string, command string) string {
+	template := testAccAzureRMVirtualMachineScaleSetExtension_template(rInt, location, 1)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_scale_set_extension" "test" {
+  name                           = "acctestExt-%d"
+  resource_group_name            = "${azurerm_resource_group.test.name}"
+  virtual_machine_scale_set_name = "${azurerm_virtual_machine_scale_set.test.name}"
+  publisher                      = "Microsoft.Azure.Extensions"
+  type                           = "CustomScript"
+  type_handler_version           = "2.0"
+
+  settings = <<SETTINGS
+	{
+		"commandToExecute": "%s"
+	}
+SETTINGS
+}
+`, template, rInt, command)
+}
+
+func testAccAzureRMVirtualMachineScaleSetExtension_protectedSettings(rInt int, location string, capacity int) string {
+	template := testAccAzureRMVirtualMachineScaleSetExtension_template(rInt, location, capacity)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_scale_set_extension" "test" {
+  name                           = "acctestExt-%d"
+  resource_group_name            = "${azurerm_resource_group.test.name}"
+  virtual_machine_scale_set_name = "${azurerm_virtual_machine_scale_set.test.name}"
+  publisher                      = "Microsoft.Azure.Extensions"
+  type                           = "CustomScript"
+  type_handler_version           = "2.0"
+  force_update_tag               = "first"
+
+  protected_settings = <<SETTINGS
+	{
+		"commandToExecute": "hostname"
+	}
+SETTINGS
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVirtualMachineScaleSetExtension_template(rInt int, location string, capacity int) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctvn-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctsub-%[1]d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_virtual_machine_scale_set" "test" {
+  name                = "acctvmss-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  upgrade_policy_mode = "Manual"
+
+  sku {
+    name     = "Standard_D1_v2"
+    tier     = "Standard"
+    capacity = %[3]d
+  }
+
+  os_profile {
+    computer_name_prefix = "testvm-%[1]d"
+    admin_username       = "myadmin"
+    admin_password       = "Passwword1234"
+  }
+
+  network_profile {
+    name    = "TestNetworkProfile-%[1]d"
+    primary = true
+
+    ip_configuration {
+      name      = "TestIPConfiguration"
+      primary   = true
+      subnet_id = "${azurerm_subnet.test.id}"
+    }
+  }
+
+  storage_profile_os_disk {
+    name              = ""
+    caching           = "ReadWrite"
+    create_option     = "FromImage"
+    managed_disk_type = "Standard_LRS"
+  }
+
+  storage_profile_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  # Extensions are managed using the azurerm_virtual_machine_scale_set_extension resource
+  lifecycle {
+    ignore_changes = ["extension"]
+  }
+}
+`, rInt, location, capacity)
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -17,13 +19,11 @@ var virtualNetworkResourceName = "azurerm_virtual_network"
 
 func resourceArmVirtualNetwork() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmVirtualNetworkCreate,
-		Read:   resourceArmVirtualNetworkRead,
-		Update: resourceArmVirtualNetworkCreate,
-		Delete: resourceArmVirtualNetworkDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmVirtualNetworkCreate,
+		Read:     resourceArmVirtualNetworkRead,
+		Update:   resourceArmVirtualNetworkCreate,
+		Delete:   resourceArmVirtualNetworkDelete,
+		Importer: azure.ImporterValidatingResourceId("virtualNetworks"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -52,6 +52,26 @@ func resourceArmVirtualNetwork() *schema.Resource {
 				},
 			},
 
+			"ddos_protection_plan": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"enable": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+
 			"subnet": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -86,7 +106,8 @@ func resourceArmVirtualNetwork() *schema.Resource {
 
 func resourceArmVirtualNetworkCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vnetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM virtual network creation.")
 
@@ -148,14 +169,18 @@ func resourceArmVirtualNetworkCreate(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmVirtualNetworkRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vnetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["virtualNetworks"]
+	name, err := id.PopSegment("virtualNetworks")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resGroup, name, "")
 	if err != nil {
@@ -187,6 +212,9 @@ func resourceArmVirtualNetworkRead(d *schema.ResourceData, meta interface{}) err
 			return fmt.Errorf("Error setting `dns_servers`: %+v", err)
 		}
 
+		if err := d.Set("ddos_protection_plan", flattenVirtualNetworkDDoSProtectionPlan(props)); err != nil {
+			return fmt.Errorf("Error setting `ddos_protection_plan`: %+v", err)
+		}
 	}
 
 	flattenAndSetTags(d, resp.Tags)
@@ -196,14 +224,18 @@ func resourceArmVirtualNetworkRead(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmVirtualNetworkDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vnetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["virtualNetworks"]
+	name, err := id.PopSegment("virtualNetworks")
+	if err != nil {
+		return err
+	}
 
 	nsgNames, err := expandAzureRmVirtualNetworkVirtualNetworkSecurityGroupNames(d)
 	if err != nil {
@@ -289,9 +321,39 @@ func expandVirtualNetworkProperties(ctx context.Context, d *schema.ResourceData,
 		},
 		Subnets: &subnets,
 	}
+
+	if v, ok := d.GetOk("ddos_protection_plan"); ok {
+		plans := v.([]interface{})
+		plan := plans[0].(map[string]interface{})
+
+		properties.DdosProtectionPlan = &network.SubResource{
+			ID: utils.String(plan["id"].(string)),
+		}
+		properties.EnableDdosProtection = utils.Bool(plan["enable"].(bool))
+	}
+
 	// finally; return the struct:
 	return properties, nil
 }
+
+func flattenVirtualNetworkDDoSProtectionPlan(input *network.VirtualNetworkPropertiesFormat) []interface{} {
+	if input == nil || input.DdosProtectionPlan == nil || input.DdosProtectionPlan.ID == nil {
+		return []interface{}{}
+	}
+
+	enable := false
+	if input.EnableDdosProtection != nil {
+		enable = *input.EnableDdosProtection
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"id":     *input.DdosProtectionPlan.ID,
+			"enable": enable,
+		},
+	}
+}
+
 func flattenVirtualNetworkSubnets(input *[]network.Subnet) *schema.Set {
 	results := &schema.Set{
 		F: resourceAzureSubnetHash,
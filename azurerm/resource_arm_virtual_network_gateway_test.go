@@ -166,7 +166,7 @@ func TestAccAzureRMVirtualNetworkGateway_vpnGw3(t *testing.T) {
 func TestAccAzureRMVirtualNetworkGateway_vpnClientConfig(t *testing.T) {
 	ri := acctest.RandInt()
 	resourceName := "azurerm_virtual_network_gateway.test"
-	config := testAccAzureRMVirtualNetworkGateway_vpnClientConfig(ri, testLocation())
+	location := testLocation()
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -174,13 +174,21 @@ func TestAccAzureRMVirtualNetworkGateway_vpnClientConfig(t *testing.T) {
 		CheckDestroy: testCheckAzureRMVirtualNetworkGatewayDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: config,
+				Config: testAccAzureRMVirtualNetworkGateway_vpnClientConfig(ri, location, "10.2.0.0/24"),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMVirtualNetworkGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "vpn_client_configuration.0.address_space.0", "10.2.0.0/24"),
 					resource.TestCheckResourceAttr(resourceName, "vpn_client_configuration.0.radius_server_address", "1.2.3.4"),
 					resource.TestCheckResourceAttr(resourceName, "vpn_client_configuration.0.vpn_client_protocols.#", "2"),
 				),
 			},
+			{
+				Config: testAccAzureRMVirtualNetworkGateway_vpnClientConfig(ri, location, "10.3.0.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "vpn_client_configuration.0.address_space.0", "10.3.0.0/24"),
+				),
+			},
 		},
 	})
 }
@@ -447,7 +455,7 @@ resource "azurerm_virtual_network_gateway" "test" {
 
 }
 
-func testAccAzureRMVirtualNetworkGateway_vpnClientConfig(rInt int, location string) string {
+func testAccAzureRMVirtualNetworkGateway_vpnClientConfig(rInt int, location string, addressSpace string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
   name = "acctestRG-%d"
@@ -492,14 +500,14 @@ resource "azurerm_virtual_network_gateway" "test" {
   }
 
   vpn_client_configuration {
-	address_space = ["10.2.0.0/24"]
+	address_space = ["%s"]
 	vpn_client_protocols = ["SSTP", "IkeV2"]
 
 	radius_server_address = "1.2.3.4"
     radius_server_secret = "1234"
   }
 }
-`, rInt, location, rInt, rInt, rInt)
+`, rInt, location, rInt, rInt, rInt, addressSpace)
 }
 
 func testAccAzureRMVirtualNetworkGateway_sku(rInt int, location string, sku string) string {
@@ -7,6 +7,8 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -16,13 +18,11 @@ var peerMutex = &sync.Mutex{}
 
 func resourceArmVirtualNetworkPeering() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmVirtualNetworkPeeringCreate,
-		Read:   resourceArmVirtualNetworkPeeringRead,
-		Update: resourceArmVirtualNetworkPeeringCreate,
-		Delete: resourceArmVirtualNetworkPeeringDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceArmVirtualNetworkPeeringCreate,
+		Read:     resourceArmVirtualNetworkPeeringRead,
+		Update:   resourceArmVirtualNetworkPeeringCreate,
+		Delete:   resourceArmVirtualNetworkPeeringDelete,
+		Importer: azure.ImporterValidatingResourceId("virtualNetworks", "virtualNetworkPeerings"),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -74,7 +74,8 @@ func resourceArmVirtualNetworkPeering() *schema.Resource {
 
 func resourceArmVirtualNetworkPeeringCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vnetPeeringsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM virtual network peering creation.")
 
@@ -115,15 +116,22 @@ func resourceArmVirtualNetworkPeeringCreate(d *schema.ResourceData, meta interfa
 
 func resourceArmVirtualNetworkPeeringRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vnetPeeringsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	vnetName := id.Path["virtualNetworks"]
-	name := id.Path["virtualNetworkPeerings"]
+	vnetName, err := id.PopSegment("virtualNetworks")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("virtualNetworkPeerings")
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Get(ctx, resGroup, vnetName, name)
 	if err != nil {
@@ -151,15 +159,22 @@ func resourceArmVirtualNetworkPeeringRead(d *schema.ResourceData, meta interface
 
 func resourceArmVirtualNetworkPeeringDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vnetPeeringsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	vnetName := id.Path["virtualNetworks"]
-	name := id.Path["virtualNetworkPeerings"]
+	vnetName, err := id.PopSegment("virtualNetworks")
+	if err != nil {
+		return err
+	}
+	name, err := id.PopSegment("virtualNetworkPeerings")
+	if err != nil {
+		return err
+	}
 
 	peerMutex.Lock()
 	defer peerMutex.Unlock()
@@ -13,29 +13,14 @@ import (
 // with the Subscription ID, Resource Group and the Provider as top-
 // level fields, and other key-value pairs available via a map in the
 // Path field.
-type ResourceID struct {
-	SubscriptionID string
-	ResourceGroup  string
-	Provider       string
-	Path           map[string]string
-}
+type ResourceID = azure.ResourceID
 
 // parseAzureResourceID converts a long-form Azure Resource Manager ID
 // into a ResourceID. We make assumptions about the structure of URLs,
 // which is obviously not good, but the best thing available given the
 // SDK.
 func parseAzureResourceID(id string) (*ResourceID, error) {
-	parsed, err := azure.ParseAzureResourceID(id)
-	if err != nil {
-		return nil, err
-	}
-
-	return &ResourceID{
-		SubscriptionID: parsed.SubscriptionID,
-		ResourceGroup:  parsed.ResourceGroup,
-		Provider:       parsed.Provider,
-		Path:           parsed.Path,
-	}, nil
+	return azure.ParseAzureResourceID(id)
 }
 
 func parseNetworkSecurityGroupName(networkSecurityGroupId string) (string, error) {
@@ -44,7 +29,7 @@ func parseNetworkSecurityGroupName(networkSecurityGroupId string) (string, error
 		return "", fmt.Errorf("[ERROR] Unable to Parse Network Security Group ID '%s': %+v", networkSecurityGroupId, err)
 	}
 
-	return id.Path["networkSecurityGroups"], nil
+	return id.PopSegment("networkSecurityGroups")
 }
 
 func parseRouteTableName(routeTableId string) (string, error) {
@@ -53,5 +38,5 @@ func parseRouteTableName(routeTableId string) (string, error) {
 		return "", fmt.Errorf("[ERROR] Unable to parse Route Table ID '%s': %+v", routeTableId, err)
 	}
 
-	return id.Path["routeTables"], nil
+	return id.PopSegment("routeTables")
 }
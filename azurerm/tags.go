@@ -1,11 +1,12 @@
 package azurerm
 
 import (
-	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func tagsSchema() *schema.Schema {
@@ -45,23 +46,37 @@ func tagValueToString(v interface{}) (string, error) {
 	}
 }
 
+const (
+	// maxNumberOfTags is the maximum number of tags which can be assigned to a Resource
+	maxNumberOfTags = 50
+
+	// maxTagKeyLength is the maximum length of a tag key for most Resources, however some
+	// Resources (such as Storage Accounts) have a lower limit
+	maxTagKeyLength = 512
+
+	// maxTagValueLength is the maximum length of a tag value
+	maxTagValueLength = 256
+)
+
 func validateAzureRMTags(v interface{}, _ string) (ws []string, es []error) {
-	tagsMap := v.(map[string]interface{})
+	return validateTagsWithMaxKeyLength(v.(map[string]interface{}), maxTagKeyLength)
+}
 
-	if len(tagsMap) > 15 {
-		es = append(es, errors.New("a maximum of 15 tags can be applied to each ARM resource"))
+func validateTagsWithMaxKeyLength(tagsMap map[string]interface{}, maxKeyLength int) (ws []string, es []error) {
+	if len(tagsMap) > maxNumberOfTags {
+		es = append(es, fmt.Errorf("a maximum of %d tags can be applied to each ARM resource", maxNumberOfTags))
 	}
 
 	for k, v := range tagsMap {
-		if len(k) > 512 {
-			es = append(es, fmt.Errorf("the maximum length for a tag key is 512 characters: %q is %d characters", k, len(k)))
+		if len(k) > maxKeyLength {
+			es = append(es, fmt.Errorf("the maximum length for a tag key is %d characters: %q is %d characters", maxKeyLength, k, len(k)))
 		}
 
 		value, err := tagValueToString(v)
 		if err != nil {
 			es = append(es, err)
-		} else if len(value) > 256 {
-			es = append(es, fmt.Errorf("the maximum length for a tag value is 256 characters: the value for %q is %d characters", k, len(value)))
+		} else if len(value) > maxTagValueLength {
+			es = append(es, fmt.Errorf("the maximum length for a tag value is %d characters: the value for %q is %d characters", maxTagValueLength, k, len(value)))
 		}
 	}
 
@@ -115,3 +130,178 @@ func flattenAndSetTags(d *schema.ResourceData, tagMap map[string]*string) {
 
 	d.Set("tags", output)
 }
+
+// mergeDefaultTags returns the provider-level Default Tags combined with the tags for a resource,
+// where a tag specified on the resource takes precedence over a Default Tag with the same key
+func mergeDefaultTags(defaultTags map[string]interface{}, tagsMap map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(defaultTags)+len(tagsMap))
+
+	for k, v := range defaultTags {
+		output[k] = v
+	}
+
+	for k, v := range tagsMap {
+		output[k] = v
+	}
+
+	return output
+}
+
+// ignoredTags are tags which are assigned to Resources outside of Terraform (for example by Azure Policy)
+// and so should be retained, rather than removed, when they're not specified in the configuration
+type ignoredTags struct {
+	keys        []string
+	keyPrefixes []string
+}
+
+func (t ignoredTags) isIgnored(key string) bool {
+	// tag keys are case-insensitive
+	for _, v := range t.keys {
+		if strings.EqualFold(v, key) {
+			return true
+		}
+	}
+
+	for _, v := range t.keyPrefixes {
+		if strings.HasPrefix(strings.ToLower(key), strings.ToLower(v)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func schemaIgnoreTags() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"keys": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.NoZeroValues,
+					},
+				},
+
+				"key_prefixes": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.NoZeroValues,
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandIgnoredTags(input []interface{}) ignoredTags {
+	output := ignoredTags{}
+	if len(input) == 0 || input[0] == nil {
+		return output
+	}
+
+	raw := input[0].(map[string]interface{})
+	if keys, ok := raw["keys"].(*schema.Set); ok {
+		for _, v := range keys.List() {
+			output.keys = append(output.keys, v.(string))
+		}
+	}
+	if prefixes, ok := raw["key_prefixes"].(*schema.Set); ok {
+		for _, v := range prefixes.List() {
+			output.keyPrefixes = append(output.keyPrefixes, v.(string))
+		}
+	}
+
+	return output
+}
+
+// retainIgnoredTags returns the planned tags for a resource combined with any ignored tags
+// which are currently assigned to the resource but aren't present in the planned tags
+func retainIgnoredTags(ignored ignoredTags, existing map[string]interface{}, tagsMap map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(tagsMap))
+	for k, v := range tagsMap {
+		output[k] = v
+	}
+
+	for k, v := range existing {
+		if _, ok := output[k]; ok {
+			continue
+		}
+
+		if ignored.isIgnored(k) {
+			output[k] = v
+		}
+	}
+
+	return output
+}
+
+// applyTagsCustomizeDiffToResources wraps the CustomizeDiff of each Resource supporting in-place updates of tags
+// such that the provider-level `default_tags` are merged into the planned value for `tags`, and any tags matching
+// `ignore_tags` which were assigned outside of Terraform are retained. Since this happens at plan time the merged
+// tags are what's sent to Azure and stored in the state, meaning neither causes a diff once they've been applied.
+func applyTagsCustomizeDiffToResources(resources map[string]*schema.Resource) {
+	for _, resource := range resources {
+		tags, ok := resource.Schema["tags"]
+		if !ok || tags.Type != schema.TypeMap || !tags.Optional || !tags.Computed || tags.ForceNew {
+			continue
+		}
+
+		resource.CustomizeDiff = customizeDiffForTags(resource.CustomizeDiff)
+	}
+}
+
+func customizeDiffForTags(customizeDiff schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, meta interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(diff, meta); err != nil {
+				return err
+			}
+		}
+
+		client, ok := meta.(*ArmClient)
+		if !ok {
+			return nil
+		}
+
+		// the tags can only be merged once they're known (e.g. when interpolated from another resource, during the apply)
+		if !tagsAreKnown(diff) {
+			return nil
+		}
+
+		existingRaw, tagsRaw := diff.GetChange("tags")
+		existing := existingRaw.(map[string]interface{})
+		tagsMap := tagsRaw.(map[string]interface{})
+
+		merged := mergeDefaultTags(client.defaultTags, tagsMap)
+		merged = retainIgnoredTags(client.ignoredTags, existing, merged)
+		if reflect.DeepEqual(tagsMap, merged) {
+			return nil
+		}
+
+		if _, errors := validateAzureRMTags(merged, "tags"); len(errors) > 0 {
+			return fmt.Errorf("Error applying `default_tags` and `ignore_tags` to `tags`: %+v", errors[0])
+		}
+
+		return diff.SetNew("tags", merged)
+	}
+}
+
+// tagsAreKnown returns whether the planned value for `tags` is known - the vendored version of helper/schema
+// doesn't expose this, and reading a map containing a value which isn't known yet panics, so we check for that
+func tagsAreKnown(diff *schema.ResourceDiff) (known bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			known = false
+		}
+	}()
+
+	diff.GetChange("tags")
+	return true
+}
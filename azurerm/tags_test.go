@@ -4,11 +4,16 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+
+	"github.com/hashicorp/hil/ast"
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
 )
 
 func TestValidateMaximumNumberOfARMTags(t *testing.T) {
 	tagsMap := make(map[string]interface{})
-	for i := 0; i < 16; i++ {
+	for i := 0; i < 51; i++ {
 		tagsMap[fmt.Sprintf("key%d", i)] = fmt.Sprintf("value%d", i)
 	}
 
@@ -18,7 +23,7 @@ func TestValidateMaximumNumberOfARMTags(t *testing.T) {
 		t.Fatal("Expected one validation error for too many tags")
 	}
 
-	if !strings.Contains(es[0].Error(), "a maximum of 15 tags") {
+	if !strings.Contains(es[0].Error(), "a maximum of 50 tags") {
 		t.Fatal("Wrong validation error message for too many tags")
 	}
 }
@@ -113,3 +118,251 @@ func TestFilterARMTags(t *testing.T) {
 		t.Fatalf("Expected %v in filtered tag map, got %v", valueData[1], *filtered["key2"])
 	}
 }
+
+func TestMergeDefaultTags(t *testing.T) {
+	defaultTags := map[string]interface{}{
+		"environment": "Production",
+		"cost-center": "Finance",
+	}
+	tagsMap := map[string]interface{}{
+		"environment": "Staging",
+		"owner":       "Team A",
+	}
+
+	merged := mergeDefaultTags(defaultTags, tagsMap)
+
+	expected := map[string]string{
+		"environment": "Staging",
+		"cost-center": "Finance",
+		"owner":       "Team A",
+	}
+	if len(merged) != len(expected) {
+		t.Fatalf("Expected %d tags but got %d", len(expected), len(merged))
+	}
+	for k, v := range expected {
+		if merged[k] != v {
+			t.Fatalf("Expected the tag %q to be %q but got %q", k, v, merged[k])
+		}
+	}
+}
+
+func TestApplyTagsCustomizeDiffToResources(t *testing.T) {
+	resources := map[string]*schema.Resource{
+		"in_place": {
+			Schema: map[string]*schema.Schema{
+				"tags": tagsSchema(),
+			},
+		},
+		"force_new": {
+			Schema: map[string]*schema.Schema{
+				"tags": tagsForceNewSchema(),
+			},
+		},
+		"no_tags": {
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+
+	applyTagsCustomizeDiffToResources(resources)
+
+	if resources["in_place"].CustomizeDiff == nil {
+		t.Fatalf("Expected a CustomizeDiff to be set for a Resource with updatable tags")
+	}
+	if resources["force_new"].CustomizeDiff != nil {
+		t.Fatalf("Expected no CustomizeDiff to be set for a Resource where tags are ForceNew")
+	}
+	if resources["no_tags"].CustomizeDiff != nil {
+		t.Fatalf("Expected no CustomizeDiff to be set for a Resource without tags")
+	}
+}
+
+func TestCustomizeDiffForTags(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": tagsSchema(),
+		},
+		CustomizeDiff: customizeDiffForTags(nil),
+	}
+	meta := &ArmClient{
+		defaultTags: map[string]interface{}{
+			"environment": "production",
+		},
+	}
+	unknown := ast.Variable{
+		Type:  ast.TypeUnknown,
+		Value: config.UnknownVariableValue,
+	}
+
+	cases := []struct {
+		Name     string
+		Config   map[string]interface{}
+		Expected map[string]string
+	}{
+		{
+			Name:   "No Tags",
+			Config: map[string]interface{}{},
+			Expected: map[string]string{
+				"tags.%":           "1",
+				"tags.environment": "production",
+			},
+		},
+		{
+			Name: "Known Tags",
+			Config: map[string]interface{}{
+				"tags": map[string]interface{}{
+					"cost_center": "MSFT",
+				},
+			},
+			Expected: map[string]string{
+				"tags.%":           "2",
+				"tags.cost_center": "MSFT",
+				"tags.environment": "production",
+			},
+		},
+		{
+			Name: "Unknown Tags",
+			Config: map[string]interface{}{
+				"tags": map[string]interface{}{
+					"cost_center": "${var.cost_center}",
+				},
+			},
+			Expected: map[string]string{
+				"tags.%": config.UnknownVariableValue,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			raw, err := config.NewRawConfig(tc.Config)
+			if err != nil {
+				t.Fatalf("Error building the config: %+v", err)
+			}
+			if err := raw.Interpolate(map[string]ast.Variable{"var.cost_center": unknown}); err != nil {
+				t.Fatalf("Error interpolating the config: %+v", err)
+			}
+
+			diff, err := resource.Diff(nil, terraform.NewResourceConfig(raw), meta)
+			if err != nil {
+				t.Fatalf("Error computing the diff: %+v", err)
+			}
+
+			for k, v := range tc.Expected {
+				attr, ok := diff.Attributes[k]
+				if !ok {
+					t.Fatalf("Expected %q to be in the diff but it wasn't", k)
+				}
+
+				if v == config.UnknownVariableValue {
+					if !attr.NewComputed {
+						t.Fatalf("Expected %q to be computed but got %q", k, attr.New)
+					}
+					continue
+				}
+
+				if attr.New != v {
+					t.Fatalf("Expected %q to be %q but got %q", k, v, attr.New)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateStorageAccountTagMaxKeyLength(t *testing.T) {
+	tagsMap := map[string]interface{}{
+		strings.Repeat("a", 129): "value",
+	}
+
+	_, es := validateAzureRMStorageAccountTags(tagsMap, "tags")
+	if len(es) != 1 {
+		t.Fatal("Expected one validation error for a key which is > 128 chars")
+	}
+
+	if !strings.Contains(es[0].Error(), "maximum length for a tag key is 128") {
+		t.Fatalf("Wrong validation error message for the maximum tag key length: %+v", es[0])
+	}
+}
+
+func TestIgnoredTagsIsIgnored(t *testing.T) {
+	ignored := ignoredTags{
+		keys:        []string{"CreatedBy"},
+		keyPrefixes: []string{"policy-"},
+	}
+
+	testCases := []struct {
+		key      string
+		expected bool
+	}{
+		{key: "CreatedBy", expected: true},
+		{key: "createdby", expected: true},
+		{key: "CreatedByUser", expected: false},
+		{key: "policy-owner", expected: true},
+		{key: "Policy-Owner", expected: true},
+		{key: "owner-policy", expected: false},
+		{key: "environment", expected: false},
+	}
+
+	for _, test := range testCases {
+		if actual := ignored.isIgnored(test.key); actual != test.expected {
+			t.Fatalf("Expected %q to be ignored %t but got %t", test.key, test.expected, actual)
+		}
+	}
+}
+
+func TestExpandIgnoredTags(t *testing.T) {
+	if result := expandIgnoredTags([]interface{}{}); len(result.keys) != 0 || len(result.keyPrefixes) != 0 {
+		t.Fatalf("Expected no ignored tags for an empty block but got %+v", result)
+	}
+
+	input := []interface{}{
+		map[string]interface{}{
+			"keys":         schema.NewSet(schema.HashString, []interface{}{"CreatedBy"}),
+			"key_prefixes": schema.NewSet(schema.HashString, []interface{}{"policy-"}),
+		},
+	}
+	result := expandIgnoredTags(input)
+	if len(result.keys) != 1 || result.keys[0] != "CreatedBy" {
+		t.Fatalf("Expected the key `CreatedBy` to be ignored but got %+v", result.keys)
+	}
+	if len(result.keyPrefixes) != 1 || result.keyPrefixes[0] != "policy-" {
+		t.Fatalf("Expected the key prefix `policy-` to be ignored but got %+v", result.keyPrefixes)
+	}
+}
+
+func TestRetainIgnoredTags(t *testing.T) {
+	ignored := ignoredTags{
+		keyPrefixes: []string{"policy-"},
+	}
+	existing := map[string]interface{}{
+		"environment":  "production",
+		"policy-owner": "platform",
+		"removed":      "value",
+	}
+	tagsMap := map[string]interface{}{
+		"environment": "staging",
+	}
+
+	result := retainIgnoredTags(ignored, existing, tagsMap)
+
+	expected := map[string]interface{}{
+		"environment":  "staging",
+		"policy-owner": "platform",
+	}
+	if len(result) != len(expected) {
+		t.Fatalf("Expected %d tags but got %d: %+v", len(expected), len(result), result)
+	}
+	for k, v := range expected {
+		if result[k] != v {
+			t.Fatalf("Expected the tag %q to be %q but got %q", k, v, result[k])
+		}
+	}
+
+	if _, ok := tagsMap["policy-owner"]; ok {
+		t.Fatalf("Expected the planned tags not to be modified")
+	}
+}